@@ -0,0 +1,79 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command libsmbios builds a C shared library which exposes go-smbios to
+// programs written in other languages, such as Python or Rust, so they can
+// reuse its parsers instead of invoking dmidecode.
+//
+// Build the library and its header using:
+//
+//	$ go build -buildmode=c-shared -o libsmbios.so ./cmd/libsmbios
+//
+// The library exports the following functions:
+//
+//	// smbios_read_json reads SMBIOS data from the operating system and
+//	// returns a hardware report as a JSON object.  On failure, the object
+//	// contains a single "error" string.  The returned string must be
+//	// released using smbios_free.
+//	char* smbios_read_json(void);
+//
+//	// smbios_free releases a string returned by this library.
+//	void smbios_free(char* s);
+package main
+
+// #include <stdlib.h>
+import "C"
+
+import (
+	"encoding/json"
+	"unsafe"
+
+	"github.com/digitalocean/go-smbios/internal/report"
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+//export smbios_read_json
+func smbios_read_json() *C.char {
+	b, err := readJSON()
+	if err != nil {
+		// Marshaling a string map cannot fail.
+		b, _ = json.Marshal(map[string]string{"error": err.Error()})
+	}
+
+	return C.CString(string(b))
+}
+
+//export smbios_free
+func smbios_free(s *C.char) {
+	C.free(unsafe.Pointer(s))
+}
+
+// readJSON reads SMBIOS data and produces a JSON hardware report.
+func readJSON() ([]byte, error) {
+	rc, ep, err := smbios.Stream()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	ss, err := smbios.NewDecoder(rc).Decode()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(report.New(ep, ss))
+}
+
+// main is required to build a C shared library, but is never called.
+func main() {}
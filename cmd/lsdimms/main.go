@@ -16,18 +16,28 @@
 package main
 
 import (
-	"encoding/binary"
+	"flag"
 	"fmt"
 	"log"
+	"os"
 
+	"github.com/digitalocean/go-smbios/internal/cli"
 	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/humanize"
+	"github.com/digitalocean/go-smbios/smbios/structures"
 )
 
 func main() {
+	fs := flag.NewFlagSet(os.Args[0], flag.ContinueOnError)
+
+	var handles cli.HandleFilter
+	fs.Var(&handles, "handle", "only display memory devices with the specified comma-separated handles, in hexadecimal (0x0042) or decimal (66) form")
+	cli.ParseFlags(fs, os.Args[1:])
+
 	// Find SMBIOS data in operating system-specific location.
 	rc, ep, err := smbios.Stream()
 	if err != nil {
-		log.Fatalf("failed to open stream: %v", err)
+		cli.Fatalf(cli.ExitCode(err), "failed to open stream: %v", err)
 	}
 	// Be sure to close the stream!
 	defer rc.Close()
@@ -36,48 +46,78 @@ func main() {
 	d := smbios.NewDecoder(rc)
 	ss, err := d.Decode()
 	if err != nil {
-		log.Fatalf("failed to decode structures: %v", err)
+		cli.Fatalf(cli.ExitParse, "failed to decode structures: %v", err)
 	}
 
 	major, minor, rev := ep.Version()
 	fmt.Printf("SMBIOS %d.%d.%d\n", major, minor, rev)
 
+	tbl := cli.NewTable("HANDLE", "LOCATOR", "BANK", "SIZE", "SPEED", "VOLTAGE", "TYPE DETAIL")
+
+	var n int
 	for _, s := range ss {
-		// Only look at memory devices.
-		if s.Header.Type != 17 {
+		if s.Header.Type != structures.TypeMemoryDevice || !handles.Match(s.Header.Handle) {
 			continue
 		}
+		n++
 
-		// Code based on: https://www.dmtf.org/sites/default/files/standards/documents/DSP0134_3.1.1.pdf.
+		// A single malformed memory device should not hide the others.
+		md, err := structures.ParseMemoryDevice(s)
+		if err != nil {
+			log.Printf("warning: skipping memory device %s: %v", cli.FormatHandle(s.Header.Handle), err)
+			continue
+		}
 
-		// TODO: this should go in a new package in go-smbios for parsing specific structures.
+		tbl.AddRow(
+			cli.FormatHandle(md.Header.Handle),
+			md.DeviceLocator,
+			md.BankLocator,
+			md.HumanSize(),
+			dimmSpeed(md),
+			dimmVoltage(md),
+			md.TypeDetail.String(),
+		)
+	}
 
-		// Only parse the DIMM size.
-		dimmSize := int(binary.LittleEndian.Uint16(s.Formatted[8:10]))
+	if _, err := tbl.WriteTo(os.Stdout); err != nil {
+		cli.Fatalf(cli.ExitFailure, "failed to write output: %v", err)
+	}
 
-		if dimmSize == 0 {
-			fmt.Printf("[% 3s] empty\n", s.Strings[0])
-			continue
-		}
+	if n == 0 && !handles.Empty() {
+		cli.Fatalf(cli.ExitNotFound, "no memory devices found with handles: %s", handles.String())
+	}
+}
 
-		//If the DIMM size is 32GB or greater, we need to parse the extended field.
-		// Spec says 0x7fff in regular size field means we should parse the extended.
-		if dimmSize == 0x7fff {
-			dimmSize = int(binary.LittleEndian.Uint32(s.Formatted[24:28]))
-		}
+// dimmSpeed formats the speed of a memory device.
+func dimmSpeed(md *structures.MemoryDevice) string {
+	speed, ok := md.EffectiveSpeed()
+	if !ok {
+		return "unknown"
+	}
 
-		// The granularity in which the value is specified
-		// depends on the setting of the most-significant bit (bit
-		// 15). If the bit is 0, the value is specified in megabyte
-		// units; if the bit is 1, the value is specified in kilobyte
-		// units.
-		//
-		// Little endian MSB for uint16 is in second byte.
-		unit := "KB"
-		if s.Formatted[9]&0x80 == 0 {
-			unit = "MB"
-		}
+	return humanize.TransferRate(speed)
+}
+
+// dimmVoltage formats the configured voltage of a memory device, followed by
+// its operating voltage range when known.
+func dimmVoltage(md *structures.MemoryDevice) string {
+	v := "unknown"
+	if mv, ok := md.ConfiguredMillivolts(); ok {
+		v = humanize.Voltage(mv)
+	}
 
-		fmt.Printf("[% 3s] DIMM: %d %s\n", s.Strings[0], dimmSize, unit)
+	lo, loOK := md.MinimumMillivolts()
+	hi, hiOK := md.MaximumMillivolts()
+	if !loOK && !hiOK {
+		return v
 	}
+
+	r := func(mv int, ok bool) string {
+		if !ok {
+			return "?"
+		}
+		return humanize.Voltage(mv)
+	}
+
+	return fmt.Sprintf("%s (%s-%s)", v, r(lo, loOK), r(hi, hiOK))
 }
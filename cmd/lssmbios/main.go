@@ -16,17 +16,28 @@
 package main
 
 import (
+	"encoding/hex"
+	"flag"
 	"fmt"
-	"log"
+	"os"
+	"strconv"
+	"strings"
 
+	"github.com/digitalocean/go-smbios/internal/cli"
 	"github.com/digitalocean/go-smbios/smbios"
 )
 
 func main() {
+	fs := flag.NewFlagSet(os.Args[0], flag.ContinueOnError)
+
+	var handles cli.HandleFilter
+	fs.Var(&handles, "handle", "only display structures with the specified comma-separated handles, in hexadecimal (0x0042) or decimal (66) form")
+	cli.ParseFlags(fs, os.Args[1:])
+
 	// Find SMBIOS data in operating system-specific location.
 	rc, ep, err := smbios.Stream()
 	if err != nil {
-		log.Fatalf("failed to open stream: %v", err)
+		cli.Fatalf(cli.ExitCode(err), "failed to open stream: %v", err)
 	}
 	// Be sure to close the stream!
 	defer rc.Close()
@@ -35,7 +46,7 @@ func main() {
 	d := smbios.NewDecoder(rc)
 	ss, err := d.Decode()
 	if err != nil {
-		log.Fatalf("failed to decode structures: %v", err)
+		cli.Fatalf(cli.ExitParse, "failed to decode structures: %v", err)
 	}
 
 	// Determine SMBIOS version and table location from entry point.
@@ -45,7 +56,34 @@ func main() {
 	fmt.Printf("SMBIOS %d.%d.%d - table: address: %#x, size: %d\n",
 		major, minor, rev, addr, size)
 
+	tbl := cli.NewTable("HANDLE", "TYPE", "LENGTH", "FORMATTED", "STRINGS")
+
+	var n int
 	for _, s := range ss {
-		fmt.Println(s)
+		if !handles.Match(s.Header.Handle) {
+			continue
+		}
+		n++
+
+		strs := make([]string, 0, len(s.Strings))
+		for _, str := range s.Strings {
+			strs = append(strs, strconv.Quote(str))
+		}
+
+		tbl.AddRow(
+			cli.FormatHandle(s.Header.Handle),
+			strconv.Itoa(int(s.Header.Type)),
+			strconv.Itoa(int(s.Header.Length)),
+			hex.EncodeToString(s.Formatted),
+			strings.Join(strs, ", "),
+		)
+	}
+
+	if _, err := tbl.WriteTo(os.Stdout); err != nil {
+		cli.Fatalf(cli.ExitFailure, "failed to write output: %v", err)
+	}
+
+	if n == 0 && !handles.Empty() {
+		cli.Fatalf(cli.ExitNotFound, "no structures found with handles: %s", handles.String())
 	}
 }
@@ -0,0 +1,269 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command smbiosbench measures the latency and memory allocations of
+// acquiring and decoding SMBIOS data on the local machine, using each
+// configuration of package smbios which is available.  Its output is
+// intended to be attached to performance issue reports.
+//
+// Each configuration is run up to -n times, and all runs stop once the time
+// budget given by -timeout is spent, so that smbiosbench completes promptly
+// even on machines where reading SMBIOS data is slow.  A single run which
+// takes longer than -run-timeout, such as a hung read, is abandoned, and
+// smbiosbench notes this in its output, as the abandoned run's allocations
+// may be counted in the measurements of later runs.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/digitalocean/go-smbios/internal/cli"
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// A config is a named configuration of Stream to benchmark.
+type config struct {
+	name    string
+	options []smbios.StreamOption
+}
+
+// configs are the configurations benchmarked by smbiosbench.  Not all are
+// available on every machine.
+var configs = []config{
+	{name: "default"},
+	{name: "verified", options: []smbios.StreamOption{smbios.WithVerification()}},
+	{name: "no-devmem", options: []smbios.StreamOption{smbios.WithoutDevMem()}},
+	{name: "prefer-32bit", options: []smbios.StreamOption{smbios.WithEntryPointPreference(smbios.Prefer32Bit)}},
+	{name: "prefer-64bit", options: []smbios.StreamOption{smbios.WithEntryPointPreference(smbios.Prefer64Bit)}},
+	{name: "sysfs", options: []smbios.StreamOption{smbios.WithSources(smbios.SysfsSource)}},
+	{name: "devmem", options: []smbios.StreamOption{smbios.WithSources(smbios.DevMemSource)}},
+	{name: "firmware-table", options: []smbios.StreamOption{smbios.WithSources(smbios.FirmwareTableSource)}},
+}
+
+func main() {
+	fs := flag.NewFlagSet(os.Args[0], flag.ContinueOnError)
+
+	var (
+		n          = fs.Int("n", 20, "maximum number of runs of each configuration")
+		timeout    = fs.Duration("timeout", 30*time.Second, "total time budget for all runs")
+		runTimeout = fs.Duration("run-timeout", 5*time.Second, "time limit for a single run")
+	)
+	cli.ParseFlags(fs, os.Args[1:])
+
+	if *n < 1 {
+		cli.Fatalf(cli.ExitFailure, "number of runs must be at least 1")
+	}
+
+	fmt.Printf("%s %s/%s, GOMAXPROCS=%d\n", runtime.Version(), runtime.GOOS, runtime.GOARCH, runtime.GOMAXPROCS(0))
+
+	tbl := cli.NewTable("CONFIG", "SOURCE", "RUNS", "ACQUIRE", "DECODE", "ALLOCS/OP", "BYTES/OP", "STRUCTURES")
+
+	deadline := time.Now().Add(*timeout)
+
+	// limit returns the time limit for the next run, which is not positive
+	// once the time budget is spent.
+	limit := func() time.Duration {
+		if d := time.Until(deadline); d < *runTimeout {
+			return d
+		}
+
+		return *runTimeout
+	}
+
+	var (
+		ok        int
+		abandoned bool
+	)
+	for _, c := range configs {
+		d := limit()
+		if d <= 0 {
+			break
+		}
+
+		// An unmeasured first run warms caches, and identifies the source
+		// using provenance, which would otherwise add to the measurements.
+		warm, err := runLimit(c, true, d)
+		if errors.Is(err, errTimedOut) {
+			abandoned = true
+		}
+		if err != nil {
+			// A configuration may be unavailable on this machine.
+			tbl.AddRow(c.name, "unavailable: "+err.Error(), "", "", "", "", "", "")
+			continue
+		}
+
+		var rs []result
+		for i := 0; i < *n; i++ {
+			d := limit()
+			if d <= 0 {
+				break
+			}
+
+			r, err := runLimit(c, false, d)
+			if errors.Is(err, errTimedOut) {
+				abandoned = true
+			}
+			if err != nil {
+				tbl.AddRow(c.name, "failed: "+err.Error(), "", "", "", "", "", "")
+				break
+			}
+
+			rs = append(rs, r)
+		}
+		if len(rs) == 0 {
+			continue
+		}
+		ok++
+
+		s := summarize(rs)
+		tbl.AddRow(
+			c.name,
+			warm.source,
+			strconv.Itoa(len(rs)),
+			s.acquire.String(),
+			s.decode.String(),
+			strconv.FormatUint(s.allocs, 10),
+			strconv.FormatUint(s.bytes, 10),
+			strconv.Itoa(s.structures),
+		)
+	}
+
+	if _, err := tbl.WriteTo(os.Stdout); err != nil {
+		cli.Fatalf(cli.ExitFailure, "failed to write output: %v", err)
+	}
+
+	if ok == 0 {
+		cli.Fatalf(cli.ExitUnsupported, "no configurations are available")
+	}
+
+	if limit() <= 0 {
+		fmt.Println("time budget exhausted; some configurations ran fewer times")
+	}
+
+	if abandoned {
+		fmt.Println("some runs timed out and were abandoned; their allocations may be included in the measurements of later runs")
+	}
+}
+
+// A result is the measurement of a single run.
+type result struct {
+	source  string
+	acquire time.Duration
+	decode  time.Duration
+
+	// Allocations made by both acquiring and decoding.
+	allocs uint64
+	bytes  uint64
+
+	structures int
+}
+
+// errTimedOut is returned by runLimit when a run is abandoned.
+var errTimedOut = errors.New("timed out")
+
+// runLimit runs c as run does, but gives up after timeout, as a read from a
+// misbehaving source can block indefinitely.  An abandoned run continues in
+// the background, so its allocations may be attributed to later runs.
+func runLimit(c config, provenance bool, timeout time.Duration) (result, error) {
+	type out struct {
+		r   result
+		err error
+	}
+
+	ch := make(chan out, 1)
+	go func() {
+		r, err := run(c, provenance)
+		ch <- out{r: r, err: err}
+	}()
+
+	t := time.NewTimer(timeout)
+	defer t.Stop()
+
+	select {
+	case o := <-ch:
+		return o.r, o.err
+	case <-t.C:
+		return result{}, fmt.Errorf("%w after %s", errTimedOut, timeout)
+	}
+}
+
+// run acquires and decodes SMBIOS data once using c.  If provenance is set,
+// run also identifies the source of the data.
+func run(c config, provenance bool) (result, error) {
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+
+	start := time.Now()
+	rc, _, err := smbios.Stream(c.options...)
+	if err != nil {
+		return result{}, err
+	}
+	defer rc.Close()
+	acquired := time.Now()
+
+	var dopts []smbios.DecoderOption
+	if provenance {
+		dopts = append(dopts, smbios.WithProvenance())
+	}
+
+	ss, err := smbios.NewDecoder(rc, dopts...).Decode()
+	if err != nil {
+		return result{}, err
+	}
+	decoded := time.Now()
+
+	runtime.ReadMemStats(&after)
+
+	r := result{
+		acquire:    acquired.Sub(start),
+		decode:     decoded.Sub(acquired),
+		allocs:     after.Mallocs - before.Mallocs,
+		bytes:      after.TotalAlloc - before.TotalAlloc,
+		structures: len(ss),
+	}
+	if len(ss) > 0 && ss[0].Provenance != nil {
+		r.source = ss[0].Provenance.Source
+	}
+
+	return r, nil
+}
+
+// summarize returns the median of each measurement in rs.  The source is
+// not summarized.
+func summarize(rs []result) result {
+	median := func(f func(r result) uint64) uint64 {
+		vs := make([]uint64, 0, len(rs))
+		for _, r := range rs {
+			vs = append(vs, f(r))
+		}
+		sort.Slice(vs, func(i, j int) bool { return vs[i] < vs[j] })
+
+		return vs[len(vs)/2]
+	}
+
+	return result{
+		acquire:    time.Duration(median(func(r result) uint64 { return uint64(r.acquire) })),
+		decode:     time.Duration(median(func(r result) uint64 { return uint64(r.decode) })),
+		allocs:     median(func(r result) uint64 { return r.allocs }),
+		bytes:      median(func(r result) uint64 { return r.bytes }),
+		structures: rs[0].structures,
+	}
+}
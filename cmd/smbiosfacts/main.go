@@ -0,0 +1,148 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command smbiosfacts emits SMBIOS inventory as JSON facts for configuration
+// management systems, replacing dmidecode-based custom facts scripts.
+//
+// By default, facts are nested objects in the layout expected of an Ansible
+// local facts script: install smbiosfacts as an executable
+// /etc/ansible/facts.d/smbios.fact and the facts are available as
+// ansible_local.smbios.  Numbered structures such as memory devices are
+// emitted as arrays.
+//
+// With -format=salt, the same facts are wrapped in an "smbios" object, so
+// that the output can be merged into Salt grains.  With -format=flat, facts
+// are emitted as a single object with dotted keys, as produced by
+// structures.Table.Flatten.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/digitalocean/go-smbios/internal/cli"
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+)
+
+func main() {
+	fs := flag.NewFlagSet(os.Args[0], flag.ContinueOnError)
+	format := fs.String("format", "ansible", "output format: ansible, salt, or flat")
+	cli.ParseFlags(fs, os.Args[1:])
+
+	switch *format {
+	case "ansible", "salt", "flat":
+	default:
+		cli.Fatalf(cli.ExitFailure, "unknown output format: %q", *format)
+	}
+
+	rc, _, err := smbios.Stream()
+	if err != nil {
+		cli.Fatalf(cli.ExitCode(err), "failed to open stream: %v", err)
+	}
+	defer rc.Close()
+
+	ss, err := smbios.NewDecoder(rc).Decode()
+	if err != nil {
+		cli.Fatalf(cli.ExitParse, "failed to decode structures: %v", err)
+	}
+
+	facts, err := structures.NewTable(&smbios.Table{Structures: ss}).Flatten()
+	if err != nil {
+		cli.Fatalf(cli.ExitParse, "failed to parse structures: %v", err)
+	}
+
+	var v interface{}
+	switch *format {
+	case "ansible":
+		v = nest(facts)
+	case "salt":
+		v = map[string]interface{}{"smbios": nest(facts)}
+	case "flat":
+		v = facts
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "\t")
+	if err := enc.Encode(v); err != nil {
+		cli.Fatalf(cli.ExitFailure, "failed to write facts: %v", err)
+	}
+}
+
+// nest converts flattened facts with dotted keys into nested objects, in
+// which objects keyed by consecutive indices are replaced by arrays.
+func nest(facts map[string]string) interface{} {
+	root := make(map[string]interface{})
+	for k, v := range facts {
+		m := root
+		parts := strings.Split(k, ".")
+		for _, p := range parts[:len(parts)-1] {
+			next, ok := m[p].(map[string]interface{})
+			if !ok {
+				next = make(map[string]interface{})
+				m[p] = next
+			}
+			m = next
+		}
+
+		m[parts[len(parts)-1]] = v
+	}
+
+	return arrays(root)
+}
+
+// arrays recursively replaces objects in v whose keys are the indices 0
+// through n-1 with arrays of length n.
+func arrays(v interface{}) interface{} {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return v
+	}
+
+	for k, c := range m {
+		m[k] = arrays(c)
+	}
+
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		i, err := strconv.Atoi(k)
+		if err != nil {
+			return m
+		}
+		keys = append(keys, i)
+	}
+	sort.Ints(keys)
+
+	for i, k := range keys {
+		if i != k {
+			return m
+		}
+	}
+
+	// An empty object is not an array.
+	if len(keys) == 0 {
+		return m
+	}
+
+	a := make([]interface{}, len(keys))
+	for _, k := range keys {
+		a[k] = m[strconv.Itoa(k)]
+	}
+
+	return a
+}
@@ -0,0 +1,83 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command smbiosreport generates a comprehensive report of system hardware
+// from SMBIOS, suitable for attaching to support tickets.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+
+	"github.com/digitalocean/go-smbios/internal/cli"
+	"github.com/digitalocean/go-smbios/internal/report"
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/bom"
+)
+
+func main() {
+	fs := flag.NewFlagSet(os.Args[0], flag.ContinueOnError)
+
+	var (
+		jsonFlag = fs.Bool("json", false, "output the report as JSON")
+		bomFlag  = fs.Bool("bom", false, "output a hardware bill of materials as JSON")
+	)
+	cli.ParseFlags(fs, os.Args[1:])
+
+	// Find SMBIOS data in operating system-specific location.
+	rc, ep, err := smbios.Stream()
+	if err != nil {
+		cli.Fatalf(cli.ExitCode(err), "failed to open stream: %v", err)
+	}
+	// Be sure to close the stream!
+	defer rc.Close()
+
+	// Decode SMBIOS structures from the stream.
+	d := smbios.NewDecoder(rc)
+	ss, err := d.Decode()
+	if err != nil {
+		cli.Fatalf(cli.ExitParse, "failed to decode structures: %v", err)
+	}
+
+	if *bomFlag {
+		b, err := bom.New(ss)
+		if err != nil {
+			cli.Fatalf(cli.ExitParse, "failed to generate bill of materials: %v", err)
+		}
+
+		writeJSON(b)
+		return
+	}
+
+	r := report.New(ep, ss)
+
+	if *jsonFlag {
+		writeJSON(r)
+		return
+	}
+
+	if _, err := os.Stdout.Write(r.Text()); err != nil {
+		cli.Fatalf(cli.ExitFailure, "failed to write report: %v", err)
+	}
+}
+
+// writeJSON writes v to stdout as indented JSON.
+func writeJSON(v interface{}) {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "\t")
+	if err := enc.Encode(v); err != nil {
+		cli.Fatalf(cli.ExitFailure, "failed to write JSON: %v", err)
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command smbioswatch is an example inventory agent which periodically reads
+// SMBIOS data and logs changes to the structure table.
+//
+// smbioswatch is intended for Windows hosts, where SMBIOS data is read using
+// GetSystemFirmwareTable without requiring Event Tracing for Windows or WMI,
+// but it runs in the foreground on any platform supported by package smbios.
+// On Windows, the -service flag runs smbioswatch under the service control
+// manager, with its output redirected to a file using the -log flag:
+//
+//	sc.exe create smbioswatch start= auto binPath= "C:\smbioswatch.exe -service -log C:\smbioswatch.log"
+//
+// The first table read during each boot is cached in the file specified by
+// -cache, so that a restarted agent compares against the same baseline
+// rather than the table at the time of the restart.
+package main
+
+import (
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/digitalocean/go-smbios/internal/cli"
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+func main() {
+	fs := flag.NewFlagSet(os.Args[0], flag.ContinueOnError)
+
+	var (
+		interval   = fs.Duration("interval", 1*time.Hour, "interval between SMBIOS reads")
+		cache      = fs.String("cache", filepath.Join(os.TempDir(), "smbioswatch.cache"), "file used to cache the first SMBIOS table read during each boot")
+		excludeOEM = fs.Bool("exclude-oem", false, "ignore changes to OEM-specific structures, which may contain volatile data")
+		logFile    = fs.String("log", "", "append log output to the specified file instead of stderr")
+		service    = fs.Bool("service", false, "run as a Windows service under the service control manager")
+	)
+	cli.ParseFlags(fs, os.Args[1:])
+
+	if *logFile != "" {
+		f, err := os.OpenFile(*logFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			// The log file is unrelated to SMBIOS data, so err does not
+			// determine the exit code.
+			cli.Fatalf(cli.ExitFailure, "failed to open log file: %v", err)
+		}
+		defer f.Close()
+
+		log.SetOutput(f)
+	}
+
+	w := &watcher{
+		interval:   *interval,
+		cache:      *cache,
+		excludeOEM: *excludeOEM,
+	}
+
+	if *service {
+		if err := runService(serviceName, w.watch); err != nil {
+			cli.Fatalf(cli.ExitCode(err), "failed to run service: %v", err)
+		}
+		return
+	}
+
+	if err := w.watch(nil); err != nil {
+		cli.Fatalf(cli.ExitCode(err), "failed to read SMBIOS table: %v", err)
+	}
+}
+
+// serviceName is the name of the smbioswatch Windows service.
+const serviceName = "smbioswatch"
+
+// A watcher periodically reads SMBIOS data and logs changes.
+type watcher struct {
+	interval   time.Duration
+	cache      string
+	excludeOEM bool
+}
+
+// watch reads SMBIOS data every interval and logs changes until stop is
+// closed.  watch returns an error only if the baseline cannot be read.
+func (w *watcher) watch(stop <-chan struct{}) error {
+	var hopts []smbios.HashOption
+	if w.excludeOEM {
+		hopts = append(hopts, smbios.HashExcludeOEM())
+	}
+
+	// Establish the baseline for this boot from the cache, if possible.
+	prev, err := read(smbios.WithCache(w.cache))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("baseline: %d structures, hash: %s",
+		len(prev.Structures), hash(prev, hopts))
+
+	t := time.NewTicker(w.interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return nil
+		case <-t.C:
+		}
+
+		next, err := read()
+		if err != nil {
+			// Keep running; the next read may succeed.
+			log.Printf("failed to read SMBIOS table: %v", err)
+			continue
+		}
+
+		if hash(prev, hopts) == hash(next, hopts) {
+			continue
+		}
+
+		log.Printf("SMBIOS table changed, hash: %s", hash(next, hopts))
+		w.logChanges(prev, next)
+
+		prev = next
+	}
+}
+
+// read reads and decodes the SMBIOS structure table.
+func read(options ...smbios.StreamOption) (*smbios.Table, error) {
+	rc, ep, err := smbios.Stream(options...)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	ss, err := smbios.NewDecoder(rc).Decode()
+	if err != nil {
+		return nil, err
+	}
+
+	return &smbios.Table{
+		EntryPoint: ep,
+		Structures: ss,
+	}, nil
+}
+
+// hash returns the hexadecimal hash of t.
+func hash(t *smbios.Table, options []smbios.HashOption) string {
+	h := t.Hash(options...)
+	return hex.EncodeToString(h[:])
+}
+
+// logChanges logs the structures which were added, removed, or changed
+// between prev and next, identified by their handles.  OEM-specific
+// structures are ignored if they are also excluded from the hash.
+func (w *watcher) logChanges(prev, next *smbios.Table) {
+	// ignore matches the structures excluded by smbios.HashExcludeOEM.
+	ignore := func(s *smbios.Structure) bool {
+		return w.excludeOEM && s.Header.Type >= 128
+	}
+
+	before := make(map[uint16]*smbios.Structure, len(prev.Structures))
+	for _, s := range prev.Structures {
+		if !ignore(s) {
+			before[s.Header.Handle] = s
+		}
+	}
+
+	for _, s := range next.Structures {
+		if ignore(s) {
+			continue
+		}
+
+		p, ok := before[s.Header.Handle]
+		delete(before, s.Header.Handle)
+
+		switch {
+		case !ok:
+			log.Printf("  added:   %s", describe(s))
+		case !p.Equal(s):
+			log.Printf("  changed: %s", describe(s))
+		}
+	}
+
+	for _, s := range prev.Structures {
+		if _, ok := before[s.Header.Handle]; ok && !ignore(s) {
+			log.Printf("  removed: %s", describe(s))
+		}
+	}
+}
+
+// describe returns a short description of s for logging.
+func describe(s *smbios.Structure) string {
+	return fmt.Sprintf("handle %s, type %d", cli.FormatHandle(s.Header.Handle), s.Header.Type)
+}
@@ -0,0 +1,179 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows && !safe
+// +build windows,!safe
+
+package main
+
+import (
+	"log"
+	"sync"
+	"syscall"
+	"unsafe"
+
+	"github.com/digitalocean/go-smbios/internal/cli"
+)
+
+// Service control manager constants, from winsvc.h and winerror.h.
+const (
+	serviceWin32OwnProcess = 0x10
+
+	serviceStopped     = 0x1
+	serviceStopPending = 0x3
+	serviceRunning     = 0x4
+
+	serviceAcceptStop     = 0x1
+	serviceAcceptShutdown = 0x4
+
+	serviceControlStop        = 0x1
+	serviceControlInterrogate = 0x4
+	serviceControlShutdown    = 0x5
+
+	errorCallNotImplemented   = 120
+	errorServiceSpecificError = 1066
+)
+
+var (
+	libAdvapi32 = syscall.NewLazyDLL("advapi32.dll")
+
+	// MSDN Documentation for service functions:
+	// https://learn.microsoft.com/en-us/windows/win32/services/service-functions
+	procStartServiceCtrlDispatcherW   = libAdvapi32.NewProc("StartServiceCtrlDispatcherW")
+	procRegisterServiceCtrlHandlerExW = libAdvapi32.NewProc("RegisterServiceCtrlHandlerExW")
+	procSetServiceStatus              = libAdvapi32.NewProc("SetServiceStatus")
+)
+
+// serviceStatus is a SERVICE_STATUS structure.
+type serviceStatus struct {
+	ServiceType             uint32
+	CurrentState            uint32
+	ControlsAccepted        uint32
+	Win32ExitCode           uint32
+	ServiceSpecificExitCode uint32
+	CheckPoint              uint32
+	WaitHint                uint32
+}
+
+// serviceTableEntry is a SERVICE_TABLE_ENTRYW structure.
+type serviceTableEntry struct {
+	Name *uint16
+	Proc uintptr
+}
+
+// A service is the state of the running service.  The service control
+// manager callbacks cannot carry state, so only one service can run in a
+// process.
+type service struct {
+	name   *uint16
+	run    func(stop <-chan struct{}) error
+	stop   chan struct{}
+	once   sync.Once
+	handle uintptr
+	err    error
+}
+
+// svc is the running service.
+var svc *service
+
+// runService runs run as the Windows service name, until the service control
+// manager stops the service.  runService fails if the process was not started
+// by the service control manager.
+func runService(name string, run func(stop <-chan struct{}) error) error {
+	n, err := syscall.UTF16PtrFromString(name)
+	if err != nil {
+		return err
+	}
+
+	svc = &service{
+		name: n,
+		run:  run,
+		stop: make(chan struct{}),
+	}
+
+	table := []serviceTableEntry{
+		{Name: n, Proc: syscall.NewCallback(serviceMain)},
+		// The table is terminated by an entry with null members.
+		{},
+	}
+
+	// StartServiceCtrlDispatcherW calls serviceMain on another thread, and
+	// returns once the service has stopped.
+	r1, _, err := procStartServiceCtrlDispatcherW.Call(uintptr(unsafe.Pointer(&table[0])))
+	if r1 == 0 {
+		return err
+	}
+
+	return svc.err
+}
+
+// serviceMain is the ServiceMain callback of the service.
+func serviceMain(argc, argv uintptr) uintptr {
+	h, _, err := procRegisterServiceCtrlHandlerExW.Call(
+		uintptr(unsafe.Pointer(svc.name)),
+		syscall.NewCallback(serviceHandler),
+		0,
+	)
+	if h == 0 {
+		svc.err = err
+		return 0
+	}
+
+	svc.handle = h
+	svc.setStatus(serviceRunning, serviceAcceptStop|serviceAcceptShutdown, 0)
+
+	svc.err = svc.run(svc.stop)
+
+	// Report the same exit code the command would have exited with.
+	var code int
+	if svc.err != nil {
+		log.Printf("service failed: %v", svc.err)
+		code = cli.ExitCode(svc.err)
+	}
+
+	svc.setStatus(serviceStopped, 0, code)
+	return 0
+}
+
+// serviceHandler is the HandlerEx callback of the service.
+func serviceHandler(control, eventType, eventData, context uintptr) uintptr {
+	switch control {
+	case serviceControlStop, serviceControlShutdown:
+		svc.setStatus(serviceStopPending, 0, 0)
+		svc.once.Do(func() { close(svc.stop) })
+		return 0
+	case serviceControlInterrogate:
+		return 0
+	default:
+		return errorCallNotImplemented
+	}
+}
+
+// setStatus reports the state of the service to the service control manager.
+// A non-zero code is reported as a service-specific exit code.
+func (s *service) setStatus(state, accepts uint32, code int) {
+	st := serviceStatus{
+		ServiceType:      serviceWin32OwnProcess,
+		CurrentState:     state,
+		ControlsAccepted: accepts,
+	}
+
+	if code != 0 {
+		st.Win32ExitCode = errorServiceSpecificError
+		st.ServiceSpecificExitCode = uint32(code)
+	}
+
+	// There is nothing more to do if the status cannot be reported.
+	_, _, _ = procSetServiceStatus.Call(s.handle, uintptr(unsafe.Pointer(&st)))
+}
@@ -0,0 +1,94 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"errors"
+	"flag"
+	"log"
+	"os"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// Exit codes returned by all commands, so that orchestration tools can
+// determine the cause of a failure without parsing error messages.
+const (
+	// ExitOK indicates success.
+	ExitOK = 0
+
+	// ExitFailure indicates a failure which has no more specific exit
+	// code, such as an error writing output or invalid command-line
+	// arguments.
+	ExitFailure = 1
+
+	// ExitPermission indicates that SMBIOS data could not be accessed due
+	// to insufficient permissions, or because access was disabled.
+	ExitPermission = 2
+
+	// ExitUnsupported indicates that SMBIOS data cannot be acquired on this
+	// platform.
+	ExitUnsupported = 3
+
+	// ExitParse indicates that SMBIOS data could not be parsed.
+	ExitParse = 4
+
+	// ExitNotFound indicates that SMBIOS data, or the structures requested
+	// by the user, were not found.
+	ExitNotFound = 5
+)
+
+// ExitCode returns the exit code which describes an error returned while
+// parsing command-line arguments or acquiring or decoding SMBIOS data, such
+// as from smbios.Stream.  Errors which have no more specific exit code
+// produce ExitFailure.
+func ExitCode(err error) int {
+	var (
+		lerr *smbios.LengthError
+		cerr *smbios.StructureCountError
+	)
+
+	switch {
+	case err == nil, errors.Is(err, flag.ErrHelp):
+		return ExitOK
+	case errors.Is(err, os.ErrPermission), errors.Is(err, smbios.ErrDevMemDisabled):
+		return ExitPermission
+	case errors.Is(err, smbios.ErrUnsupported):
+		return ExitUnsupported
+	case errors.Is(err, os.ErrNotExist), errors.Is(err, smbios.ErrEntryPointNotFound):
+		return ExitNotFound
+	case errors.Is(err, smbios.ErrInvalidEntryPoint), errors.As(err, &lerr), errors.As(err, &cerr):
+		return ExitParse
+	default:
+		return ExitFailure
+	}
+}
+
+// ParseFlags parses the command-line arguments args using fs, which must be
+// created with flag.ContinueOnError, and exits if they cannot be parsed or
+// help was requested.  Package flag's ExitOnError would exit with status 2,
+// which is ExitPermission, so invalid arguments exit with ExitFailure instead.
+func ParseFlags(fs *flag.FlagSet, args []string) {
+	if err := fs.Parse(args); err != nil {
+		os.Exit(ExitCode(err))
+	}
+}
+
+// Fatalf logs a formatted message, as log.Fatalf does, and exits with the
+// specified exit code.
+func Fatalf(code int, format string, v ...interface{}) {
+	log.Printf(format, v...)
+	os.Exit(code)
+}
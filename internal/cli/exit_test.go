@@ -0,0 +1,152 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli_test
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/internal/cli"
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{
+			name: "OK",
+			code: cli.ExitOK,
+		},
+		{
+			name: "help",
+			err:  flag.ErrHelp,
+			code: cli.ExitOK,
+		},
+		{
+			name: "flag",
+			err:  parseFlags("-bogus"),
+			code: cli.ExitFailure,
+		},
+		{
+			name: "other",
+			err:  errors.New("some error"),
+			code: cli.ExitFailure,
+		},
+		{
+			name: "permission",
+			err:  &os.PathError{Op: "open", Path: "/dev/mem", Err: os.ErrPermission},
+			code: cli.ExitPermission,
+		},
+		{
+			name: "/dev/mem disabled",
+			err:  smbios.ErrDevMemDisabled,
+			code: cli.ExitPermission,
+		},
+		{
+			name: "unsupported",
+			err:  fmt.Errorf("not implemented: %w", smbios.ErrUnsupported),
+			code: cli.ExitUnsupported,
+		},
+		{
+			name: "not exist",
+			err:  &os.PathError{Op: "open", Path: "/dev/mem", Err: os.ErrNotExist},
+			code: cli.ExitNotFound,
+		},
+		{
+			name: "retried entry point not found",
+			err: &smbios.RetryError{
+				Errors: []error{
+					errors.New("some error"),
+					smbios.ErrEntryPointNotFound,
+				},
+			},
+			code: cli.ExitNotFound,
+		},
+		{
+			name: "invalid entry point",
+			err:  fmt.Errorf("failed to parse entry point: %w", smbios.ErrInvalidEntryPoint),
+			code: cli.ExitParse,
+		},
+		{
+			name: "length",
+			err:  fmt.Errorf("failed to decode: %w", &smbios.LengthError{Header: smbios.Header{Length: 2}}),
+			code: cli.ExitParse,
+		},
+		{
+			name: "structure count",
+			err:  &smbios.StructureCountError{Expected: 2, Decoded: 1},
+			code: cli.ExitParse,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := cli.ExitCode(tt.err); code != tt.code {
+				t.Fatalf("unexpected exit code: want %d, got %d", tt.code, code)
+			}
+		})
+	}
+}
+
+func TestExitCodeValues(t *testing.T) {
+	// The exit codes are documented in the README, and scripts rely on
+	// their values.
+	tests := []struct {
+		name       string
+		code, want int
+	}{
+		{name: "OK", code: cli.ExitOK, want: 0},
+		{name: "failure", code: cli.ExitFailure, want: 1},
+		{name: "permission", code: cli.ExitPermission, want: 2},
+		{name: "unsupported", code: cli.ExitUnsupported, want: 3},
+		{name: "parse", code: cli.ExitParse, want: 4},
+		{name: "not found", code: cli.ExitNotFound, want: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.code != tt.want {
+				t.Fatalf("unexpected exit code: want %d, got %d", tt.want, tt.code)
+			}
+		})
+	}
+}
+
+func TestExitCodeParseEntryPoint(t *testing.T) {
+	_, err := smbios.ParseEntryPoint(strings.NewReader("_XX_ not an entry point"))
+	if err == nil {
+		t.Fatal("expected an error, but none occurred")
+	}
+
+	if code := cli.ExitCode(err); code != cli.ExitParse {
+		t.Fatalf("unexpected exit code: want %d, got %d", cli.ExitParse, code)
+	}
+}
+
+// parseFlags parses args using an empty flag.FlagSet which discards its
+// output.
+func parseFlags(args ...string) error {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	return fs.Parse(args)
+}
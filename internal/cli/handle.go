@@ -0,0 +1,102 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ParseHandle parses an SMBIOS structure handle in hexadecimal form with a
+// "0x" prefix, such as "0x0042", or in decimal form, such as "66".
+func ParseHandle(s string) (uint16, error) {
+	// Leading zeros do not indicate octal, as they would with base 0.
+	base, digits := 10, s
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		base, digits = 16, s[2:]
+	}
+
+	h, err := strconv.ParseUint(digits, base, 16)
+	if err != nil {
+		return 0, fmt.Errorf("invalid SMBIOS handle %q: must be hexadecimal (0x0042) or decimal (66) in range 0-65535", s)
+	}
+
+	return uint16(h), nil
+}
+
+// FormatHandle formats an SMBIOS structure handle in both hexadecimal form,
+// as dmidecode does, and decimal form, such as "0x0042 (66)".
+func FormatHandle(h uint16) string {
+	return fmt.Sprintf("0x%04x (%d)", h, h)
+}
+
+// A HandleFilter is a flag.Value which selects SMBIOS structures by handle.
+// It accepts a comma-separated list of handles in any form accepted by
+// ParseHandle, and may be specified more than once.
+type HandleFilter struct {
+	handles map[uint16]bool
+}
+
+// String implements flag.Value.
+func (f *HandleFilter) String() string {
+	if f == nil {
+		return ""
+	}
+
+	hs := make([]int, 0, len(f.handles))
+	for h := range f.handles {
+		hs = append(hs, int(h))
+	}
+	sort.Ints(hs)
+
+	strs := make([]string, 0, len(hs))
+	for _, h := range hs {
+		strs = append(strs, fmt.Sprintf("0x%04x", h))
+	}
+
+	return strings.Join(strs, ",")
+}
+
+// Set implements flag.Value.
+func (f *HandleFilter) Set(s string) error {
+	if f.handles == nil {
+		f.handles = make(map[uint16]bool)
+	}
+
+	for _, field := range strings.Split(s, ",") {
+		h, err := ParseHandle(strings.TrimSpace(field))
+		if err != nil {
+			return err
+		}
+
+		f.handles[h] = true
+	}
+
+	return nil
+}
+
+// Match reports whether handle h is selected by the HandleFilter.  An empty
+// HandleFilter selects all handles.
+func (f *HandleFilter) Match(h uint16) bool {
+	return len(f.handles) == 0 || f.handles[h]
+}
+
+// Empty reports whether the HandleFilter selects all handles because no
+// handles were specified.
+func (f *HandleFilter) Empty() bool {
+	return f == nil || len(f.handles) == 0
+}
@@ -0,0 +1,100 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli_test
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/internal/cli"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseHandle(t *testing.T) {
+	tests := []struct {
+		s  string
+		h  uint16
+		ok bool
+	}{
+		{s: ""},
+		{s: "0x"},
+		{s: "foo"},
+		{s: "-1"},
+		{s: "65536"},
+		{s: "0x10000"},
+		{s: "66", h: 66, ok: true},
+		{s: "0042", h: 42, ok: true},
+		{s: "0x0042", h: 0x42, ok: true},
+		{s: "0X00ff", h: 0xff, ok: true},
+		{s: "65535", h: 0xffff, ok: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			h, err := cli.ParseHandle(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.h, h); diff != "" {
+				t.Fatalf("unexpected handle (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFormatHandle(t *testing.T) {
+	if diff := cmp.Diff("0x0042 (66)", cli.FormatHandle(66)); diff != "" {
+		t.Fatalf("unexpected handle (-want +got):\n%s", diff)
+	}
+}
+
+func TestHandleFilter(t *testing.T) {
+	var f cli.HandleFilter
+	if !f.Match(0x42) {
+		t.Fatal("empty filter should match all handles")
+	}
+	if !f.Empty() {
+		t.Fatal("filter should be empty")
+	}
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(&f, "handle", "")
+
+	if err := fs.Parse([]string{"-handle", "0x0042,17", "-handle", "0x0100"}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+
+	if f.Empty() {
+		t.Fatal("filter should not be empty")
+	}
+
+	if diff := cmp.Diff("0x0011,0x0042,0x0100", f.String()); diff != "" {
+		t.Fatalf("unexpected filter (-want +got):\n%s", diff)
+	}
+
+	for _, h := range []uint16{0x42, 17, 0x100} {
+		if !f.Match(h) {
+			t.Fatalf("filter should match handle 0x%04x", h)
+		}
+	}
+	if f.Match(0x43) {
+		t.Fatal("filter should not match handle 0x0043")
+	}
+}
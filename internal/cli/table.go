@@ -0,0 +1,124 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cli provides output formatting shared by the go-smbios commands.
+package cli
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// columnPadding is the number of spaces placed between columns.
+const columnPadding = 2
+
+// A Table formats rows of text into left-aligned columns.  Output is fully
+// determined by the input rows, so it is suitable for comparison in tests.
+type Table struct {
+	header []string
+	rows   [][]string
+}
+
+// NewTable creates a Table with the specified column headers.
+func NewTable(header ...string) *Table {
+	return &Table{header: header}
+}
+
+// AddRow adds a row of cells to the Table.  Missing cells are left blank and
+// extra cells beyond the number of headers are ignored.
+func (t *Table) AddRow(cells ...string) {
+	row := make([]string, len(t.header))
+	copy(row, cells)
+
+	t.rows = append(t.rows, row)
+}
+
+// WriteTo writes the formatted Table to w.
+func (t *Table) WriteTo(w io.Writer) (int64, error) {
+	return writeColumns(w, append([][]string{t.header}, t.rows...), len(t.header))
+}
+
+// A List formats key/value pairs with their values aligned in a column.
+type List struct {
+	rows [][]string
+}
+
+// NewList creates an empty List.
+func NewList() *List {
+	return &List{}
+}
+
+// Add adds a key/value pair to the List.
+func (l *List) Add(key, value string) {
+	l.rows = append(l.rows, []string{key + ":", value})
+}
+
+// WriteTo writes the formatted List to w.
+func (l *List) WriteTo(w io.Writer) (int64, error) {
+	return writeColumns(w, l.rows, 2)
+}
+
+// writeColumns writes rows of ncols cells to w as left-aligned columns.
+func writeColumns(w io.Writer, rows [][]string, ncols int) (int64, error) {
+	// Each column is as wide as its widest cell.
+	widths := make([]int, ncols)
+	for _, row := range rows {
+		for i, c := range row {
+			if n := utf8.RuneCountInString(c); n > widths[i] {
+				widths[i] = n
+			}
+		}
+	}
+
+	cw := &countWriter{w: w}
+	bw := bufio.NewWriter(cw)
+
+	for _, row := range rows {
+		var sb strings.Builder
+		for i, c := range row {
+			sb.WriteString(c)
+
+			// No padding after the final column so lines never end with
+			// trailing whitespace.
+			if i == len(row)-1 {
+				break
+			}
+
+			pad := widths[i] - utf8.RuneCountInString(c) + columnPadding
+			sb.WriteString(strings.Repeat(" ", pad))
+		}
+
+		line := strings.TrimRight(sb.String(), " ")
+		if _, err := bw.WriteString(line + "\n"); err != nil {
+			return cw.n, err
+		}
+	}
+
+	err := bw.Flush()
+	return cw.n, err
+}
+
+// A countWriter counts the number of bytes written to an io.Writer.
+type countWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countWriter) Write(b []byte) (int, error) {
+	n, err := cw.w.Write(b)
+	cw.n += int64(n)
+	return n, err
+}
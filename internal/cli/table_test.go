@@ -0,0 +1,102 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/internal/cli"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTable(t *testing.T) {
+	tests := []struct {
+		name   string
+		header []string
+		rows   [][]string
+		out    string
+	}{
+		{
+			name:   "header only",
+			header: []string{"LOCATOR", "SIZE"},
+			out:    "LOCATOR  SIZE\n",
+		},
+		{
+			name:   "aligned",
+			header: []string{"LOCATOR", "SIZE", "SPEED"},
+			rows: [][]string{
+				{"DIMM A1", "16 GiB", "2666 MT/s"},
+				{"CPU1_DIMM_B10", "8 GiB", "2400 MT/s"},
+				{"A2", "empty"},
+			},
+			out: "" +
+				"LOCATOR        SIZE    SPEED\n" +
+				"DIMM A1        16 GiB  2666 MT/s\n" +
+				"CPU1_DIMM_B10  8 GiB   2400 MT/s\n" +
+				"A2             empty\n",
+		},
+		{
+			name:   "extra cells",
+			header: []string{"A"},
+			rows:   [][]string{{"1", "2"}},
+			out:    "A\n1\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := cli.NewTable(tt.header...)
+			for _, r := range tt.rows {
+				tbl.AddRow(r...)
+			}
+
+			var buf bytes.Buffer
+			n, err := tbl.WriteTo(&buf)
+			if err != nil {
+				t.Fatalf("failed to write table: %v", err)
+			}
+
+			if want, got := int64(buf.Len()), n; want != got {
+				t.Fatalf("unexpected byte count: %d != %d", want, got)
+			}
+
+			if diff := cmp.Diff(tt.out, buf.String()); diff != "" {
+				t.Fatalf("unexpected output (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	l := cli.NewList()
+	l.Add("Manufacturer", "DigitalOcean")
+	l.Add("Product Name", "Droplet")
+	l.Add("UUID", "")
+
+	var buf bytes.Buffer
+	if _, err := l.WriteTo(&buf); err != nil {
+		t.Fatalf("failed to write list: %v", err)
+	}
+
+	want := "" +
+		"Manufacturer:  DigitalOcean\n" +
+		"Product Name:  Droplet\n" +
+		"UUID:\n"
+
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Fatalf("unexpected output (-want +got):\n%s", diff)
+	}
+}
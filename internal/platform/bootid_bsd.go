@@ -0,0 +1,34 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build dragonfly || freebsd || netbsd || openbsd
+// +build dragonfly freebsd netbsd openbsd
+
+package platform
+
+import (
+	"encoding/hex"
+	"syscall"
+)
+
+// bootID returns an identifier which is unique to the current boot.
+func bootID() (string, error) {
+	// The raw boot time structure is sufficient as an identifier.
+	s, err := syscall.Sysctl("kern.boottime")
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString([]byte(s)), nil
+}
@@ -0,0 +1,37 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !safe
+// +build !safe
+
+package platform
+
+import (
+	"strconv"
+	"time"
+)
+
+var procGetTickCount64 = libKernel32.NewProc("GetTickCount64")
+
+// bootID returns an identifier which is unique to the current boot.
+func bootID() (string, error) {
+	// GetTickCount64 cannot fail.
+	r1, _, _ := procGetTickCount64.Call()
+	uptime := time.Duration(r1) * time.Millisecond
+
+	// The boot time is derived from the current time and uptime, so round it
+	// to absorb small differences between calls.
+	boot := time.Now().Add(-uptime).Round(time.Minute)
+	return strconv.FormatInt(boot.Unix(), 10), nil
+}
@@ -0,0 +1,34 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows || safe
+// +build !windows safe
+
+package platform
+
+import (
+	"encoding/binary"
+	"fmt"
+	"runtime"
+)
+
+// firmwareTable is only implemented on Windows.
+func firmwareTable() ([]byte, binary.ByteOrder, error) {
+	return nil, nil, fmt.Errorf("reading SMBIOS firmware table not implemented on %q: %w", runtime.GOOS, ErrUnsupported)
+}
+
+// firmwareTableSize is only implemented on Windows.
+func firmwareTableSize() (int, error) {
+	return 0, fmt.Errorf("reading SMBIOS firmware table not implemented on %q: %w", runtime.GOOS, ErrUnsupported)
+}
@@ -12,15 +12,14 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package smbios
+//go:build !safe
+// +build !safe
+
+package platform
 
 import (
-	"bytes"
 	"encoding/binary"
-	"errors"
 	"fmt"
-	"io"
-	"io/ioutil"
 	"syscall"
 	"unsafe"
 )
@@ -33,12 +32,6 @@ import (
 // the C++ compiler.
 const firmwareTableProviderSigRSMB uint32 = 0x52534d42
 
-// smbiosDataHeaderSize is size of the "header" (non-variable) part of the
-// RawSMBIOSData struct. This serves as both the offset to the actual
-// SMBIOS table data, and the minimum possible size of a valid RawSMBIOSDATA
-// struct (with a table length of 0).
-const rawSMBIOSDataHeaderSize = 8
-
 var (
 	libKernel32 = syscall.NewLazyDLL("kernel32.dll")
 
@@ -60,54 +53,14 @@ func nativeEndian() binary.ByteOrder {
 	return binary.BigEndian
 }
 
-// windowsStream parses the data returned from GetSystemFirmwareTable('RSMB',...)
-// and returns a stream and entrypoint that can be used to decode the system's
-// SMBIOS table.
-//
-// When calling GetSystemFirmwareTable with FirmwareTableProviderSignature = "RSMB",
-// Windows will write a RawSMBIOSData struct into the output buffer.
-// Thus, `buf` is expected to contain a valid RawSMBIOSData structure.
-//
-//	From windows.h:
-//
-// 	struct RawSMBIOSData {
-//		BYTE 	Used20CallingMethod;
-//		BYTE	SMBIOSMajorVersion;
-//		BYTE 	SMBIOSMinorVersion;
-//		BYTE 	DMIRevision;
-//		DWORD 	Length;
-//		BYTE 	SMBIOSTableData[];
-//	}
-//
-// Note: a DWORD is equivalent to a uint32
-// See: https://msdn.microsoft.com/en-us/library/cc230318.aspx
-func windowsStream(buf []byte) (io.ReadCloser, EntryPoint, error) {
-	bufLen := uint32(len(buf))
-
-	// Do an additional check to make sure the actual amount written is sane.
-	if bufLen < rawSMBIOSDataHeaderSize {
-		return nil, nil, fmt.Errorf("GetSystemFirmwareTable wrote less data than expected: wrote %d bytes, expected at least 8 bytes", bufLen)
-	}
-
-	tableSize := nativeEndian().Uint32(buf[4:8])
-	if rawSMBIOSDataHeaderSize+tableSize > bufLen {
-		return nil, nil, errors.New("reported SMBIOS table size exceeds buffer")
-	}
-
-	entryPoint := &WindowsEntryPoint{
-		MajorVersion: buf[1],
-		MinorVersion: buf[2],
-		Revision:     buf[3],
-		Size:         tableSize,
-	}
-
-	tableBuff := buf[rawSMBIOSDataHeaderSize : rawSMBIOSDataHeaderSize+tableSize]
-
-	return ioutil.NopCloser(bytes.NewReader(tableBuff)), entryPoint, nil
-}
+// rawSMBIOSDataHeaderSize is size of the "header" (non-variable) part of the
+// RawSMBIOSData struct, and the minimum possible size of a valid
+// RawSMBIOSData struct.
+const rawSMBIOSDataHeaderSize = 8
 
-func stream() (io.ReadCloser, EntryPoint, error) {
-	// Call first with empty buffer to get size.
+// firmwareTableSize determines the size of the RawSMBIOSData structure by
+// calling GetSystemFirmwareTable with an empty buffer.
+func firmwareTableSize() (int, error) {
 	r1, _, err := procGetSystemFirmwareTable.Call(
 		uintptr(firmwareTableProviderSigRSMB), // FirmwareTableProviderSignature = 'RSMB'
 		0,                                     // FirmwareTableID = 0
@@ -123,16 +76,28 @@ func stream() (io.ReadCloser, EntryPoint, error) {
 	// Godoc for LazyProc.Call:
 	// https://golang.org/pkg/syscall/?GOOS=windows&GOARCH=amd64#LazyProc.Call
 	if r1 == 0 {
-		return nil, nil, fmt.Errorf("failed to determine size of buffer needed: %v", err)
+		return 0, fmt.Errorf("failed to determine size of buffer needed: %v", err)
 	}
 	if r1 < rawSMBIOSDataHeaderSize {
-		return nil, nil, fmt.Errorf("reported buffer size smaller than expected: reported %d, expected >= 8", r1)
+		return 0, fmt.Errorf("reported buffer size smaller than expected: reported %d, expected >= 8", r1)
 	}
 
-	bufferSize := uint32(r1)
+	return int(r1), nil
+}
+
+// firmwareTable retrieves the RawSMBIOSData structure using
+// GetSystemFirmwareTable.
+func firmwareTable() ([]byte, binary.ByteOrder, error) {
+	// Call first with empty buffer to get size.
+	size, err := firmwareTableSize()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bufferSize := uint32(size)
 	buffer := make([]byte, bufferSize)
 
-	r1, _, err = procGetSystemFirmwareTable.Call(
+	r1, _, err := procGetSystemFirmwareTable.Call(
 		uintptr(firmwareTableProviderSigRSMB), // FirmwareTableProviderSignature = 'RSMB'
 		0,                                     // FirmwareTableID = 0
 		uintptr(unsafe.Pointer(&buffer[0])),   // pFirmwareTableBuffer = &buffer
@@ -151,5 +116,5 @@ func stream() (io.ReadCloser, EntryPoint, error) {
 	// At this point, bytesWritten <= bufferSize, which means the call succeeded as
 	// per the MSDN documentation.
 
-	return windowsStream(buffer[:bytesWritten])
+	return buffer[:bytesWritten], nativeEndian(), nil
 }
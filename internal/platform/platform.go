@@ -0,0 +1,95 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package platform provides access to the operating system-specific locations
+// and APIs from which SMBIOS data is read.
+//
+// Each supported operating system implements Platform in its own files, so
+// that support for a new operating system can be added without modifying
+// package smbios, and tests can substitute a fake Platform.
+package platform
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// ErrUnsupported is returned, possibly wrapped, on platforms which provide no
+// way to acquire SMBIOS data.
+var ErrUnsupported = errors.New("SMBIOS access is not supported on this platform")
+
+// A Kind is the kind of a Source, which determines how its SMBIOS data is
+// read.
+type Kind int
+
+// Possible Kind values.
+const (
+	// Files sources provide the entry point and structure table in separate
+	// files, such as Linux sysfs.
+	Files Kind = iota
+
+	// Memory sources provide physical memory containing the entry point and
+	// structure table, such as /dev/mem.
+	Memory
+
+	// FirmwareTable sources provide a Windows RawSMBIOSData structure, read
+	// using Platform.FirmwareTable.
+	FirmwareTable
+)
+
+// A Source is a location from which SMBIOS data may be read.
+type Source struct {
+	Kind Kind
+
+	// Name is the location of the structure table, such as a file path or
+	// the name of an operating system API.
+	Name string
+
+	// EntryPoint is the path of the entry point file for Files sources.
+	EntryPoint string
+}
+
+// A Platform provides access to the SMBIOS data of an operating system.
+type Platform interface {
+	// Sources returns the locations of SMBIOS data in order of preference.
+	// If the platform provides no way to acquire SMBIOS data, Sources
+	// returns an error which wraps ErrUnsupported.
+	Sources() ([]Source, error)
+
+	// FirmwareTable reads the RawSMBIOSData structure for FirmwareTable
+	// sources and returns it with its byte order.
+	FirmwareTable() ([]byte, binary.ByteOrder, error)
+
+	// FirmwareTableSize returns the size of the RawSMBIOSData structure for
+	// FirmwareTable sources, without reading it.
+	FirmwareTableSize() (int, error)
+
+	// BootID returns an identifier which is unique to the current boot.
+	BootID() (string, error)
+}
+
+// Current returns the Platform for the operating system on which the program
+// is running.
+func Current() Platform {
+	return current{}
+}
+
+// current implements Platform using the per-operating system sources,
+// firmwareTable, and bootID functions.
+type current struct{}
+
+func (current) Sources() ([]Source, error)                       { return sources() }
+func (current) FirmwareTable() ([]byte, binary.ByteOrder, error) { return firmwareTable() }
+func (current) FirmwareTableSize() (int, error)                  { return firmwareTableSize() }
+func (current) BootID() (string, error)                          { return bootID() }
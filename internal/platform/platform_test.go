@@ -0,0 +1,50 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform_test
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/internal/platform"
+)
+
+func TestCurrent(t *testing.T) {
+	p := platform.Current()
+
+	srcs, err := p.Sources()
+	switch runtime.GOOS {
+	case "linux":
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		// sysfs must be preferred over /dev/mem.
+		if len(srcs) != 2 || srcs[0].Kind != platform.Files || srcs[1].Kind != platform.Memory {
+			t.Fatalf("unexpected sources: %+v", srcs)
+		}
+	case "js", "wasip1", "plan9":
+		if !errors.Is(err, platform.ErrUnsupported) {
+			t.Fatalf("expected unsupported platform, but got: %v", err)
+		}
+	}
+
+	if runtime.GOOS != "windows" {
+		if _, _, err := p.FirmwareTable(); !errors.Is(err, platform.ErrUnsupported) {
+			t.Fatalf("expected unsupported firmware table, but got: %v", err)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+// +build linux
+
+package platform
+
+const (
+	// sysfs locations for SMBIOS information.
+	sysfsDMI        = "/sys/firmware/dmi/tables/DMI"
+	sysfsEntryPoint = "/sys/firmware/dmi/tables/smbios_entry_point"
+)
+
+// sources returns the sysfs location present in modern kernels, followed by
+// the standard UNIX-like system method.
+func sources() ([]Source, error) {
+	return []Source{
+		{Kind: Files, Name: sysfsDMI, EntryPoint: sysfsEntryPoint},
+		{Kind: Memory, Name: devMem},
+	}, nil
+}
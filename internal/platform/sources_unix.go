@@ -12,19 +12,15 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-//+build dragonfly freebsd netbsd openbsd solaris
+//go:build dragonfly || freebsd || netbsd || openbsd || solaris
+// +build dragonfly freebsd netbsd openbsd solaris
 
 // Linux intentionally omitted because it has an alternative method that
-// is used before attempting /dev/mem access.  See stream_linux.go.
+// is used before attempting /dev/mem access.  See sources_linux.go.
 
-package smbios
+package platform
 
-import (
-	"io"
-)
-
-// stream opens the SMBIOS entry point and an SMBIOS structure stream.
-func stream() (io.ReadCloser, EntryPoint, error) {
-	// Use the standard UNIX-like system method.
-	return devMemStream()
+// sources returns the standard UNIX-like system method.
+func sources() ([]Source, error) {
+	return []Source{{Kind: Memory, Name: devMem}}, nil
 }
@@ -0,0 +1,28 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows && safe
+// +build windows,safe
+
+package platform
+
+import (
+	"fmt"
+)
+
+// sources is disabled by the safe build tag, because GetSystemFirmwareTable
+// cannot be called without package unsafe.
+func sources() ([]Source, error) {
+	return nil, fmt.Errorf("opening SMBIOS stream on Windows is disabled by the safe build tag: %w", ErrUnsupported)
+}
@@ -0,0 +1,309 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package report builds hardware reports from SMBIOS structures for the
+// go-smbios commands.
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/digitalocean/go-smbios/internal/cli"
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/humanize"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+)
+
+// A Report is a summary of the system's hardware.
+type Report struct {
+	Version           string                            `json:"version"`
+	EntryPoint        smbios.EntryPoint                 `json:"entry_point"`
+	BIOS              []*structures.BIOSInformation     `json:"bios,omitempty"`
+	System            []*structures.SystemInformation   `json:"system,omitempty"`
+	Baseboards        []*structures.Baseboard           `json:"baseboards,omitempty"`
+	Chassis           []*structures.Chassis             `json:"chassis,omitempty"`
+	Processors        []*structures.Processor           `json:"processors,omitempty"`
+	MemoryArrays      []*structures.PhysicalMemoryArray `json:"memory_arrays,omitempty"`
+	MemoryDevices     []*structures.MemoryDevice        `json:"memory_devices,omitempty"`
+	SystemSlots       []*structures.SystemSlot          `json:"system_slots,omitempty"`
+	PortConnectors    []*structures.PortConnector       `json:"port_connectors,omitempty"`
+	FirmwareInventory []*structures.FirmwareInventory   `json:"firmware_inventory,omitempty"`
+
+	// Errors are structures which could not be parsed, and conditions which
+	// indicate that the structure table does not describe the system's
+	// hardware.  They are reported rather than fatal so that a Report can be
+	// produced for systems with buggy firmware.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// New builds a Report from an entry point and decoded structures.
+func New(ep smbios.EntryPoint, ss []*smbios.Structure) *Report {
+	major, minor, rev := ep.Version()
+	r := &Report{
+		Version:    fmt.Sprintf("%d.%d.%d", major, minor, rev),
+		EntryPoint: ep,
+	}
+
+	for _, s := range ss {
+		var err error
+		switch s.Header.Type {
+		case structures.TypeBIOSInformation:
+			var v *structures.BIOSInformation
+			if v, err = structures.ParseBIOSInformation(s); err == nil {
+				r.BIOS = append(r.BIOS, v)
+			}
+		case structures.TypeSystemInformation:
+			var v *structures.SystemInformation
+			if v, err = structures.ParseSystemInformation(s); err == nil {
+				r.System = append(r.System, v)
+			}
+		case structures.TypeBaseboard:
+			var v *structures.Baseboard
+			if v, err = structures.ParseBaseboard(s); err == nil {
+				r.Baseboards = append(r.Baseboards, v)
+			}
+		case structures.TypeChassis:
+			var v *structures.Chassis
+			if v, err = structures.ParseChassis(s); err == nil {
+				r.Chassis = append(r.Chassis, v)
+			}
+		case structures.TypeProcessor:
+			var v *structures.Processor
+			if v, err = structures.ParseProcessor(s); err == nil {
+				r.Processors = append(r.Processors, v)
+			}
+		case structures.TypePhysicalMemoryArray:
+			var v *structures.PhysicalMemoryArray
+			if v, err = structures.ParsePhysicalMemoryArray(s); err == nil {
+				r.MemoryArrays = append(r.MemoryArrays, v)
+			}
+		case structures.TypeMemoryDevice:
+			var v *structures.MemoryDevice
+			if v, err = structures.ParseMemoryDevice(s); err == nil {
+				r.MemoryDevices = append(r.MemoryDevices, v)
+			}
+		case structures.TypeSystemSlot:
+			var v *structures.SystemSlot
+			if v, err = structures.ParseSystemSlot(s); err == nil {
+				r.SystemSlots = append(r.SystemSlots, v)
+			}
+		case structures.TypePortConnector:
+			var v *structures.PortConnector
+			if v, err = structures.ParsePortConnector(s); err == nil {
+				r.PortConnectors = append(r.PortConnectors, v)
+			}
+		case structures.TypeFirmwareInventory:
+			var v *structures.FirmwareInventory
+			if v, err = structures.ParseFirmwareInventory(s); err == nil {
+				r.FirmwareInventory = append(r.FirmwareInventory, v)
+			}
+		}
+
+		if err != nil {
+			r.Errors = append(r.Errors, fmt.Sprintf("handle 0x%04x: %v", s.Header.Handle, err))
+		}
+	}
+
+	if err := smbios.CheckStructures(ss); err != nil {
+		r.Errors = append(r.Errors, err.Error())
+	}
+
+	return r
+}
+
+// Text formats the Report as human-readable text.
+func (r *Report) Text() []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "SMBIOS %s\n", r.Version)
+
+	for _, v := range r.BIOS {
+		l := cli.NewList()
+		l.Add("Vendor", v.Vendor)
+		l.Add("Version", v.Version)
+		l.Add("Release Date", v.ReleaseDate)
+		if size, ok := v.ROMSizeBytes(); ok {
+			l.Add("ROM Size", humanize.Bytes(size))
+		}
+		if major, minor, ok := v.SystemBIOSRelease(); ok {
+			l.Add("BIOS Revision", fmt.Sprintf("%d.%d", major, minor))
+		}
+		writeSection(&buf, "BIOS", l)
+	}
+
+	for _, v := range r.System {
+		l := cli.NewList()
+		l.Add("Manufacturer", v.Manufacturer)
+		l.Add("Product Name", v.ProductName)
+		l.Add("Version", v.Version)
+		l.Add("Serial Number", v.SerialNumber)
+		if v.UUID.Present() {
+			l.Add("UUID", v.UUID.String())
+		}
+		l.Add("SKU Number", v.SKUNumber)
+		l.Add("Family", v.Family)
+		writeSection(&buf, "System", l)
+	}
+
+	for _, v := range r.Baseboards {
+		l := cli.NewList()
+		l.Add("Manufacturer", v.Manufacturer)
+		l.Add("Product", v.Product)
+		l.Add("Version", v.Version)
+		l.Add("Serial Number", v.SerialNumber)
+		l.Add("Asset Tag", v.AssetTag)
+		if v.BoardType != 0 {
+			l.Add("Type", v.BoardType.String())
+		}
+		l.Add("Location In Chassis", v.LocationInChassis)
+		writeSection(&buf, "Baseboard", l)
+	}
+
+	for _, v := range r.Chassis {
+		l := cli.NewList()
+		l.Add("Manufacturer", v.Manufacturer)
+		l.Add("Type", v.Type.String())
+		l.Add("Version", v.Version)
+		l.Add("Serial Number", v.SerialNumber)
+		l.Add("Asset Tag", v.AssetTag)
+		if v.SKUNumber != "" {
+			l.Add("SKU Number", v.SKUNumber)
+		}
+		writeSection(&buf, "Chassis", l)
+	}
+
+	if len(r.Processors) > 0 {
+		t := cli.NewTable("SOCKET", "MANUFACTURER", "VERSION", "CORES", "THREADS", "SPEED")
+		for _, v := range r.Processors {
+			if !v.Populated() {
+				t.AddRow(v.SocketDesignation, "empty")
+				continue
+			}
+
+			speed := "unknown"
+			if mhz, ok := v.EffectiveCurrentSpeed(); ok {
+				speed = humanize.Frequency(mhz)
+			}
+
+			t.AddRow(
+				v.SocketDesignation,
+				v.ProcessorManufacturer,
+				v.ProcessorVersion,
+				strconv.Itoa(v.Cores()),
+				strconv.Itoa(v.Threads()),
+				speed,
+			)
+		}
+		writeSection(&buf, "Processors", t)
+	}
+
+	// CXL-attached memory is listed separately from system memory.
+	var mds, cxl []*structures.MemoryDevice
+	for _, v := range r.MemoryDevices {
+		if v.IsCXL(r.MemoryArrays) {
+			cxl = append(cxl, v)
+		} else {
+			mds = append(mds, v)
+		}
+	}
+
+	if len(mds) > 0 {
+		writeSection(&buf, "Memory", memoryTable(mds))
+	}
+	if len(cxl) > 0 {
+		writeSection(&buf, "CXL Memory", memoryTable(cxl))
+	}
+
+	if len(r.SystemSlots) > 0 {
+		t := cli.NewTable("DESIGNATION", "TYPE", "USAGE", "ADDRESS")
+		for _, v := range r.SystemSlots {
+			t.AddRow(
+				v.SlotDesignation,
+				v.SlotType.String(),
+				v.CurrentUsage.String(),
+				fmt.Sprintf("%04x:%02x:%02x.%x", v.SegmentGroupNumber, v.BusNumber,
+					v.DeviceFunctionNumber>>3, v.DeviceFunctionNumber&0x7),
+			)
+		}
+		writeSection(&buf, "System Slots", t)
+	}
+
+	if len(r.PortConnectors) > 0 {
+		t := cli.NewTable("INTERNAL", "EXTERNAL", "PORT TYPE")
+		for _, v := range r.PortConnectors {
+			t.AddRow(
+				v.InternalReferenceDesignator,
+				v.ExternalReferenceDesignator,
+				v.PortType.String(),
+			)
+		}
+		writeSection(&buf, "Ports", t)
+	}
+
+	if len(r.FirmwareInventory) > 0 {
+		t := cli.NewTable("COMPONENT", "VERSION", "MANUFACTURER", "RELEASE DATE")
+		for _, v := range r.FirmwareInventory {
+			t.AddRow(v.ComponentName, v.Version, v.Manufacturer, v.ReleaseDate)
+		}
+		writeSection(&buf, "Firmware Inventory", t)
+	}
+
+	if len(r.Errors) > 0 {
+		fmt.Fprintln(&buf, "\nErrors")
+		for _, e := range r.Errors {
+			fmt.Fprintln(&buf, e)
+		}
+	}
+
+	return buf.Bytes()
+}
+
+// memoryTable formats memory devices as a table.
+func memoryTable(mds []*structures.MemoryDevice) *cli.Table {
+	t := cli.NewTable("LOCATOR", "BANK", "SIZE", "SPEED", "TYPE DETAIL", "MANUFACTURER", "PART NUMBER", "SERIAL NUMBER")
+	for _, v := range mds {
+		if v.Size == 0 {
+			t.AddRow(v.DeviceLocator, v.BankLocator, "empty")
+			continue
+		}
+
+		speed := "unknown"
+		if mts, ok := v.EffectiveSpeed(); ok {
+			speed = humanize.TransferRate(mts)
+		}
+
+		t.AddRow(
+			v.DeviceLocator,
+			v.BankLocator,
+			v.HumanSize(),
+			speed,
+			v.TypeDetail.String(),
+			v.Manufacturer,
+			v.PartNumber,
+			v.SerialNumber,
+		)
+	}
+
+	return t
+}
+
+// writeSection writes a titled section to buf.
+func writeSection(buf *bytes.Buffer, title string, w io.WriterTo) {
+	fmt.Fprintf(buf, "\n%s\n", title)
+
+	// Writes to a bytes.Buffer cannot fail.
+	_, _ = w.WriteTo(buf)
+}
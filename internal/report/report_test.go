@@ -0,0 +1,249 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package report_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/internal/report"
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestReport(t *testing.T) {
+	ss := []*smbios.Structure{
+		{
+			Header: smbios.Header{Type: 1, Length: 0x08, Handle: 0x0001},
+			Formatted: []byte{
+				0x01, 0x02, 0x00, 0x00,
+			},
+			Strings: []string{"DigitalOcean", "Droplet"},
+		},
+		{
+			// Too short to be a valid memory device.
+			Header:    smbios.Header{Type: 17, Length: 0x05, Handle: 0x0002},
+			Formatted: []byte{0x00},
+		},
+		{
+			Header: smbios.Header{Type: 127, Length: 0x04, Handle: 0x0003},
+		},
+	}
+
+	r := report.New(&smbios.WindowsEntryPoint{MajorVersion: 3, MinorVersion: 2}, ss)
+
+	if diff := cmp.Diff(1, len(r.System)); diff != "" {
+		t.Fatalf("unexpected number of systems (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(1, len(r.Errors)); diff != "" {
+		t.Fatalf("unexpected number of errors (-want +got):\n%s", diff)
+	}
+
+	want := `SMBIOS 3.2.0
+
+System
+Manufacturer:   DigitalOcean
+Product Name:   Droplet
+Version:
+Serial Number:
+SKU Number:
+Family:
+
+Errors
+handle 0x0002: expected SMBIOS memory device structure minimum length of at least 21, but got: 5
+`
+
+	if diff := cmp.Diff(want, string(r.Text())); diff != "" {
+		t.Fatalf("unexpected report text (-want +got):\n%s", diff)
+	}
+}
+
+func TestReportEmptyTable(t *testing.T) {
+	ss := []*smbios.Structure{{
+		Header: smbios.Header{Type: 127, Length: 0x04, Handle: 0x0001},
+	}}
+
+	r := report.New(&smbios.WindowsEntryPoint{MajorVersion: 3, MinorVersion: 2}, ss)
+
+	want := []string{smbios.ErrEmptyTable.Error()}
+	if diff := cmp.Diff(want, r.Errors); diff != "" {
+		t.Fatalf("unexpected errors (-want +got):\n%s", diff)
+	}
+}
+
+func TestReportJSONEntryPoint(t *testing.T) {
+	ss := []*smbios.Structure{{
+		Header: smbios.Header{Type: 127, Length: 0x04, Handle: 0x0001},
+	}}
+
+	r := report.New(&smbios.WindowsEntryPoint{Size: 4, MajorVersion: 3, MinorVersion: 2}, ss)
+
+	b, err := json.Marshal(r)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+
+	var v struct {
+		EntryPoint json.RawMessage `json:"entry_point"`
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		t.Fatalf("failed to unmarshal: %v", err)
+	}
+
+	want := `{"Kind":"windows","Version":"3.2.0","Size":4,"MajorVersion":3,"MinorVersion":2,"Revision":0}`
+	if diff := cmp.Diff(want, string(v.EntryPoint)); diff != "" {
+		t.Fatalf("unexpected entry point JSON (-want +got):\n%s", diff)
+	}
+}
+
+func TestReportEnumerationNames(t *testing.T) {
+	ss := []*smbios.Structure{
+		{
+			// Rack mount chassis.
+			Header:    smbios.Header{Type: 3, Length: 0x09, Handle: 0x0001},
+			Formatted: []byte{0x00, 0x17, 0x00, 0x00, 0x00},
+		},
+		{
+			// USB port.
+			Header:    smbios.Header{Type: 8, Length: 0x09, Handle: 0x0002},
+			Formatted: []byte{0x00, 0x00, 0x00, 0x12, 0x10},
+		},
+		{
+			// Available PCI Express 3 x16 slot.
+			Header:    smbios.Header{Type: 9, Length: 0x0c, Handle: 0x0003},
+			Formatted: []byte{0x00, 0xb6, 0x0d, 0x03, 0x04, 0x00, 0x00, 0x00},
+		},
+		{
+			Header: smbios.Header{Type: 127, Length: 0x04, Handle: 0x0004},
+		},
+	}
+
+	r := report.New(&smbios.WindowsEntryPoint{MajorVersion: 3, MinorVersion: 2}, ss)
+	if len(r.Errors) > 0 {
+		t.Fatalf("unexpected errors: %v", r.Errors)
+	}
+
+	text := string(r.Text())
+	for _, want := range []string{"Rack Mount Chassis", "USB", "PCI Express 3 x16", "Available"} {
+		if !strings.Contains(text, want) {
+			t.Fatalf("report text does not contain %q:\n%s", want, text)
+		}
+	}
+
+	b, err := json.Marshal(r)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+
+	for _, want := range []string{`"Type":"Rack Mount Chassis"`, `"PortType":"USB"`, `"SlotType":"PCI Express 3 x16"`, `"CurrentUsage":"Available"`} {
+		if !strings.Contains(string(b), want) {
+			t.Fatalf("report JSON does not contain %s:\n%s", want, b)
+		}
+	}
+}
+
+func TestReportCXL(t *testing.T) {
+	ss := []*smbios.Structure{
+		{
+			// Physical memory array on a CXL add-on card.
+			Header: smbios.Header{Type: 16, Length: 0x0f, Handle: 0x0010},
+			Formatted: []byte{
+				0xa4, 0x03, 0x03,
+				0x00, 0x00, 0x00, 0x01,
+				0xfe, 0xff,
+				0x01, 0x00,
+			},
+		},
+		{
+			Header: smbios.Header{Type: 17, Length: 0x15, Handle: 0x0011},
+			Formatted: []byte{
+				0x10, 0x00,
+				0xfe, 0xff,
+				0x40, 0x00,
+				0x40, 0x00,
+				0x00, 0x40,
+				0x09,
+				0x00,
+				0x01,
+				0x00,
+				0x22,
+				0x80, 0x20,
+			},
+			Strings: []string{"CXL0"},
+		},
+		{
+			Header: smbios.Header{Type: 127, Length: 0x04, Handle: 0x0012},
+		},
+	}
+
+	r := report.New(&smbios.WindowsEntryPoint{MajorVersion: 3, MinorVersion: 5}, ss)
+
+	want := `SMBIOS 3.5.0
+
+CXL Memory
+LOCATOR  BANK  SIZE    SPEED    TYPE DETAIL                        MANUFACTURER  PART NUMBER  SERIAL NUMBER
+CXL0           16 GiB  unknown  Synchronous Registered (Buffered)
+`
+
+	if diff := cmp.Diff(want, string(r.Text())); diff != "" {
+		t.Fatalf("unexpected report text (-want +got):\n%s", diff)
+	}
+}
+
+func TestReportMemorySize(t *testing.T) {
+	md := func(handle uint16, size uint16, locator string) *smbios.Structure {
+		return &smbios.Structure{
+			Header: smbios.Header{Type: 17, Length: 0x15, Handle: handle},
+			Formatted: []byte{
+				0xfe, 0xff,
+				0xfe, 0xff,
+				0x40, 0x00,
+				0x40, 0x00,
+				byte(size), byte(size >> 8),
+				0x09,
+				0x00,
+				0x01,
+				0x00,
+				0x1a,
+				0x80, 0x00,
+			},
+			Strings: []string{locator},
+		}
+	}
+
+	ss := []*smbios.Structure{
+		md(0x0011, 0x0000, "DIMM_A1"),
+		// Installed, but of unknown size.
+		md(0x0012, 0xffff, "DIMM_A2"),
+		{
+			Header: smbios.Header{Type: 127, Length: 0x04, Handle: 0x0013},
+		},
+	}
+
+	r := report.New(&smbios.WindowsEntryPoint{MajorVersion: 3, MinorVersion: 2}, ss)
+
+	want := `SMBIOS 3.2.0
+
+Memory
+LOCATOR  BANK  SIZE     SPEED    TYPE DETAIL  MANUFACTURER  PART NUMBER  SERIAL NUMBER
+DIMM_A1        empty
+DIMM_A2        Unknown  unknown  Synchronous
+`
+
+	if diff := cmp.Diff(want, string(r.Text())); diff != "" {
+		t.Fatalf("unexpected report text (-want +got):\n%s", diff)
+	}
+}
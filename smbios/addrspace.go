@@ -0,0 +1,146 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"fmt"
+	"io"
+	"sort"
+)
+
+// An AddrSpace provides access to physical memory by physical address, such
+// as from a captured memory image.  ReadAt reads len(b) bytes of physical
+// memory starting at physical address addr, with the semantics of
+// io.ReaderAt.
+type AddrSpace interface {
+	io.ReaderAt
+}
+
+// A Segment maps a contiguous range of physical memory to a range of a
+// memory image.
+type Segment struct {
+	// Addr is the physical address of the start of the Segment.
+	Addr int64
+
+	// Offset is the offset of the Segment in the memory image.
+	Offset int64
+
+	// Size is the size of the Segment in bytes.
+	Size int64
+}
+
+// NewSegmentAddrSpace creates an AddrSpace which maps physical memory to the
+// memory image r using segs, such as the program headers of an ELF core file.
+// Reads starting at physical addresses which are not present in any Segment
+// return an error, and reads which continue past the end of mapped memory
+// return the bytes read and io.EOF.
+func NewSegmentAddrSpace(r io.ReaderAt, segs []Segment) AddrSpace {
+	sorted := make([]Segment, len(segs))
+	copy(sorted, segs)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Addr < sorted[j].Addr
+	})
+
+	return &segmentAddrSpace{
+		r:    r,
+		segs: sorted,
+	}
+}
+
+var _ AddrSpace = &segmentAddrSpace{}
+
+// A segmentAddrSpace is an AddrSpace composed of Segments.
+type segmentAddrSpace struct {
+	r    io.ReaderAt
+	segs []Segment
+}
+
+// ReadAt implements AddrSpace.
+func (as *segmentAddrSpace) ReadAt(b []byte, addr int64) (int, error) {
+	var n int
+	for n < len(b) {
+		seg, ok := as.find(addr)
+		if !ok {
+			if n > 0 {
+				// The read ran off the end of the mapped memory, which
+				// io.ReaderAt reports as a short read at EOF.
+				return n, io.EOF
+			}
+
+			return n, fmt.Errorf("physical address %#x is not present in address space", addr)
+		}
+
+		// Read as much as possible from this segment, and continue with the
+		// next segment if the read spans multiple segments.
+		l := seg.Addr + seg.Size - addr
+		if rem := int64(len(b) - n); rem < l {
+			l = rem
+		}
+
+		nn, err := as.r.ReadAt(b[n:n+int(l)], seg.Offset+addr-seg.Addr)
+		n += nn
+		addr += int64(nn)
+
+		if err != nil && !(err == io.EOF && int64(nn) == l) {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
+
+// find finds the Segment which contains addr.
+func (as *segmentAddrSpace) find(addr int64) (Segment, bool) {
+	i := sort.Search(len(as.segs), func(i int) bool {
+		return as.segs[i].Addr+as.segs[i].Size > addr
+	})
+
+	if i == len(as.segs) || as.segs[i].Addr > addr {
+		return Segment{}, false
+	}
+
+	return as.segs[i], true
+}
+
+var _ AddrSpace = &translatedAddrSpace{}
+
+// A translatedAddrSpace is an AddrSpace which uses an AddressTranslator to
+// locate physical memory in an io.ReadSeeker.
+type translatedAddrSpace struct {
+	rs        io.ReadSeeker
+	translate AddressTranslator
+}
+
+// ReadAt implements AddrSpace.  Only the start address of each read is
+// translated, so each read must be contiguous in the underlying image.
+func (as *translatedAddrSpace) ReadAt(b []byte, addr int64) (int, error) {
+	off, err := as.translate(addr)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := as.rs.Seek(off, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	n, err := io.ReadFull(as.rs, b)
+	if err == io.ErrUnexpectedEOF {
+		// Short reads at the end of the image are reported as io.EOF, per
+		// io.ReaderAt.
+		err = io.EOF
+	}
+
+	return n, err
+}
@@ -0,0 +1,148 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSegmentAddrSpace(t *testing.T) {
+	image := []byte("....abcdefgh..ijkl")
+
+	// Segments are deliberately out of order.
+	as := smbios.NewSegmentAddrSpace(bytes.NewReader(image), []smbios.Segment{
+		{Addr: 0x1008, Offset: 14, Size: 4},
+		{Addr: 0x1000, Offset: 4, Size: 8},
+	})
+
+	tests := []struct {
+		name string
+		addr int64
+		n    int
+		b    string
+		eof  bool
+		ok   bool
+	}{
+		{
+			name: "before first segment",
+			addr: 0x0fff,
+			n:    1,
+		},
+		{
+			name: "past last segment",
+			addr: 0x100a,
+			n:    4,
+			b:    "kl",
+			eof:  true,
+		},
+		{
+			name: "one segment",
+			addr: 0x1002,
+			n:    4,
+			b:    "cdef",
+			ok:   true,
+		},
+		{
+			name: "spans segments",
+			addr: 0x1006,
+			n:    6,
+			b:    "ghijkl",
+			ok:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := make([]byte, tt.n)
+			n, err := as.ReadAt(b, tt.addr)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+			if diff := cmp.Diff(tt.eof, err == io.EOF); diff != "" {
+				t.Fatalf("unexpected EOF (-want +got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tt.b, string(b[:n])); diff != "" {
+				t.Fatalf("unexpected data (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestStreamFromAddrSpace(t *testing.T) {
+	table := []byte{
+		0x01, 0x05, 0x01, 0x00,
+		0x01,
+		's', 'e', 'r', 'i', 'a', 'l', 0x00,
+		0x00,
+
+		127, 0x04, 0x02, 0x00,
+		0x00,
+		0x00,
+	}
+
+	const tableAddr = 0x7af09000
+
+	ep := &smbios.EntryPoint32Bit{
+		Major:                 2,
+		Minor:                 8,
+		StructureTableAddress: tableAddr,
+	}
+	if err := ep.Recalculate(table); err != nil {
+		t.Fatalf("failed to recalculate entry point: %v", err)
+	}
+
+	epb, err := ep.MarshalBinary()
+	if err != nil {
+		t.Fatalf("failed to marshal entry point: %v", err)
+	}
+
+	// The image contains the BIOS area followed by the structure table, as
+	// might be found in a memory dump with only some memory captured.  The
+	// entry point lies less than 64 bytes before the end of the BIOS area.
+	const biosSize = 0x10000
+	image := make([]byte, biosSize+len(table))
+	copy(image[biosSize-0x20:], epb)
+	copy(image[biosSize:], table)
+
+	as := smbios.NewSegmentAddrSpace(bytes.NewReader(image), []smbios.Segment{
+		{Addr: 0xf0000, Offset: 0, Size: biosSize},
+		{Addr: tableAddr, Offset: biosSize, Size: int64(len(table))},
+	})
+
+	rc, _, err := smbios.StreamFromAddrSpace(as)
+	if err != nil {
+		t.Fatalf("failed to open stream: %v", err)
+	}
+	defer rc.Close()
+
+	ss, err := smbios.NewDecoder(rc).Decode()
+	if err != nil {
+		t.Fatalf("failed to decode structures: %v", err)
+	}
+
+	if diff := cmp.Diff([]string{"serial"}, ss[0].Strings); diff != "" {
+		t.Fatalf("unexpected strings (-want +got):\n%s", diff)
+	}
+}
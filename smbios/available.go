@@ -0,0 +1,62 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"os"
+
+	"github.com/digitalocean/go-smbios/internal/platform"
+)
+
+// ErrUnsupported is returned, possibly wrapped, by Stream and Available on
+// platforms which provide no way to acquire SMBIOS data.
+var ErrUnsupported = platform.ErrUnsupported
+
+// SourceInfo describes the operating system-specific location from which
+// Stream reads SMBIOS data.
+type SourceInfo struct {
+	// Source is the location which was checked, such as a file path or the
+	// name of an operating system API.  Source is empty if no location is
+	// supported on this platform.
+	Source string
+
+	// Err explains why SMBIOS data is not available from Source, if it is
+	// not.
+	Err error
+}
+
+// Available reports whether SMBIOS data appears to be available from the
+// location which Stream would use, without reading or decoding the SMBIOS
+// table.  Callers can use Available to cheaply detect support for SMBIOS
+// before scheduling heavier work.
+//
+// A true result does not guarantee that Stream will succeed, such as if the
+// SMBIOS data itself is malformed.
+func Available() (bool, SourceInfo) {
+	info := available(platform.Current())
+	return info.Err == nil, info
+}
+
+// checkOpen checks whether file can be opened for reading without reading
+// any of its contents.
+func checkOpen(file string) SourceInfo {
+	f, err := os.Open(file)
+	if err != nil {
+		return SourceInfo{Source: file, Err: err}
+	}
+
+	_ = f.Close()
+	return SourceInfo{Source: file}
+}
@@ -0,0 +1,44 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func Test_checkOpen(t *testing.T) {
+	dir, err := ioutil.TempDir("", "go-smbios-available")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "DMI")
+
+	if info := checkOpen(file); !os.IsNotExist(info.Err) || info.Source != file {
+		t.Fatalf("expected not exist error for %q, but got: %+v", file, info)
+	}
+
+	if err := ioutil.WriteFile(file, []byte{127, 0x04, 0x00, 0x00, 0x00, 0x00}, 0600); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	if info := checkOpen(file); info.Err != nil || info.Source != file {
+		t.Fatalf("expected %q to be available, but got: %+v", file, info)
+	}
+}
@@ -0,0 +1,255 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bom
+
+import (
+	"strings"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/manufacturer"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+)
+
+// Version is the version of the BOM JSON format.
+const Version = 1
+
+// A ComponentType is the kind of hardware described by a Component.
+type ComponentType string
+
+// Possible ComponentType values.
+const (
+	ComponentFirmware    ComponentType = "firmware"
+	ComponentSystem      ComponentType = "system"
+	ComponentBaseboard   ComponentType = "baseboard"
+	ComponentChassis     ComponentType = "chassis"
+	ComponentProcessor   ComponentType = "processor"
+	ComponentMemory      ComponentType = "memory"
+	ComponentPowerSupply ComponentType = "power-supply"
+	ComponentTPM         ComponentType = "tpm"
+)
+
+// A BOM is a hardware bill of materials.
+type BOM struct {
+	// Version is the version of the BOM format, and is always set to
+	// the Version constant.
+	Version int `json:"version"`
+
+	// UUID is the system UUID, if one is present.
+	UUID string `json:"uuid,omitempty"`
+
+	// Components are the hardware components of the system, in the order
+	// their structures appear in the SMBIOS structure table.
+	Components []Component `json:"components"`
+}
+
+// A Component is a single hardware component.  Fields which are not reported
+// by firmware are left empty.
+type Component struct {
+	Type   ComponentType `json:"type"`
+	Handle uint16        `json:"handle"`
+
+	// Location identifies where the component is installed, such as a
+	// processor socket or memory device locator.
+	Location string `json:"location,omitempty"`
+
+	// Manufacturer is the manufacturer string reported by firmware, and
+	// Vendor is its normalized form, if the manufacturer is known.
+	Manufacturer string              `json:"manufacturer,omitempty"`
+	Vendor       manufacturer.Vendor `json:"vendor,omitempty"`
+
+	Model        string `json:"model,omitempty"`
+	PartNumber   string `json:"partNumber,omitempty"`
+	SerialNumber string `json:"serialNumber,omitempty"`
+	AssetTag     string `json:"assetTag,omitempty"`
+	Version      string `json:"version,omitempty"`
+}
+
+// New generates a BOM from SMBIOS structures.  Empty memory device slots,
+// unpopulated processor sockets, and absent power supplies are omitted.
+func New(ss []*smbios.Structure) (*BOM, error) {
+	b := &BOM{
+		Version:    Version,
+		Components: []Component{},
+	}
+
+	for _, s := range ss {
+		c, ok, err := b.component(s)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+
+		c.Handle = s.Header.Handle
+		c.normalize()
+		b.Components = append(b.Components, c)
+	}
+
+	return b, nil
+}
+
+// component produces a Component from s, if s describes a component.  b's
+// system UUID is set if s is a System Information structure.
+func (b *BOM) component(s *smbios.Structure) (Component, bool, error) {
+	switch s.Header.Type {
+	case structures.TypeBIOSInformation:
+		v, err := structures.ParseBIOSInformation(s)
+		if err != nil {
+			return Component{}, false, err
+		}
+
+		return Component{
+			Type:         ComponentFirmware,
+			Manufacturer: v.Vendor,
+			Version:      v.Version,
+		}, true, nil
+	case structures.TypeSystemInformation:
+		v, err := structures.ParseSystemInformation(s)
+		if err != nil {
+			return Component{}, false, err
+		}
+
+		if b.UUID == "" && v.UUID.Present() {
+			b.UUID = v.UUID.String()
+		}
+
+		return Component{
+			Type:         ComponentSystem,
+			Manufacturer: v.Manufacturer,
+			Model:        v.ProductName,
+			PartNumber:   v.SKUNumber,
+			SerialNumber: v.SerialNumber,
+			Version:      v.Version,
+		}, true, nil
+	case structures.TypeBaseboard:
+		v, err := structures.ParseBaseboard(s)
+		if err != nil {
+			return Component{}, false, err
+		}
+
+		return Component{
+			Type:         ComponentBaseboard,
+			Location:     v.LocationInChassis,
+			Manufacturer: v.Manufacturer,
+			Model:        v.Product,
+			SerialNumber: v.SerialNumber,
+			AssetTag:     v.AssetTag,
+			Version:      v.Version,
+		}, true, nil
+	case structures.TypeChassis:
+		v, err := structures.ParseChassis(s)
+		if err != nil {
+			return Component{}, false, err
+		}
+
+		return Component{
+			Type:         ComponentChassis,
+			Manufacturer: v.Manufacturer,
+			PartNumber:   v.SKUNumber,
+			SerialNumber: v.SerialNumber,
+			AssetTag:     v.AssetTag,
+			Version:      v.Version,
+		}, true, nil
+	case structures.TypeProcessor:
+		v, err := structures.ParseProcessor(s)
+		if err != nil {
+			return Component{}, false, err
+		}
+		if !v.Populated() {
+			return Component{}, false, nil
+		}
+
+		return Component{
+			Type:         ComponentProcessor,
+			Location:     v.SocketDesignation,
+			Manufacturer: v.ProcessorManufacturer,
+			Model:        v.ProcessorVersion,
+			PartNumber:   v.PartNumber,
+			SerialNumber: v.SerialNumber,
+			AssetTag:     v.AssetTag,
+		}, true, nil
+	case structures.TypeMemoryDevice:
+		v, err := structures.ParseMemoryDevice(s)
+		if err != nil {
+			return Component{}, false, err
+		}
+		if v.Size == 0 {
+			return Component{}, false, nil
+		}
+
+		return Component{
+			Type:         ComponentMemory,
+			Location:     v.DeviceLocator,
+			Manufacturer: v.Manufacturer,
+			PartNumber:   v.PartNumber,
+			SerialNumber: v.SerialNumber,
+			AssetTag:     v.AssetTag,
+			Version:      v.FirmwareVersion,
+		}, true, nil
+	case structures.TypeSystemPowerSupply:
+		v, err := structures.ParseSystemPowerSupply(s)
+		if err != nil {
+			return Component{}, false, err
+		}
+		if !v.Present() {
+			return Component{}, false, nil
+		}
+
+		return Component{
+			Type:         ComponentPowerSupply,
+			Location:     v.Location,
+			Manufacturer: v.Manufacturer,
+			Model:        v.DeviceName,
+			PartNumber:   v.ModelPartNumber,
+			SerialNumber: v.SerialNumber,
+			AssetTag:     v.AssetTag,
+			Version:      v.RevisionLevel,
+		}, true, nil
+	case structures.TypeTPMDevice:
+		v, err := structures.ParseTPMDevice(s)
+		if err != nil {
+			return Component{}, false, err
+		}
+
+		return Component{
+			Type:         ComponentTPM,
+			Manufacturer: v.Vendor(),
+			Model:        v.Description,
+			Version:      v.FirmwareVersion(),
+		}, true, nil
+	default:
+		return Component{}, false, nil
+	}
+}
+
+// normalize trims whitespace from c's identifiers and sets its Vendor.
+func (c *Component) normalize() {
+	for _, s := range []*string{
+		&c.Location,
+		&c.Manufacturer,
+		&c.Model,
+		&c.PartNumber,
+		&c.SerialNumber,
+		&c.AssetTag,
+		&c.Version,
+	} {
+		*s = strings.TrimSpace(*s)
+	}
+
+	if v, ok := manufacturer.Normalize(c.Manufacturer); ok {
+		c.Vendor = v
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bom_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/bom"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNew(t *testing.T) {
+	ss := []*smbios.Structure{
+		// System Information with UUID.
+		{
+			Header: smbios.Header{Type: 1, Length: 0x19, Handle: 0x0001},
+			Formatted: []byte{
+				0x01, 0x02, 0x00, 0x03,
+				0x00, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77,
+				0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff,
+				0x06,
+			},
+			Strings: []string{"Dell Inc.", "PowerEdge R640", " ABC123 "},
+		},
+		// Populated processor.
+		{
+			Header: smbios.Header{Type: 4, Length: 0x1a, Handle: 0x0400},
+			Formatted: []byte{
+				0x01, 0x03, 0xb3, 0x02,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x41, 0x00,
+			},
+			Strings: []string{"CPU1", "Intel(R) Corporation", "Xeon"},
+		},
+		// Unpopulated processor.
+		{
+			Header:    smbios.Header{Type: 4, Length: 0x1a, Handle: 0x0401},
+			Formatted: make([]byte, 0x1a-4),
+		},
+		// Installed and empty memory devices.
+		{
+			Header: smbios.Header{Type: 17, Length: 0x1b, Handle: 0x1100},
+			Formatted: []byte{
+				0x00, 0x10, 0xfe, 0xff, 0x48, 0x00, 0x40, 0x00,
+				0x00, 0x40, 0x09, 0x00, 0x01, 0x00, 0x1a, 0x80,
+				0x00, 0x40, 0x0b, 0x02, 0x03, 0x00, 0x04, 0x00,
+			},
+			Strings: []string{"A1", "Samsung", "12345678", "M393A2K43BB1-CTD"},
+		},
+		{
+			Header: smbios.Header{Type: 17, Length: 0x15, Handle: 0x1101},
+			Formatted: []byte{
+				0x00, 0x10, 0xfe, 0xff, 0x48, 0x00, 0x40, 0x00,
+				0x00, 0x00, 0x09, 0x00, 0x01, 0x00, 0x1a, 0x80,
+				0x00,
+			},
+			Strings: []string{"A2"},
+		},
+		// An unrelated structure.
+		{
+			Header: smbios.Header{Type: 127, Length: 0x04, Handle: 0xfeff},
+		},
+	}
+
+	b, err := bom.New(ss)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := &bom.BOM{
+		Version: bom.Version,
+		UUID:    "33221100-5544-7766-8899-aabbccddeeff",
+		Components: []bom.Component{
+			{
+				Type:         bom.ComponentSystem,
+				Handle:       0x0001,
+				Manufacturer: "Dell Inc.",
+				Vendor:       "dell",
+				Model:        "PowerEdge R640",
+				SerialNumber: "ABC123",
+			},
+			{
+				Type:         bom.ComponentProcessor,
+				Handle:       0x0400,
+				Location:     "CPU1",
+				Manufacturer: "Intel(R) Corporation",
+				Vendor:       "intel",
+				Model:        "Xeon",
+			},
+			{
+				Type:         bom.ComponentMemory,
+				Handle:       0x1100,
+				Location:     "A1",
+				Manufacturer: "Samsung",
+				Vendor:       "samsung",
+				PartNumber:   "M393A2K43BB1-CTD",
+				SerialNumber: "12345678",
+			},
+		},
+	}
+
+	if diff := cmp.Diff(want, b); diff != "" {
+		t.Fatalf("unexpected BOM (-want +got):\n%s", diff)
+	}
+}
+
+func TestNewError(t *testing.T) {
+	ss := []*smbios.Structure{{
+		Header: smbios.Header{Type: 17, Length: 0x05},
+	}}
+
+	if _, err := bom.New(ss); err == nil {
+		t.Fatal("expected an error, but none occurred")
+	}
+}
+
+func TestBOMMarshalJSON(t *testing.T) {
+	b, err := bom.New(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out, err := json.Marshal(b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if diff := cmp.Diff(`{"version":1,"components":[]}`, string(out)); diff != "" {
+		t.Fatalf("unexpected JSON (-want +got):\n%s", diff)
+	}
+}
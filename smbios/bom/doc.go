@@ -0,0 +1,20 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bom generates a hardware bill of materials from SMBIOS structures.
+//
+// A BOM lists the identifiable hardware components of a system, such as its
+// baseboard, processors, memory modules, power supplies, and TPM, with
+// normalized identifiers suitable for supply-chain tracking.
+package bom
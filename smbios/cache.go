@@ -0,0 +1,237 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// WithCache enables caching of SMBIOS data in the specified file for the
+// duration of the current boot.  When the cache file was written during the
+// current boot, Stream returns its contents without accessing the operating
+// system's SMBIOS data.  Otherwise, Stream reads SMBIOS data as usual and
+// replaces the cache file's contents.
+//
+// Caching benefits callers which invoke Stream frequently, such as short-lived
+// command line tools on platforms where acquiring SMBIOS data is expensive.
+// Caching is disabled by default.  Errors while reading or writing the cache
+// file are ignored, and caching is skipped entirely on platforms where the
+// current boot cannot be identified.
+//
+// The cache file also records the options which affect how SMBIOS data is
+// acquired, such as WithSources, WithVerification, WithEntryPointPreference,
+// and WithoutDevMem, and is only used when the same options are in effect.
+//
+// The cache file contains the same data as the SMBIOS table, which may include
+// serial numbers and other identifiers, and is created with permissions
+// which only allow access by its owner.  On Unix-like platforms, an existing
+// cache file is ignored unless it is a regular file owned by the current user
+// and inaccessible to other users.
+func WithCache(file string) StreamOption {
+	return func(c *streamConfig) {
+		c.cache = file
+	}
+}
+
+// cacheFormat is the version of the serialized format of a cache file.  Cache
+// files written using other formats are ignored.
+const cacheFormat = 4
+
+// cacheEntry is the serialized format of a cache file.
+type cacheEntry struct {
+	// Format is the version of the format, cacheFormat.
+	Format int `json:"format"`
+
+	// BootID identifies the boot during which the entry was written.
+	BootID string `json:"boot_id"`
+
+	// Config describes the options used to acquire the SMBIOS data, as
+	// returned by streamConfig.cacheConfig.
+	Config string `json:"config"`
+
+	// Source is the location from which the SMBIOS data was read.
+	Source string `json:"source"`
+
+	// Kind determines which EntryPoint type is stored in EntryPoint.
+	Kind       string          `json:"kind"`
+	EntryPoint json.RawMessage `json:"entry_point"`
+
+	Table []byte `json:"table"`
+}
+
+// Kinds of EntryPoint stored in a cacheEntry.
+const (
+	kind32      = "32-bit"
+	kind64      = "64-bit"
+	kindWindows = "windows"
+)
+
+// cacheConfig describes the options in c which affect how SMBIOS data is
+// acquired, so that data cached using other options is not reused.
+func (c *streamConfig) cacheConfig() string {
+	sources := "default"
+	if c.selectSources {
+		sources = fmt.Sprint(c.sources)
+	}
+
+	return fmt.Sprintf("sources=%s verify=%t preference=%d devmem=%t",
+		sources, c.verify, c.preference, !c.noDevMem)
+}
+
+// cachedStream returns the SMBIOS data stored in file if it was written during
+// the boot identified by bootID using the options described by config, or
+// calls open and caches its result.
+func cachedStream(
+	file, bootID, config string,
+	open func() (io.ReadCloser, EntryPoint, string, error),
+) (io.ReadCloser, EntryPoint, string, error) {
+	if ep, b, src, err := readCache(file, bootID, config); err == nil {
+		return ioutil.NopCloser(bytes.NewReader(b)), ep, src, nil
+	}
+
+	rc, ep, src, err := open()
+	if err != nil {
+		return nil, nil, "", err
+	}
+	defer rc.Close()
+
+	b, err := ioutil.ReadAll(rc)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	// Caching is best effort.
+	_ = writeCache(file, bootID, config, src, ep, b)
+
+	return ioutil.NopCloser(bytes.NewReader(b)), ep, src, nil
+}
+
+// readCache reads a cache file, returning an error if the file was not written
+// during the boot identified by bootID using the options described by config,
+// or if the file may have been written by another user.
+func readCache(file, bootID, config string) (EntryPoint, []byte, string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	defer f.Close()
+
+	// Check the opened file rather than the path, so the file cannot be
+	// replaced between the check and the read.
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, nil, "", err
+	}
+	if err := checkCacheFile(fi); err != nil {
+		return nil, nil, "", err
+	}
+
+	b, err := ioutil.ReadAll(f)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	var ce cacheEntry
+	if err := json.Unmarshal(b, &ce); err != nil {
+		return nil, nil, "", err
+	}
+
+	if ce.Format != cacheFormat {
+		return nil, nil, "", fmt.Errorf("unsupported cache format: %d", ce.Format)
+	}
+
+	if ce.BootID != bootID {
+		return nil, nil, "", fmt.Errorf("cache written during boot %q, current boot is %q", ce.BootID, bootID)
+	}
+
+	if ce.Config != config {
+		return nil, nil, "", fmt.Errorf("cache written with options %q, current options are %q", ce.Config, config)
+	}
+
+	var ep EntryPoint
+	switch ce.Kind {
+	case kind32:
+		ep = &EntryPoint32Bit{}
+	case kind64:
+		ep = &EntryPoint64Bit{}
+	case kindWindows:
+		ep = &WindowsEntryPoint{}
+	default:
+		return nil, nil, "", fmt.Errorf("unknown cached entry point kind: %q", ce.Kind)
+	}
+
+	if err := json.Unmarshal(ce.EntryPoint, ep); err != nil {
+		return nil, nil, "", err
+	}
+
+	return ep, ce.Table, ce.Source, nil
+}
+
+// writeCache atomically replaces the contents of a cache file.
+func writeCache(file, bootID, config, src string, ep EntryPoint, table []byte) error {
+	var kind string
+	switch ep.(type) {
+	case *EntryPoint32Bit:
+		kind = kind32
+	case *EntryPoint64Bit:
+		kind = kind64
+	case *WindowsEntryPoint:
+		kind = kindWindows
+	default:
+		return fmt.Errorf("cannot cache entry point of type %T", ep)
+	}
+
+	epb, err := json.Marshal(ep)
+	if err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(cacheEntry{
+		Format:     cacheFormat,
+		BootID:     bootID,
+		Config:     config,
+		Source:     src,
+		Kind:       kind,
+		EntryPoint: epb,
+		Table:      table,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file in the same directory and rename it into
+	// place, so concurrent callers never observe a partial file.
+	f, err := ioutil.TempFile(filepath.Dir(file), filepath.Base(file)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(b); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), file)
+}
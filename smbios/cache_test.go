@@ -0,0 +1,216 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_cachedStream(t *testing.T) {
+	dir, err := ioutil.TempDir("", "go-smbios-cache")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	table := []byte{
+		127, 0x04, 0x01, 0x00,
+		0x00,
+		0x00,
+	}
+
+	eps := []EntryPoint{
+		&EntryPoint32Bit{
+			Anchor:                "_SM_",
+			Major:                 2,
+			Minor:                 8,
+			IntermediateAnchor:    "_DMI_",
+			StructureTableLength:  6,
+			StructureTableAddress: 0xf0000,
+			NumberStructures:      1,
+		},
+		&EntryPoint64Bit{
+			Anchor:                "_SM3_",
+			Major:                 3,
+			Minor:                 2,
+			StructureTableMaxSize: 6,
+			StructureTableAddress: 0xf0000,
+		},
+		&WindowsEntryPoint{
+			Size:         6,
+			MajorVersion: 3,
+			MinorVersion: 1,
+		},
+	}
+
+	for i, ep := range eps {
+		file := filepath.Join(dir, fmt.Sprintf("cache%d", i))
+
+		var calls int
+		open := func() (io.ReadCloser, EntryPoint, string, error) {
+			calls++
+			return ioutil.NopCloser(bytes.NewReader(table)), ep, "test", nil
+		}
+
+		// The first and third calls are the first in a new boot, and should
+		// open the stream.  The second should be served from the cache.
+		for j, boot := range []string{"a", "a", "b"} {
+			rc, gotEP, src, err := cachedStream(file, boot, "", open)
+			if err != nil {
+				t.Fatalf("failed to open cached stream: %v", err)
+			}
+
+			b, err := ioutil.ReadAll(rc)
+			if err != nil {
+				t.Fatalf("failed to read cached stream: %v", err)
+			}
+
+			if diff := cmp.Diff(table, b); diff != "" {
+				t.Fatalf("unexpected table for %T, call %d (-want +got):\n%s", ep, j, diff)
+			}
+			if diff := cmp.Diff(ep, gotEP); diff != "" {
+				t.Fatalf("unexpected entry point for %T, call %d (-want +got):\n%s", ep, j, diff)
+			}
+			if diff := cmp.Diff("test", src); diff != "" {
+				t.Fatalf("unexpected source for %T, call %d (-want +got):\n%s", ep, j, diff)
+			}
+		}
+
+		if diff := cmp.Diff(2, calls); diff != "" {
+			t.Fatalf("unexpected number of stream opens for %T (-want +got):\n%s", ep, diff)
+		}
+	}
+}
+
+func Test_cachedStreamConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "go-smbios-cache")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "cache")
+
+	var calls int
+	open := func() (io.ReadCloser, EntryPoint, string, error) {
+		calls++
+		return ioutil.NopCloser(bytes.NewReader([]byte{127, 0x04, 0x01, 0x00, 0x00, 0x00})), &WindowsEntryPoint{}, "test", nil
+	}
+
+	// Each change of options must bypass data cached using other options.
+	configs := []string{
+		newStreamConfig(nil).cacheConfig(),
+		newStreamConfig([]StreamOption{WithVerification()}).cacheConfig(),
+		newStreamConfig([]StreamOption{WithSources(DevMemSource)}).cacheConfig(),
+		newStreamConfig([]StreamOption{WithSources()}).cacheConfig(),
+		newStreamConfig([]StreamOption{WithEntryPointPreference(Prefer64Bit)}).cacheConfig(),
+		newStreamConfig([]StreamOption{WithEntryPointPreference(Prefer64Bit)}).cacheConfig(),
+	}
+
+	for i, config := range configs {
+		rc, _, _, err := cachedStream(file, "a", config, open)
+		if err != nil {
+			t.Fatalf("failed to open cached stream: %v", err)
+		}
+		_ = rc.Close()
+
+		// Only the final call repeats the options of its predecessor.
+		want := i + 1
+		if i == len(configs)-1 {
+			want = i
+		}
+
+		if diff := cmp.Diff(want, calls); diff != "" {
+			t.Fatalf("unexpected number of stream opens after config %q (-want +got):\n%s", config, diff)
+		}
+	}
+}
+
+func Test_cachedStreamUntrusted(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("skipping, cache file permissions are not checked on Windows")
+	}
+
+	dir, err := ioutil.TempDir("", "go-smbios-cache")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "cache")
+
+	var calls int
+	open := func() (io.ReadCloser, EntryPoint, string, error) {
+		calls++
+		return ioutil.NopCloser(bytes.NewReader([]byte{127, 0x04, 0x01, 0x00, 0x00, 0x00})), &WindowsEntryPoint{}, "test", nil
+	}
+
+	rc, _, _, err := cachedStream(file, "a", "", open)
+	if err != nil {
+		t.Fatalf("failed to open cached stream: %v", err)
+	}
+	_ = rc.Close()
+
+	// A cache file which other users could have written must be ignored.
+	if err := os.Chmod(file, 0666); err != nil {
+		t.Fatalf("failed to change cache file permissions: %v", err)
+	}
+
+	rc, _, _, err = cachedStream(file, "a", "", open)
+	if err != nil {
+		t.Fatalf("failed to open cached stream: %v", err)
+	}
+	_ = rc.Close()
+
+	if diff := cmp.Diff(2, calls); diff != "" {
+		t.Fatalf("unexpected number of stream opens (-want +got):\n%s", diff)
+	}
+}
+
+func Test_cachedStreamUnwritable(t *testing.T) {
+	table := []byte{
+		127, 0x04, 0x01, 0x00,
+		0x00,
+		0x00,
+	}
+
+	open := func() (io.ReadCloser, EntryPoint, string, error) {
+		return ioutil.NopCloser(bytes.NewReader(table)), &WindowsEntryPoint{}, "test", nil
+	}
+
+	// Failure to write the cache should not prevent reading the stream.
+	rc, _, _, err := cachedStream("/nonexistent/go-smbios/cache", "a", "", open)
+	if err != nil {
+		t.Fatalf("failed to open cached stream: %v", err)
+	}
+
+	b, err := ioutil.ReadAll(rc)
+	if err != nil {
+		t.Fatalf("failed to read cached stream: %v", err)
+	}
+
+	if diff := cmp.Diff(table, b); diff != "" {
+		t.Fatalf("unexpected table (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris
+// +build darwin dragonfly freebsd linux netbsd openbsd solaris
+
+package smbios
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// checkCacheFile returns an error unless fi describes a regular file which is
+// owned by the current user and inaccessible to other users, as written by
+// writeCache.
+func checkCacheFile(fi os.FileInfo) error {
+	if !fi.Mode().IsRegular() {
+		return fmt.Errorf("cache file %q is not a regular file", fi.Name())
+	}
+
+	if perm := fi.Mode().Perm(); perm&0077 != 0 {
+		return fmt.Errorf("cache file %q has permissions %#o, accessible to other users", fi.Name(), perm)
+	}
+
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return fmt.Errorf("cannot determine owner of cache file %q", fi.Name())
+	}
+
+	if uid := os.Getuid(); int(st.Uid) != uid {
+		return fmt.Errorf("cache file %q is owned by user %d, not the current user %d", fi.Name(), st.Uid, uid)
+	}
+
+	return nil
+}
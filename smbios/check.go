@@ -0,0 +1,77 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// Errors returned by CheckStructures which indicate that a structure table
+// does not describe the system's hardware, so an empty or sparse inventory
+// built from it should not be treated as a healthy result.
+var (
+	// ErrEmptyTable indicates a structure table with no Structures other
+	// than End-of-table, such as a placeholder produced by firmware or a
+	// hypervisor when no SMBIOS data is available.
+	ErrEmptyTable = errors.New("SMBIOS structure table contains no structures")
+
+	// ErrBIOSCharacteristicsNotSupported indicates that the BIOS Information
+	// structure reports that BIOS characteristics are not supported, which
+	// firmware uses to signal that its SMBIOS data is not populated.
+	ErrBIOSCharacteristicsNotSupported = errors.New("SMBIOS BIOS characteristics not supported")
+)
+
+// biosCharacteristicsNotSupported is the BIOS characteristics bit which
+// indicates that characteristics are not supported.  It must equal
+// structures.BIOSCharacteristicsNotSupported, which cannot be referenced here
+// because package structures imports this package; a test in package
+// structures verifies that they agree.
+const biosCharacteristicsNotSupported = 1 << 3
+
+// CheckStructures checks whether Structures decoded from a structure table
+// describe the system's hardware, returning ErrEmptyTable or
+// ErrBIOSCharacteristicsNotSupported if they do not.
+func CheckStructures(ss []*Structure) error {
+	var n int
+	for _, s := range ss {
+		switch s.Header.Type {
+		case typeEndOfTable:
+			continue
+		case 0:
+			// The characteristics field is a QWORD at offset 0x0a of the
+			// BIOS Information structure.
+			const off = 0x0a - headerLen
+			if len(s.Formatted) >= off+8 &&
+				binary.LittleEndian.Uint64(s.Formatted[off:])&biosCharacteristicsNotSupported != 0 {
+				return ErrBIOSCharacteristicsNotSupported
+			}
+		}
+
+		n++
+	}
+
+	if n == 0 {
+		return ErrEmptyTable
+	}
+
+	return nil
+}
+
+// Check checks whether the Table describes the system's hardware.  See
+// CheckStructures for details.
+func (t *Table) Check() error {
+	return CheckStructures(t.Structures)
+}
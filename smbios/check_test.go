@@ -0,0 +1,78 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+func TestCheckStructures(t *testing.T) {
+	eot := &smbios.Structure{
+		Header: smbios.Header{Type: 127, Length: 4},
+	}
+
+	bios := func(characteristics byte) *smbios.Structure {
+		return &smbios.Structure{
+			Header: smbios.Header{Type: 0, Length: 0x12},
+			Formatted: []byte{
+				0x01, 0x02, 0x00, 0xe8, 0x03, 0xff,
+				characteristics, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			},
+			Strings: []string{"DigitalOcean", "20171212", "12/12/2017"},
+		}
+	}
+
+	tests := []struct {
+		name string
+		ss   []*smbios.Structure
+		err  error
+	}{
+		{
+			name: "no structures",
+			err:  smbios.ErrEmptyTable,
+		},
+		{
+			name: "only end-of-table",
+			ss:   []*smbios.Structure{eot},
+			err:  smbios.ErrEmptyTable,
+		},
+		{
+			name: "BIOS characteristics not supported",
+			ss:   []*smbios.Structure{bios(0x08), eot},
+			err:  smbios.ErrBIOSCharacteristicsNotSupported,
+		},
+		{
+			name: "short BIOS information",
+			ss: []*smbios.Structure{
+				{Header: smbios.Header{Type: 0, Length: 5}, Formatted: []byte{0x08}},
+				eot,
+			},
+		},
+		{
+			name: "OK",
+			ss:   []*smbios.Structure{bios(0x80), eot},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := (&smbios.Table{Structures: tt.ss}).Check(); err != tt.err {
+				t.Fatalf("unexpected error: want %v, got %v", tt.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"fmt"
+)
+
+// ExpectedStructures returns the number of structures declared by an entry
+// point, including the End-of-table structure.  Only 32-bit entry points
+// declare the number of structures in the structure table, so for other
+// entry points, or if the number is 0, ExpectedStructures returns false.
+func ExpectedStructures(ep EntryPoint) (int, bool) {
+	e, ok := ep.(*EntryPoint32Bit)
+	if !ok || e.NumberStructures == 0 {
+		return 0, false
+	}
+
+	return int(e.NumberStructures), true
+}
+
+// WithStructureCount configures a Decoder with the number of structures
+// declared by ep, if any.  See ExpectedStructures for details.
+//
+// When the number of structures is known, Decode pre-allocates its result,
+// Decoder.Expected reports the number for progress reporting, and Decode and
+// DecodeFunc return a *StructureCountError if a different number of
+// structures is decoded.  Structures dropped by WithRedaction are counted.
+func WithStructureCount(ep EntryPoint) DecoderOption {
+	return func(d *Decoder) {
+		d.expected, _ = ExpectedStructures(ep)
+	}
+}
+
+// Expected returns the number of structures the Decoder expects to decode, as
+// configured by WithStructureCount.  If the number is not known, Expected
+// returns false.
+func (d *Decoder) Expected() (int, bool) {
+	return d.expected, d.expected != 0
+}
+
+// A StructureCountError is returned when the number of structures decoded
+// differs from the number declared by an entry point.
+type StructureCountError struct {
+	Expected, Decoded int
+}
+
+// Error implements error.
+func (e *StructureCountError) Error() string {
+	return fmt.Sprintf("expected SMBIOS entry point structure count of %d, but decoded: %d", e.Expected, e.Decoded)
+}
@@ -0,0 +1,98 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWithStructureCount(t *testing.T) {
+	table := []byte{
+		0x00, 0x05, 0x01, 0x00,
+		0xff,
+		0x00,
+		0x00,
+
+		0x01, 0x05, 0x02, 0x00,
+		0x01,
+		's', 'e', 'r', 'i', 'a', 'l', 0x00,
+		0x00,
+
+		127, 0x04, 0x03, 0x00,
+		0x00,
+		0x00,
+	}
+
+	tests := []struct {
+		name     string
+		ep       smbios.EntryPoint
+		options  []smbios.DecoderOption
+		expected int
+		err      error
+	}{
+		{
+			name: "64-bit",
+			ep:   &smbios.EntryPoint64Bit{},
+		},
+		{
+			name: "32-bit, unknown",
+			ep:   &smbios.EntryPoint32Bit{},
+		},
+		{
+			name:     "32-bit, OK",
+			ep:       &smbios.EntryPoint32Bit{NumberStructures: 3},
+			expected: 3,
+		},
+		{
+			name:     "32-bit, redacted",
+			ep:       &smbios.EntryPoint32Bit{NumberStructures: 3},
+			options:  []smbios.DecoderOption{smbios.WithRedaction(smbios.RedactDrop, 1)},
+			expected: 3,
+		},
+		{
+			name:     "32-bit, mismatch",
+			ep:       &smbios.EntryPoint32Bit{NumberStructures: 4},
+			expected: 4,
+			err: &smbios.StructureCountError{
+				Expected: 4,
+				Decoded:  3,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := append([]smbios.DecoderOption{smbios.WithStructureCount(tt.ep)}, tt.options...)
+			d := smbios.NewDecoder(bytes.NewReader(table), options...)
+
+			expected, ok := d.Expected()
+			if diff := cmp.Diff(tt.expected != 0, ok); diff != "" {
+				t.Fatalf("unexpected expected count presence (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.expected, expected); diff != "" {
+				t.Fatalf("unexpected expected count (-want +got):\n%s", diff)
+			}
+
+			_, err := d.Decode()
+			if diff := cmp.Diff(tt.err, err); diff != "" {
+				t.Fatalf("unexpected error (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
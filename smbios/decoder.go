@@ -18,7 +18,11 @@ import (
 	"bufio"
 	"bytes"
 	"encoding/binary"
+	"fmt"
 	"io"
+	"io/ioutil"
+	"os"
+	"time"
 )
 
 const (
@@ -27,6 +31,10 @@ const (
 
 	// typeEndOfTable indicates the end of a stream of Structures.
 	typeEndOfTable = 127
+
+	// typeOEMStart is the first Structure type reserved for OEM-specific
+	// Structures.
+	typeOEMStart = 128
 )
 
 var (
@@ -39,6 +47,33 @@ var (
 type Decoder struct {
 	br *bufio.Reader
 	b  []byte
+
+	// n is the number of bytes consumed from br.
+	n      int
+	tracer Tracer
+
+	// redact specifies the RedactAction for each redacted structure type.
+	redact map[uint8]RedactAction
+
+	// If non-nil, the Provenance template applied to each Structure.
+	provenance *Provenance
+
+	// expected is the number of structures declared by the entry point, or
+	// 0 if unknown.
+	expected int
+
+	// If non-nil, measures Structures as they are decoded.
+	measurer *measurer
+
+	// trim specifies whether trailing spaces are removed from strings.
+	trim bool
+
+	// resync specifies whether to resynchronize after a Structure with an
+	// invalid length.
+	resync bool
+
+	// dedupOEM specifies whether identical OEM Structures are collapsed.
+	dedupOEM bool
 }
 
 // Stream locates and opens a stream of SMBIOS data and the SMBIOS entry
@@ -46,57 +81,391 @@ type Decoder struct {
 // closed after decoding to free its resources.
 //
 // If no suitable location is found, an error is returned.
-func Stream() (io.ReadCloser, EntryPoint, error) {
-	rc, ep, err := stream()
+//
+// StreamOptions may be specified to alter the behavior of Stream.
+func Stream(options ...StreamOption) (io.ReadCloser, EntryPoint, error) {
+	c := newStreamConfig(options)
+
+	span := c.tracer.Start("smbios.Stream")
+	defer span.End()
+
+	rc, ep, src, err := openStream(c)
 	if err != nil {
+		span.RecordError(err)
 		return nil, nil, err
 	}
 
+	major, minor, rev := ep.Version()
+	_, size := ep.Table()
+
+	span.SetAttribute(AttributeSource, src)
+	span.SetAttribute(AttributeVersion, fmt.Sprintf("%d.%d.%d", major, minor, rev))
+	span.SetAttribute(AttributeTableSize, size)
+
 	// The io.ReadCloser from stream could be any one of a number of types
 	// depending on the source of the SMBIOS stream information.
 	//
 	// To prevent the caller from potentially tampering with something dangerous
 	// like mmap'd memory by using a type assertion, we make the io.ReadCloser
 	// into an opaque and unexported type to prevent type assertion.
-	return &opaqueReadCloser{rc: rc}, ep, nil
+	//
+	// Only streams backed by files are live handles; all others are copies
+	// of the SMBIOS data made while opening the stream.
+	_, live := rc.(*os.File)
+	return &opaqueReadCloser{
+		rc:       rc,
+		detached: !live,
+		source:   src,
+		acquired: time.Now(),
+	}, ep, nil
+}
+
+// openStream opens an SMBIOS stream as configured by c.
+func openStream(c *streamConfig) (io.ReadCloser, EntryPoint, string, error) {
+	open := func() (io.ReadCloser, EntryPoint, string, error) {
+		if c.retry != nil {
+			return retryStream(*c.retry, c.sleep, func() (io.ReadCloser, EntryPoint, string, error) {
+				return stream(c)
+			})
+		}
+
+		return stream(c)
+	}
+
+	if c.cache == "" {
+		return open()
+	}
+
+	// The cache can only be used if the current boot can be identified.
+	id, err := c.platform.BootID()
+	if err != nil {
+		return open()
+	}
+
+	return cachedStream(c.cache, id, c.cacheConfig(), open)
+}
+
+// StreamBytes is like Stream, but reads the entire SMBIOS structure table
+// into memory and closes the stream.  This is convenient for callers which
+// must decode or inspect the table more than once, such as to compute a hash
+// of the raw table and also decode its structures.
+func StreamBytes(options ...StreamOption) ([]byte, EntryPoint, error) {
+	rc, ep, err := Stream(options...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rc.Close()
+
+	b, err := ioutil.ReadAll(rc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return b, ep, nil
+}
+
+// StreamFromBytes creates a stream of SMBIOS data from raw entry point and
+// structure table bytes, such as those copied from
+// /sys/firmware/dmi/tables on Linux.  It performs no operating system-specific
+// data acquisition, so it can be used on platforms where Stream is not
+// supported, such as WebAssembly.
+//
+// The returned stream is always detached.
+func StreamFromBytes(entryPoint, table []byte) (io.ReadCloser, EntryPoint, error) {
+	ep, err := ParseEntryPoint(bytes.NewReader(entryPoint))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &opaqueReadCloser{
+		rc:       ioutil.NopCloser(bytes.NewReader(table)),
+		detached: true,
+	}, ep, nil
+}
+
+// Detached reports whether an io.ReadCloser returned by Stream holds a copy of
+// the SMBIOS data in memory, such as data read from /dev/mem or Windows APIs,
+// rather than a live handle to an operating system resource such as a sysfs
+// file.
+//
+// Detached returns false for any io.ReadCloser not returned by Stream or
+// Detach.
+func Detached(rc io.ReadCloser) bool {
+	orc, ok := rc.(*opaqueReadCloser)
+	return ok && orc.detached
+}
+
+// Detach reads all remaining data from rc into memory and closes rc,
+// returning an io.ReadCloser which holds a copy of the data and no operating
+// system resources.  If rc is already detached, it is returned unmodified.
+func Detach(rc io.ReadCloser) (io.ReadCloser, error) {
+	if Detached(rc) {
+		return rc, nil
+	}
+	defer rc.Close()
+
+	b, err := ioutil.ReadAll(rc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Retain the provenance of streams returned by Stream.
+	drc := &opaqueReadCloser{
+		rc:       ioutil.NopCloser(bytes.NewReader(b)),
+		detached: true,
+	}
+	if orc, ok := rc.(*opaqueReadCloser); ok {
+		drc.source, drc.acquired = orc.source, orc.acquired
+	}
+
+	return drc, nil
 }
 
 // NewDecoder creates a Decoder which decodes Structures from the input stream.
-func NewDecoder(r io.Reader) *Decoder {
-	return &Decoder{
-		br: bufio.NewReader(r),
-		b:  make([]byte, 1024),
+// DecoderOptions may be specified to alter the behavior of the Decoder.
+//
+// By default, strings are decoded exactly as they appear in the stream,
+// including any trailing spaces.  See WithTrimmedStrings.
+func NewDecoder(r io.Reader, options ...DecoderOption) *Decoder {
+	d := &Decoder{
+		br:     bufio.NewReader(r),
+		b:      make([]byte, 1024),
+		tracer: nopTracer{},
 	}
+
+	for _, o := range options {
+		o(d)
+	}
+
+	if d.provenance != nil {
+		d.provenance = streamProvenance(r)
+	}
+
+	return d
 }
 
 // Decode decodes Structures from the Decoder's stream until an End-of-table
 // structure is found.
 func (d *Decoder) Decode() ([]*Structure, error) {
-	var ss []*Structure
+	return d.decode(d.expected)
+}
+
+// decode implements Decode, verifying that the expected number of
+// Structures are decoded if expected is not 0.
+func (d *Decoder) decode(expected int) ([]*Structure, error) {
+	ss := make([]*Structure, 0, expected)
+
+	var dd *deduplicator
+	if d.dedupOEM {
+		dd = newDeduplicator()
+	}
+
+	err := d.decodeFunc(expected, func(s *Structure) error {
+		if dd != nil && dd.duplicate(s) {
+			return nil
+		}
+
+		ss = append(ss, s)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
+	return ss, nil
+}
+
+// DecodeFunc decodes Structures from the Decoder's stream until an
+// End-of-table structure is found, calling fn for each Structure as it is
+// decoded, including the End-of-table structure.  DecodeFunc retains no
+// Structures, so it is suitable for building other representations of the
+// Structures in a single pass.
+//
+// If fn returns an error, decoding stops and DecodeFunc returns the error.
+func (d *Decoder) DecodeFunc(fn func(s *Structure) error) error {
+	return d.decodeFunc(d.expected, fn)
+}
+
+// decodeFunc implements DecodeFunc, verifying that the expected number of
+// Structures are decoded if expected is not 0.
+func (d *Decoder) decodeFunc(expected int, fn func(s *Structure) error) error {
+	span := d.tracer.Start("smbios.Decode")
+	defer span.End()
+
+	// n counts the Structures passed to fn, and total also counts dropped
+	// Structures for comparison with the expected number.
+	var n, total int
 	for {
+		off := d.n
 		s, err := d.next()
 		if err != nil {
-			return nil, err
+			span.RecordError(err)
+			return err
+		}
+		total++
+
+		// Dropped structures are omitted entirely.
+		if s == nil {
+			continue
+		}
+
+		if d.provenance != nil {
+			p := *d.provenance
+			p.Offset = off
+			s.Provenance = &p
+		}
+
+		if d.measurer != nil {
+			d.measurer.measure(s)
+		}
+
+		n++
+		if err := fn(s); err != nil {
+			span.RecordError(err)
+			return err
 		}
 
 		// End-of-table structure indicates end of stream.
-		ss = append(ss, s)
 		if s.Header.Type == typeEndOfTable {
 			break
 		}
 	}
 
-	return ss, nil
+	span.SetAttribute(AttributeStructures, n)
+	span.SetAttribute(AttributeBytes, d.n)
+
+	if expected != 0 && total != expected {
+		err := &StructureCountError{
+			Expected: expected,
+			Decoded:  total,
+		}
+
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
+
+// DecodeTables decodes one or more concatenated structure tables from the
+// Decoder's stream until the stream is exhausted.  Some multi-node systems
+// expose a structure table per node in this way.
+//
+// Each Table is tagged with its index in the stream as its Node.  Use
+// MergeTables to combine the Tables for aggregated inventory.
+//
+// Trailing data which cannot begin another table, such as zero padding after
+// the final End-of-table structure or fewer bytes than a Structure header, is
+// ignored.
+//
+// The number of structures configured by WithStructureCount is not verified
+// by DecodeTables, because the entry point describes only a single table.
+func (d *Decoder) DecodeTables() ([]*Table, error) {
+	var ts []*Table
+	for {
+		// Stop when no more tables follow the previous table, but always
+		// attempt to decode at least one table.
+		if len(ts) > 0 {
+			done, err := d.trailing()
+			if err != nil {
+				return nil, err
+			}
+			if done {
+				break
+			}
+		}
+
+		ss, err := d.decode(0)
+		if err != nil {
+			return nil, err
+		}
+
+		ts = append(ts, &Table{
+			Node:       len(ts),
+			Structures: ss,
+		})
+	}
+
+	return ts, nil
 }
 
-// next decodes the next Structure from the stream.
+// trailing reports whether the remainder of the stream holds no further
+// tables, because it is shorter than a Structure header or consists only of
+// zero bytes.  Trailing data is consumed if so.
+func (d *Decoder) trailing() (bool, error) {
+	b, err := d.br.Peek(headerLen)
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+	if len(b) < headerLen {
+		_, err := d.br.Discard(len(b))
+		d.n += len(b)
+		return true, err
+	}
+
+	start := d.n
+	for {
+		// Peek returns an error if fewer bytes are available than the
+		// size of the buffer, such as near the end of the stream.
+		b, _ := d.br.Peek(d.br.Size())
+		for i, c := range b {
+			if c == 0x00 {
+				continue
+			}
+
+			// d.n only advances when a whole peeked chunk is discarded,
+			// so the position of the non-zero byte within b determines
+			// how many zeros precede it.  A table may begin with a zero
+			// Type, such as BIOS information, but a non-zero Length
+			// must follow it.
+			if d.n == start && i < 2 {
+				// Another table follows.
+				return false, nil
+			}
+
+			// Zero bytes are followed by more data, so the zeros must be
+			// interpreted as a Structure header with an invalid length.
+			return false, &LengthError{Offset: start}
+		}
+
+		if _, err := d.br.Discard(len(b)); err != nil {
+			return false, err
+		}
+		d.n += len(b)
+
+		if len(b) < d.br.Size() {
+			// Only zero padding remains.
+			return true, nil
+		}
+	}
+}
+
+// next decodes the next Structure from the stream.  It returns a nil
+// Structure if the Structure was dropped due to redaction.
 func (d *Decoder) next() (*Structure, error) {
 	h, err := d.parseHeader()
 	if err != nil {
 		return nil, err
 	}
 
+	if h.Length < headerLen {
+		lerr := &LengthError{Offset: d.n - headerLen, Header: *h}
+		if !d.resync {
+			return nil, lerr
+		}
+
+		if err := d.resynchronize(); err != nil {
+			return nil, lerr
+		}
+
+		// Drop the invalid Structure.
+		return nil, nil
+	}
+
+	if action, ok := d.redact[h.Type]; ok {
+		return d.nextRedacted(h, action)
+	}
+
 	// Length of formatted section is length specified by header, minus
 	// the length of the header itself.
 	l := int(h.Length) - headerLen
@@ -117,11 +486,64 @@ func (d *Decoder) next() (*Structure, error) {
 	}, nil
 }
 
+// resynchronize discards bytes from the stream until the next plausible
+// Structure, as determined by structureScanner.
+func (d *Decoder) resynchronize() error {
+	for {
+		// Peek returns an error if fewer bytes are available than the
+		// size of the buffer, such as near the end of the stream.
+		b, _ := d.br.Peek(d.br.Size())
+		sc := newStructureScanner(b)
+		for i := range b {
+			if _, ok := sc.plausible(i); !ok {
+				continue
+			}
+
+			if _, err := d.br.Discard(i); err != nil {
+				return err
+			}
+			d.n += i
+
+			return nil
+		}
+
+		if len(b) < d.br.Size() {
+			// No more data, and no plausible Structure was found.
+			return io.ErrUnexpectedEOF
+		}
+
+		// Keep the end of the buffer, which may hold the beginning of
+		// a Structure that has not yet been read in full.
+		n := len(b) / 2
+		if _, err := d.br.Discard(n); err != nil {
+			return err
+		}
+		d.n += n
+	}
+}
+
+// A LengthError is returned when a Structure's Header specifies a length too
+// short to contain the Header itself.
+type LengthError struct {
+	// Offset is the offset of the Structure within the stream.
+	Offset int
+
+	// Header is the Structure's invalid Header.
+	Header Header
+}
+
+// Error implements error.
+func (e *LengthError) Error() string {
+	return fmt.Sprintf("invalid SMBIOS structure length %d for type %d, handle 0x%04x at offset %d",
+		e.Header.Length, e.Header.Type, e.Header.Handle, e.Offset)
+}
+
 // parseHeader parses a Structure's Header from the stream.
 func (d *Decoder) parseHeader() (*Header, error) {
 	if _, err := io.ReadFull(d.br, d.b[:headerLen]); err != nil {
 		return nil, err
 	}
+	d.n += headerLen
 
 	return &Header{
 		Type:   d.b[0],
@@ -144,6 +566,7 @@ func (d *Decoder) parseFormatted(l int) ([]byte, error) {
 	if _, err := io.ReadFull(d.br, d.b[:l]); err != nil {
 		return nil, err
 	}
+	d.n += l
 
 	// Make a copy to free up the internal buffer.
 	fb := make([]byte, len(d.b[:l]))
@@ -165,6 +588,7 @@ func (d *Decoder) parseStrings() ([]string, error) {
 		if _, err := d.br.Discard(2); err != nil {
 			return nil, err
 		}
+		d.n += 2
 
 		return nil, nil
 	}
@@ -197,8 +621,12 @@ func (d *Decoder) parseString() (str string, more bool, err error) {
 	if err != nil {
 		return "", false, err
 	}
+	d.n += len(raw)
 
 	b := bytes.TrimRight(raw, "\x00")
+	if d.trim {
+		b = bytes.TrimRight(b, " ")
+	}
 
 	peek, err := d.br.Peek(1)
 	if err != nil {
@@ -215,6 +643,7 @@ func (d *Decoder) parseString() (str string, more bool, err error) {
 	if _, err := d.br.Discard(1); err != nil {
 		return "", false, err
 	}
+	d.n++
 
 	return string(b), false, nil
 }
@@ -224,7 +653,12 @@ var _ io.ReadCloser = &opaqueReadCloser{}
 // An opaqueReadCloser masks the type of the underlying io.ReadCloser to
 // prevent type assertions.
 type opaqueReadCloser struct {
-	rc io.ReadCloser
+	rc       io.ReadCloser
+	detached bool
+
+	// The provenance of the stream, if known.
+	source   string
+	acquired time.Time
 }
 
 func (rc *opaqueReadCloser) Read(b []byte) (int, error) { return rc.rc.Read(b) }
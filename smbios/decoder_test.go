@@ -16,6 +16,10 @@ package smbios_test
 
 import (
 	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"path/filepath"
 	"testing"
 
 	"github.com/digitalocean/go-smbios/smbios"
@@ -190,3 +194,441 @@ func TestDecoder(t *testing.T) {
 		})
 	}
 }
+
+func TestDecoderLengthError(t *testing.T) {
+	// A valid structure, with handle h.
+	valid := func(h byte) []byte {
+		return []byte{
+			0x01, 0x05, h, 0x00,
+			0xff,
+			'a', 0x00,
+			0x00,
+		}
+	}
+
+	eot := []byte{127, 0x04, 0xfe, 0x00, 0x00, 0x00}
+
+	join := func(bs ...[]byte) []byte {
+		return bytes.Join(bs, nil)
+	}
+
+	tests := []struct {
+		name    string
+		b       []byte
+		options []smbios.DecoderOption
+		handles []uint16
+		err     *smbios.LengthError
+	}{
+		{
+			name: "length 0",
+			b:    []byte{0x00, 0x00, 0x01, 0x00, 0x00, 0x00},
+			err: &smbios.LengthError{
+				Header: smbios.Header{Type: 0, Length: 0, Handle: 1},
+			},
+		},
+		{
+			name: "length 3 after valid",
+			b:    join(valid(1), []byte{0x02, 0x03, 0x02, 0x00, 0x00, 0x00}, eot),
+			err: &smbios.LengthError{
+				Offset: 8,
+				Header: smbios.Header{Type: 2, Length: 3, Handle: 2},
+			},
+		},
+		{
+			name:    "resynchronize",
+			b:       join(valid(1), []byte{0x02, 0x01, 0x02, 0x00, 0xde, 0xad, 0x00}, valid(3), eot),
+			options: []smbios.DecoderOption{smbios.WithResynchronization()},
+			handles: []uint16{1, 3, 0xfe},
+		},
+		{
+			name:    "resynchronize no plausible structure",
+			b:       join(valid(1), []byte{0x02, 0x02, 0x02, 0x00, 0xff, 0xff, 0xff}),
+			options: []smbios.DecoderOption{smbios.WithResynchronization()},
+			err: &smbios.LengthError{
+				Offset: 8,
+				Header: smbios.Header{Type: 2, Length: 2, Handle: 2},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ss, err := smbios.NewDecoder(bytes.NewReader(tt.b), tt.options...).Decode()
+
+			if tt.err == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				var handles []uint16
+				for _, s := range ss {
+					handles = append(handles, s.Header.Handle)
+				}
+
+				if diff := cmp.Diff(tt.handles, handles); diff != "" {
+					t.Fatalf("unexpected handles (-want +got):\n%s", diff)
+				}
+
+				return
+			}
+
+			var lerr *smbios.LengthError
+			if !errors.As(err, &lerr) {
+				t.Fatalf("expected a length error, but got: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.err, lerr); diff != "" {
+				t.Fatalf("unexpected length error (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	// Replay minimized fuzzer inputs with pathological lengths.  Each must
+	// produce a LengthError, and must terminate with resynchronization.
+	files, err := filepath.Glob(filepath.Join("testdata", "corpus", "length-*"))
+	if err != nil {
+		t.Fatalf("failed to find corpus: %v", err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no length corpus inputs found")
+	}
+
+	for _, f := range files {
+		t.Run(filepath.Base(f), func(t *testing.T) {
+			b, err := ioutil.ReadFile(f)
+			if err != nil {
+				t.Fatalf("failed to read corpus input: %v", err)
+			}
+
+			_, err = smbios.NewDecoder(bytes.NewReader(b)).Decode()
+
+			var lerr *smbios.LengthError
+			if !errors.As(err, &lerr) {
+				t.Fatalf("expected a length error, but got: %v", err)
+			}
+			if lerr.Header.Length >= 4 {
+				t.Fatalf("unexpected length error header length: %d", lerr.Header.Length)
+			}
+
+			_, _ = smbios.NewDecoder(bytes.NewReader(b), smbios.WithResynchronization()).Decode()
+		})
+	}
+}
+
+func TestDecoderDecodeTables(t *testing.T) {
+	join := func(bs ...[]byte) []byte {
+		return bytes.Join(bs, nil)
+	}
+
+	// A table with a single BIOS structure.
+	table := func(handle byte) []byte {
+		return []byte{
+			0x00, 0x05, handle, 0x00,
+			0xff,
+			0x00,
+			0x00,
+
+			127, 0x04, handle + 1, 0x00,
+			0x00,
+			0x00,
+		}
+	}
+
+	structures := func(handle uint16) []*smbios.Structure {
+		return []*smbios.Structure{
+			{
+				Header: smbios.Header{
+					Type:   0,
+					Length: 5,
+					Handle: handle,
+				},
+				Formatted: []byte{0xff},
+			},
+			{
+				Header: smbios.Header{
+					Type:   127,
+					Length: 4,
+					Handle: handle + 1,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name string
+		b    []byte
+		ts   []*smbios.Table
+		lerr *smbios.LengthError
+		ok   bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "truncated second table",
+			b:    append(table(0x01), 0x00, 0x05, 0x01, 0x00),
+		},
+		{
+			name: "zero padding between tables",
+			b:    join(table(0x01), make([]byte, 16), table(0x01)),
+			lerr: &smbios.LengthError{Offset: 13},
+		},
+		{
+			name: "short zero padding between tables",
+			b:    join(table(0x01), make([]byte, 2), table(0x01)),
+			lerr: &smbios.LengthError{Offset: 13},
+		},
+		{
+			name: "long zero padding between tables",
+			b:    join(table(0x01), make([]byte, 8192), table(0x01)),
+			lerr: &smbios.LengthError{Offset: 13},
+		},
+		{
+			name: "zero padding across chunks between tables",
+			b:    join(table(0x01), make([]byte, 4100), table(0x01)),
+			lerr: &smbios.LengthError{Offset: 13},
+		},
+		{
+			name: "OK, one",
+			b:    table(0x01),
+			ts: []*smbios.Table{{
+				Node:       0,
+				Structures: structures(1),
+			}},
+			ok: true,
+		},
+		{
+			name: "OK, short trailing data",
+			b:    append(table(0x01), 0x00, 0x05),
+			ts: []*smbios.Table{{
+				Node:       0,
+				Structures: structures(1),
+			}},
+			ok: true,
+		},
+		{
+			name: "OK, zero padding",
+			b:    join(table(0x01), make([]byte, 16)),
+			ts: []*smbios.Table{{
+				Node:       0,
+				Structures: structures(1),
+			}},
+			ok: true,
+		},
+		{
+			name: "OK, zero padding across chunks",
+			b:    join(table(0x01), make([]byte, 4100)),
+			ts: []*smbios.Table{{
+				Node:       0,
+				Structures: structures(1),
+			}},
+			ok: true,
+		},
+		{
+			name: "OK, long zero padding",
+			b:    join(table(0x01), make([]byte, 8192)),
+			ts: []*smbios.Table{{
+				Node:       0,
+				Structures: structures(1),
+			}},
+			ok: true,
+		},
+		{
+			name: "OK, multiple",
+			b:    append(table(0x01), table(0x01)...),
+			ts: []*smbios.Table{
+				{
+					Node:       0,
+					Structures: structures(1),
+				},
+				{
+					Node:       1,
+					Structures: structures(1),
+				},
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts, err := smbios.NewDecoder(bytes.NewReader(tt.b)).DecodeTables()
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if tt.lerr != nil {
+				if diff := cmp.Diff(tt.lerr, err); diff != "" {
+					t.Fatalf("unexpected error (-want +got):\n%s", diff)
+				}
+			}
+
+			if diff := cmp.Diff(tt.ts, ts); diff != "" {
+				t.Fatalf("unexpected tables (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDetach(t *testing.T) {
+	b := []byte{0xde, 0xad, 0xbe, 0xef}
+	rc := &closeRecorder{r: bytes.NewReader(b)}
+
+	if smbios.Detached(rc) {
+		t.Fatal("arbitrary io.ReadCloser should not be detached")
+	}
+
+	drc, err := smbios.Detach(rc)
+	if err != nil {
+		t.Fatalf("failed to detach: %v", err)
+	}
+	defer drc.Close()
+
+	if !rc.closed {
+		t.Fatal("original io.ReadCloser was not closed")
+	}
+	if !smbios.Detached(drc) {
+		t.Fatal("io.ReadCloser should be detached")
+	}
+
+	// Detaching again should be a no-op.
+	again, err := smbios.Detach(drc)
+	if err != nil {
+		t.Fatalf("failed to detach again: %v", err)
+	}
+	if again != drc {
+		t.Fatal("detached io.ReadCloser should be returned unmodified")
+	}
+
+	got, err := ioutil.ReadAll(drc)
+	if err != nil {
+		t.Fatalf("failed to read detached stream: %v", err)
+	}
+
+	if diff := cmp.Diff(b, got); diff != "" {
+		t.Fatalf("unexpected stream data (-want +got):\n%s", diff)
+	}
+}
+
+// A closeRecorder is an io.ReadCloser which records whether it was closed.
+type closeRecorder struct {
+	r      io.Reader
+	closed bool
+}
+
+func (rc *closeRecorder) Read(b []byte) (int, error) { return rc.r.Read(b) }
+func (rc *closeRecorder) Close() error {
+	rc.closed = true
+	return nil
+}
+
+func TestStreamFromBytes(t *testing.T) {
+	t.Run("bad entry point", func(t *testing.T) {
+		_, _, err := smbios.StreamFromBytes([]byte{0xff, 0xff, 0xff, 0xff}, nil)
+		if err == nil {
+			t.Fatal("expected an error, but none occurred")
+		}
+	})
+
+	t.Run("OK", func(t *testing.T) {
+		ep := []byte{
+			'_', 'S', 'M', '_',
+			0xa4,
+			0x1f,
+			0x2,
+			0x8,
+			0xd4,
+			0x1, 0x0,
+			0x0, 0x0, 0x0, 0x0, 0x0,
+			'_', 'D', 'M', 'I', '_',
+			0x95,
+			0x5f, 0xf,
+			0x0, 0x90, 0xf0, 0x7a,
+			0x43, 0x0,
+			0x28,
+		}
+
+		table := []byte{
+			127, 0x04, 0x00, 0x01,
+			0x00, 0x00,
+		}
+
+		rc, gotEP, err := smbios.StreamFromBytes(ep, table)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer rc.Close()
+
+		if !smbios.Detached(rc) {
+			t.Fatal("stream should be detached")
+		}
+
+		major, minor, _ := gotEP.Version()
+		if diff := cmp.Diff([]int{2, 8}, []int{major, minor}); diff != "" {
+			t.Fatalf("unexpected version (-want +got):\n%s", diff)
+		}
+
+		ss, err := smbios.NewDecoder(rc).Decode()
+		if err != nil {
+			t.Fatalf("failed to decode structures: %v", err)
+		}
+
+		want := []*smbios.Structure{{
+			Header: smbios.Header{
+				Type:   127,
+				Length: 4,
+				Handle: 0x0100,
+			},
+		}}
+
+		if diff := cmp.Diff(want, ss); diff != "" {
+			t.Fatalf("unexpected structures (-want +got):\n%s", diff)
+		}
+	})
+}
+
+func TestDecoderTrimmedStrings(t *testing.T) {
+	b := []byte{
+		0x01, 0x04, 0x01, 0x00,
+		'a', 'b', ' ', ' ', 0x00,
+		' ', 0x00,
+		0x00,
+
+		127, 0x04, 0x02, 0x00,
+		0x00,
+		0x00,
+	}
+
+	tests := []struct {
+		name    string
+		options []smbios.DecoderOption
+		strs    []string
+	}{
+		{
+			name: "default",
+			strs: []string{"ab  ", " "},
+		},
+		{
+			name:    "trimmed",
+			options: []smbios.DecoderOption{smbios.WithTrimmedStrings()},
+			strs:    []string{"ab", ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ss, err := smbios.NewDecoder(bytes.NewReader(b), tt.options...).Decode()
+			if err != nil {
+				t.Fatalf("failed to decode structures: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.strs, ss[0].Strings); diff != "" {
+				t.Fatalf("unexpected strings (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"strings"
+)
+
+// WithOEMDeduplication configures a Decoder to collapse OEM-specific
+// Structures (types 128 through 255) which are identical to an earlier
+// Structure, apart from their handles, into that earlier Structure.  Some
+// firmware repeats identical OEM Structures hundreds of times, and
+// deduplication keeps the memory used by decoded tables and their exports
+// manageable on such platforms.
+//
+// The Duplicates field of each retained Structure counts the Structures
+// collapsed into it.  Deduplication applies to Decode and DecodeTables, but
+// not to DecodeFunc, which retains no Structures.  Other Structures are never
+// deduplicated, because their handles may be referenced by other Structures.
+func WithOEMDeduplication() DecoderOption {
+	return func(d *Decoder) {
+		d.dedupOEM = true
+	}
+}
+
+// A deduplicator detects OEM Structures which duplicate an earlier Structure.
+type deduplicator struct {
+	seen map[string]*Structure
+}
+
+// newDeduplicator creates an empty deduplicator.
+func newDeduplicator() *deduplicator {
+	return &deduplicator{seen: make(map[string]*Structure)}
+}
+
+// duplicate reports whether s is an OEM Structure identical to one seen
+// earlier, in which case the earlier Structure's Duplicates count is
+// incremented.
+func (dd *deduplicator) duplicate(s *Structure) bool {
+	if s.Header.Type < typeOEMStart {
+		return false
+	}
+
+	// Strings cannot contain null bytes, so they can be used to delimit
+	// the components of the key unambiguously.
+	var b strings.Builder
+	b.WriteByte(s.Header.Type)
+	b.WriteByte(s.Header.Length)
+	b.Write(s.Formatted)
+	for _, str := range s.Strings {
+		b.WriteByte(0x00)
+		b.WriteString(str)
+	}
+	key := b.String()
+
+	if prev, ok := dd.seen[key]; ok {
+		prev.Duplicates++
+		return true
+	}
+
+	dd.seen[key] = s
+	return false
+}
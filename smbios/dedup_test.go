@@ -0,0 +1,81 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDecoderOEMDeduplication(t *testing.T) {
+	// An OEM structure with handle h, formatted byte f, and string s.
+	oem := func(h, f byte, s string) []byte {
+		b := []byte{0x80, 0x05, h, 0x00, f}
+		b = append(b, s...)
+		return append(b, 0x00, 0x00)
+	}
+
+	b := bytes.Join([][]byte{
+		oem(1, 0xaa, "x"),
+		oem(2, 0xaa, "x"),
+		// Different formatted area and string.
+		oem(3, 0xbb, "x"),
+		oem(4, 0xaa, "y"),
+		oem(5, 0xaa, "x"),
+		// Non-OEM duplicates are retained.
+		{0x01, 0x04, 0x06, 0x00, 0x00, 0x00},
+		{0x01, 0x04, 0x07, 0x00, 0x00, 0x00},
+		{127, 0x04, 0x08, 0x00, 0x00, 0x00},
+	}, nil)
+
+	type result struct {
+		Handle     uint16
+		Duplicates int
+	}
+
+	decode := func(options ...smbios.DecoderOption) []result {
+		ss, err := smbios.NewDecoder(bytes.NewReader(b), options...).Decode()
+		if err != nil {
+			t.Fatalf("failed to decode: %v", err)
+		}
+
+		var rs []result
+		for _, s := range ss {
+			rs = append(rs, result{Handle: s.Header.Handle, Duplicates: s.Duplicates})
+		}
+
+		return rs
+	}
+
+	want := []result{
+		{Handle: 1, Duplicates: 2},
+		{Handle: 3},
+		{Handle: 4},
+		{Handle: 6},
+		{Handle: 7},
+		{Handle: 8},
+	}
+
+	if diff := cmp.Diff(want, decode(smbios.WithOEMDeduplication())); diff != "" {
+		t.Fatalf("unexpected deduplicated structures (-want +got):\n%s", diff)
+	}
+
+	if n := len(decode()); n != 8 {
+		t.Fatalf("unexpected number of structures without deduplication: %d", n)
+	}
+}
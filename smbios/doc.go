@@ -14,4 +14,26 @@
 
 // Package smbios provides detection and access to System Management BIOS (SMBIOS)
 // and Desktop Management Interface (DMI) data and structures.
+//
+// # Safe build tag
+//
+// For use in sandboxed environments, such as agents confined by seccomp, the
+// package can be built with the "safe" build tag to limit the operating system
+// resources it may access.  With the safe build tag:
+//
+//   - /dev/mem is never opened, as if WithoutDevMem were always set.
+//   - Package unsafe is not used, so Stream is disabled on Windows, which
+//     requires it to call GetSystemFirmwareTable.
+//   - No external commands are executed.
+//
+// In this configuration, Stream reads SMBIOS data only from files, such as
+// the Linux sysfs files /sys/firmware/dmi/tables/smbios_entry_point and
+// /sys/firmware/dmi/tables/DMI, using only system calls to stat, open, read,
+// and close files, in addition to those made by the Go runtime.  Functions
+// which read from caller-provided sources, such as StreamFromBytes and
+// StreamFromMemory, perform no system calls of their own.
+//
+// Options which cache SMBIOS data additionally access their cache file and an
+// identifier for the current boot, such as /proc/sys/kernel/random/boot_id on
+// Linux or the kern.boottime sysctl on BSDs.
 package smbios
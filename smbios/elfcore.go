@@ -0,0 +1,132 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"bytes"
+	"debug/elf"
+	"errors"
+	"io"
+)
+
+// ELFCoreSegments returns the Segments of physical memory captured in an ELF
+// core file, such as a Linux kernel crash dump (vmcore) or a QEMU guest memory
+// dump, for use with NewSegmentAddrSpace.
+func ELFCoreSegments(r io.ReaderAt) ([]Segment, error) {
+	f, err := elf.NewFile(r)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if f.Type != elf.ET_CORE {
+		return nil, errors.New("ELF file is not a core file")
+	}
+
+	var segs []Segment
+	for _, p := range f.Progs {
+		// Only loadable segments contain memory, and memory which was not
+		// captured in the file cannot be read.
+		if p.Type != elf.PT_LOAD || p.Filesz == 0 {
+			continue
+		}
+
+		segs = append(segs, Segment{
+			Addr:   int64(p.Paddr),
+			Offset: int64(p.Off),
+			Size:   int64(p.Filesz),
+		})
+	}
+
+	if len(segs) == 0 {
+		return nil, errors.New("ELF core file contains no memory segments")
+	}
+
+	return segs, nil
+}
+
+// StreamFromELFCore locates and reads the SMBIOS entry point and structure
+// stream from physical memory captured in an ELF core file, such as a Linux
+// kernel crash dump (vmcore) or a QEMU guest memory dump, to identify the
+// hardware a dump came from.
+//
+// The entry point is first searched for in its legacy BIOS memory location.
+// Crash dumps often omit that memory, and UEFI systems may place the entry
+// point elsewhere, so all captured memory is then searched for an entry point
+// which refers to a captured structure table.  This may be slow for large
+// dumps.
+//
+// The returned stream is always detached.
+func StreamFromELFCore(r io.ReaderAt) (io.ReadCloser, EntryPoint, error) {
+	segs, err := ELFCoreSegments(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	as := NewSegmentAddrSpace(r, segs)
+
+	rc, ep, err := StreamFromAddrSpace(as)
+	if err == nil {
+		return rc, ep, nil
+	}
+
+	rc, ep, err = scanSegments(as, segs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &opaqueReadCloser{
+		rc:       rc,
+		detached: true,
+	}, ep, nil
+}
+
+// scanSegments searches all memory in segs for a valid SMBIOS entry point
+// whose structure table is present in as.
+func scanSegments(as AddrSpace, segs []Segment) (io.ReadCloser, EntryPoint, error) {
+	// Read memory in large chunks to avoid excessive I/O.  Chunks are a
+	// multiple of the paragraph size so that alignment is preserved.
+	const chunk = 1 << 20
+	b := make([]byte, chunk)
+
+	for _, seg := range segs {
+		for off := int64(0); off < seg.Size; off += chunk {
+			n := seg.Size - off
+			if n > chunk {
+				n = chunk
+			}
+
+			addr := seg.Addr + off
+			if _, err := as.ReadAt(b[:n], addr); err != nil {
+				return nil, nil, err
+			}
+
+			// Entry points are paragraph-aligned in physical memory.
+			for i := (paragraph - addr%paragraph) % paragraph; i < n; i += paragraph {
+				if !bytes.HasPrefix(b[i:n], magicPrefix) {
+					continue
+				}
+
+				// Memory may contain anchor strings which are not entry
+				// points, so continue searching on failure.
+				if rc, ep, err := tableAt(as, addr+i); err == nil {
+					return rc, ep, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil, errors.New("no SMBIOS entry point found in ELF core file")
+}
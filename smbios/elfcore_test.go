@@ -0,0 +1,160 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios_test
+
+import (
+	"bytes"
+	"debug/elf"
+	"encoding/binary"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestStreamFromELFCore(t *testing.T) {
+	table := []byte{
+		0x01, 0x05, 0x01, 0x00,
+		0x01,
+		's', 'e', 'r', 'i', 'a', 'l', 0x00,
+		0x00,
+
+		127, 0x04, 0x02, 0x00,
+		0x00,
+		0x00,
+	}
+
+	// The entry point and table live outside the legacy BIOS area, as on
+	// UEFI systems, and the BIOS area is not captured in the dump.
+	const (
+		memAddr   = 0x7af00000
+		tableAddr = memAddr + 0x9000
+	)
+
+	ep := &smbios.EntryPoint32Bit{
+		Major:                 2,
+		Minor:                 8,
+		StructureTableAddress: tableAddr,
+	}
+	if err := ep.Recalculate(table); err != nil {
+		t.Fatalf("failed to recalculate entry point: %v", err)
+	}
+
+	epb, err := ep.MarshalBinary()
+	if err != nil {
+		t.Fatalf("failed to marshal entry point: %v", err)
+	}
+
+	mem := make([]byte, 0x10000)
+	// An anchor string which is not an entry point must be skipped.
+	copy(mem[0x40:], "_SM_ garbage")
+	copy(mem[0x1000:], epb)
+	copy(mem[0x9000:], table)
+
+	tests := []struct {
+		name string
+		b    []byte
+		ok   bool
+	}{
+		{
+			name: "not ELF",
+			b:    []byte{0xff, 0xff, 0xff, 0xff},
+		},
+		{
+			name: "not core",
+			b:    elfFile(t, elf.ET_EXEC, 0, mem),
+		},
+		{
+			name: "no entry point",
+			b:    elfFile(t, elf.ET_CORE, memAddr, make([]byte, 0x10000)),
+		},
+		{
+			name: "OK",
+			b:    elfFile(t, elf.ET_CORE, memAddr, mem),
+			ok:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rc, _, err := smbios.StreamFromELFCore(bytes.NewReader(tt.b))
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if !tt.ok {
+				// Don't bother doing comparison if output is incorrect.
+				return
+			}
+			defer rc.Close()
+
+			ss, err := smbios.NewDecoder(rc).Decode()
+			if err != nil {
+				t.Fatalf("failed to decode structures: %v", err)
+			}
+
+			if diff := cmp.Diff([]string{"serial"}, ss[0].Strings); diff != "" {
+				t.Fatalf("unexpected strings (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// elfFile builds a minimal 64-bit ELF file of type typ containing mem in a
+// single loadable segment at physical address addr.
+func elfFile(t *testing.T, typ elf.Type, addr uint64, mem []byte) []byte {
+	t.Helper()
+
+	const (
+		ehsize    = 64
+		phentsize = 56
+	)
+
+	h := elf.Header64{
+		Type:      uint16(typ),
+		Machine:   uint16(elf.EM_X86_64),
+		Version:   uint32(elf.EV_CURRENT),
+		Phoff:     ehsize,
+		Ehsize:    ehsize,
+		Phentsize: phentsize,
+		Phnum:     1,
+	}
+	copy(h.Ident[:], elf.ELFMAG)
+	h.Ident[elf.EI_CLASS] = byte(elf.ELFCLASS64)
+	h.Ident[elf.EI_DATA] = byte(elf.ELFDATA2LSB)
+	h.Ident[elf.EI_VERSION] = byte(elf.EV_CURRENT)
+
+	p := elf.Prog64{
+		Type:   uint32(elf.PT_LOAD),
+		Off:    ehsize + phentsize,
+		Paddr:  addr,
+		Filesz: uint64(len(mem)),
+		Memsz:  uint64(len(mem)),
+	}
+
+	var buf bytes.Buffer
+	for _, v := range []interface{}{h, p} {
+		if err := binary.Write(&buf, binary.LittleEndian, v); err != nil {
+			t.Fatalf("failed to write ELF file: %v", err)
+		}
+	}
+	buf.Write(mem)
+
+	return buf.Bytes()
+}
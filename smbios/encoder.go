@@ -0,0 +1,319 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// requiredTypes are the Structure types which the SMBIOS specification
+// requires to be present in a structure table.
+var requiredTypes = map[uint8]bool{
+	0:  true, // BIOS Information
+	1:  true, // System Information
+	3:  true, // System Enclosure or Chassis
+	4:  true, // Processor Information
+	7:  true, // Cache Information
+	9:  true, // System Slots
+	16: true, // Physical Memory Array
+	17: true, // Memory Device
+	19: true, // Memory Array Mapped Address
+	32: true, // System Boot Information
+}
+
+// An Order specifies how an Encoder orders Structures in a structure table.
+type Order int
+
+// Possible Order values.
+const (
+	// OrderRequired places Structures of the types required by the SMBIOS
+	// specification first, in ascending order by type, followed by all
+	// other Structures in their original order.
+	OrderRequired Order = iota
+
+	// OrderPreserve retains the original order of Structures.
+	OrderPreserve
+
+	// OrderByType sorts all Structures in ascending order by type, retaining
+	// the original order of Structures of the same type.
+	OrderByType
+)
+
+// A HandlePolicy specifies how an Encoder assigns handles to Structures.
+type HandlePolicy int
+
+// Possible HandlePolicy values.
+const (
+	// HandleSequential assigns sequential handles to Structures in the order
+	// they are encoded, starting from 0, as is common in AMI firmware.
+	HandleSequential HandlePolicy = iota
+
+	// HandlePreserve retains the original handles of Structures.  Handles
+	// must be unique, and must not be the reserved handle 0xffff.  An
+	// End-of-table structure inserted by the Encoder is assigned the
+	// handle following the greatest handle, which must not be the reserved
+	// handle.
+	HandlePreserve
+
+	// HandleByType assigns handles consisting of the Structure's type in the
+	// high byte and its index among Structures of the same type in the low
+	// byte, as is common in QEMU and HP firmware.  At most 256 Structures
+	// of each type can be assigned handles, or 255 of type 255, as the
+	// handle 0xffff is reserved.
+	HandleByType
+)
+
+// An EncoderOption configures the behavior of an Encoder.
+type EncoderOption func(*Encoder)
+
+// WithOrder configures the Order an Encoder uses for Structures.  The default
+// is OrderRequired.
+func WithOrder(o Order) EncoderOption {
+	return func(e *Encoder) {
+		e.order = o
+	}
+}
+
+// WithHandlePolicy configures the HandlePolicy an Encoder uses to assign
+// handles to Structures.  The default is HandleSequential.
+//
+// Handles which are referenced by the formatted data of other Structures, such
+// as a Memory Device's Physical Memory Array handle, are not rewritten when
+// handles are reassigned.  Use HandlePreserve for Structures which reference
+// each other.
+func WithHandlePolicy(p HandlePolicy) EncoderOption {
+	return func(e *Encoder) {
+		e.handles = p
+	}
+}
+
+// WithEntryPoint configures an Encoder to update ep to describe each encoded
+// structure table, including its length, maximum structure size, and
+// checksums as applicable.  ep must be an *EntryPoint32Bit, *EntryPoint64Bit,
+// or *WindowsEntryPoint.
+func WithEntryPoint(ep EntryPoint) EncoderOption {
+	return func(e *Encoder) {
+		e.ep = ep
+	}
+}
+
+// An Encoder encodes Structures to a stream.
+type Encoder struct {
+	w       io.Writer
+	order   Order
+	handles HandlePolicy
+	ep      EntryPoint
+}
+
+// A recalculator is an EntryPoint which can be updated to describe a structure
+// table.
+type recalculator interface {
+	EntryPoint
+	Recalculate(table []byte) error
+}
+
+// NewEncoder creates an Encoder which encodes Structures to the output stream.
+// EncoderOptions may be specified to alter the behavior of the Encoder.
+func NewEncoder(w io.Writer, options ...EncoderOption) *Encoder {
+	e := &Encoder{w: w}
+
+	for _, o := range options {
+		o(e)
+	}
+
+	return e
+}
+
+// Encode encodes ss as a structure table to the Encoder's stream.  Structures
+// are ordered and assigned handles as configured by the Encoder's options.
+//
+// An End-of-table structure is always encoded last, and is inserted if ss does
+// not contain one.  Any additional End-of-table structures are discarded.
+// The Structures in ss are not modified.
+//
+// If an EntryPoint was configured using WithEntryPoint, it is updated to
+// describe the encoded structure table.
+func (e *Encoder) Encode(ss []*Structure) error {
+	out, err := e.layout(ss)
+	if err != nil {
+		return err
+	}
+
+	var b []byte
+	for _, s := range out {
+		b = appendStructure(b, s)
+	}
+
+	if e.ep != nil {
+		r, ok := e.ep.(recalculator)
+		if !ok {
+			return fmt.Errorf("cannot update SMBIOS entry point of type %T", e.ep)
+		}
+
+		if err := r.Recalculate(b); err != nil {
+			return err
+		}
+	}
+
+	_, err = e.w.Write(b)
+	return err
+}
+
+// layout validates, orders, and assigns handles to copies of ss.
+func (e *Encoder) layout(ss []*Structure) ([]*Structure, error) {
+	var (
+		out []*Structure
+		eot *Structure
+	)
+
+	for _, s := range ss {
+		if err := checkEncode(s); err != nil {
+			return nil, err
+		}
+
+		// Only a single End-of-table structure is permitted.
+		if s.Header.Type == typeEndOfTable {
+			if eot == nil {
+				eot = s
+			}
+
+			continue
+		}
+
+		c := *s
+		out = append(out, &c)
+	}
+
+	switch e.order {
+	case OrderRequired:
+		sort.SliceStable(out, func(i, j int) bool {
+			ti, tj := out[i].Header.Type, out[j].Header.Type
+			ri, rj := requiredTypes[ti], requiredTypes[tj]
+			if ri != rj {
+				return ri
+			}
+
+			return ri && ti < tj
+		})
+	case OrderByType:
+		sort.SliceStable(out, func(i, j int) bool {
+			return out[i].Header.Type < out[j].Header.Type
+		})
+	}
+
+	// Insert an End-of-table structure if necessary.
+	inserted := eot == nil
+	if inserted {
+		eot = &Structure{
+			Header: Header{
+				Type:   typeEndOfTable,
+				Length: headerLen,
+			},
+		}
+	}
+
+	c := *eot
+	out = append(out, &c)
+
+	if err := e.assignHandles(out, inserted); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// assignHandles assigns handles to ss according to the Encoder's
+// HandlePolicy.  If inserted is true, the final End-of-table structure was
+// inserted by the Encoder and has no handle of its own.
+func (e *Encoder) assignHandles(ss []*Structure, inserted bool) error {
+	switch e.handles {
+	case HandleSequential:
+		for i, s := range ss {
+			if i >= handleReserved {
+				return fmt.Errorf("too many SMBIOS structures to assign sequential handles: %d", len(ss))
+			}
+
+			s.Header.Handle = uint16(i)
+		}
+	case HandleByType:
+		var n [256]int
+		for _, s := range ss {
+			// The handle of the 256th type 255 structure would be the
+			// reserved handle.
+			i := n[s.Header.Type]
+			if i > 0xff || uint16(s.Header.Type)<<8|uint16(i) == handleReserved {
+				return fmt.Errorf("too many SMBIOS type %d structures to assign handles by type", s.Header.Type)
+			}
+
+			s.Header.Handle = uint16(s.Header.Type)<<8 | uint16(i)
+			n[s.Header.Type]++
+		}
+	case HandlePreserve:
+		seen := make(map[uint16]bool, len(ss))
+
+		var greatest uint16
+		for _, s := range ss {
+			if inserted && s == ss[len(ss)-1] {
+				// Assign the inserted End-of-table structure the handle
+				// following the greatest handle in use, which must not be
+				// the reserved handle.
+				if greatest >= handleReserved-1 {
+					return fmt.Errorf("no SMBIOS structure handle available for End-of-table structure after handle 0x%04x", greatest)
+				}
+
+				s.Header.Handle = greatest + 1
+			}
+
+			if s.Header.Handle == handleReserved {
+				return fmt.Errorf("SMBIOS type %d structure uses reserved handle 0x%04x", s.Header.Type, handleReserved)
+			}
+
+			if seen[s.Header.Handle] {
+				return fmt.Errorf("duplicate SMBIOS structure handle: 0x%04x", s.Header.Handle)
+			}
+			seen[s.Header.Handle] = true
+
+			if s.Header.Handle > greatest {
+				greatest = s.Header.Handle
+			}
+		}
+	default:
+		return fmt.Errorf("unknown SMBIOS handle policy: %d", e.handles)
+	}
+
+	return nil
+}
+
+// checkEncode verifies that s can be encoded in a structure table.
+func checkEncode(s *Structure) error {
+	if l := headerLen + len(s.Formatted); int(s.Header.Length) != l {
+		return fmt.Errorf("SMBIOS type %d structure length %d does not match formatted data length: %d",
+			s.Header.Type, s.Header.Length, l)
+	}
+
+	for i, str := range s.Strings {
+		// An empty string or a string containing a null byte would corrupt
+		// the string-set.
+		if str == "" || strings.IndexByte(str, 0x00) != -1 {
+			return fmt.Errorf("SMBIOS type %d structure string %d is empty or contains a null byte",
+				s.Header.Type, i+1)
+		}
+	}
+
+	return nil
+}
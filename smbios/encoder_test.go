@@ -0,0 +1,227 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEncoder(t *testing.T) {
+	s := func(typ uint8, handle uint16, strs ...string) *smbios.Structure {
+		return &smbios.Structure{
+			Header: smbios.Header{
+				Type:   typ,
+				Length: 5,
+				Handle: handle,
+			},
+			Formatted: []byte{typ},
+			Strings:   strs,
+		}
+	}
+
+	eot := func(handle uint16) *smbios.Structure {
+		return &smbios.Structure{
+			Header: smbios.Header{
+				Type:   127,
+				Length: 4,
+				Handle: handle,
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		options []smbios.EncoderOption
+		in      []*smbios.Structure
+		out     []*smbios.Structure
+		ok      bool
+	}{
+		{
+			name: "bad length",
+			in: []*smbios.Structure{{
+				Header:    smbios.Header{Type: 1, Length: 4},
+				Formatted: []byte{0x00},
+			}},
+		},
+		{
+			name: "empty string",
+			in:   []*smbios.Structure{s(1, 0, "")},
+		},
+		{
+			name: "null in string",
+			in:   []*smbios.Structure{s(1, 0, "a\x00b")},
+		},
+		{
+			name:    "duplicate handles",
+			options: []smbios.EncoderOption{smbios.WithHandlePolicy(smbios.HandlePreserve)},
+			in:      []*smbios.Structure{s(1, 0x10), s(2, 0x10)},
+		},
+		{
+			name:    "preserve reserved handle",
+			options: []smbios.EncoderOption{smbios.WithHandlePolicy(smbios.HandlePreserve)},
+			in:      []*smbios.Structure{s(1, 0x10), s(2, 0xffff), s(127, 0x11)},
+		},
+		{
+			name:    "preserve handles exhausted",
+			options: []smbios.EncoderOption{smbios.WithHandlePolicy(smbios.HandlePreserve)},
+			in:      []*smbios.Structure{s(1, 0x10), s(2, 0xfffe)},
+		},
+		{
+			name: "by type handles exhausted",
+			options: []smbios.EncoderOption{
+				smbios.WithHandlePolicy(smbios.HandleByType),
+			},
+			in: func() []*smbios.Structure {
+				// The 256th type 255 structure would use the reserved handle.
+				ss := make([]*smbios.Structure, 0, 256)
+				for i := 0; i < 256; i++ {
+					ss = append(ss, s(255, 0))
+				}
+				return ss
+			}(),
+		},
+		{
+			name: "OK, empty",
+			out:  []*smbios.Structure{eot(0)},
+			ok:   true,
+		},
+		{
+			name: "OK, defaults",
+			in: []*smbios.Structure{
+				eot(0x99),
+				s(200, 0x10, "oem"),
+				s(2, 0x11),
+				s(1, 0x12, "system"),
+				s(0, 0x13),
+				eot(0x98),
+			},
+			out: []*smbios.Structure{
+				s(0, 0x00),
+				s(1, 0x01, "system"),
+				s(200, 0x02, "oem"),
+				s(2, 0x03),
+				eot(0x04),
+			},
+			ok: true,
+		},
+		{
+			name: "OK, preserve",
+			options: []smbios.EncoderOption{
+				smbios.WithOrder(smbios.OrderPreserve),
+				smbios.WithHandlePolicy(smbios.HandlePreserve),
+			},
+			in: []*smbios.Structure{
+				s(2, 0x20),
+				s(1, 0x10),
+			},
+			out: []*smbios.Structure{
+				s(2, 0x20),
+				s(1, 0x10),
+				eot(0x21),
+			},
+			ok: true,
+		},
+		{
+			name: "OK, by type",
+			options: []smbios.EncoderOption{
+				smbios.WithOrder(smbios.OrderByType),
+				smbios.WithHandlePolicy(smbios.HandleByType),
+			},
+			in: []*smbios.Structure{
+				s(200, 0),
+				s(17, 0, "DIMM 1"),
+				s(4, 0),
+				s(17, 0, "DIMM 0"),
+			},
+			out: []*smbios.Structure{
+				s(4, 0x0400),
+				s(17, 0x1100, "DIMM 1"),
+				s(17, 0x1101, "DIMM 0"),
+				s(200, 0xc800),
+				eot(0x7f00),
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := smbios.NewEncoder(&buf, tt.options...).Encode(tt.in)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+			if !tt.ok {
+				// Don't bother doing comparison if output is nil.
+				return
+			}
+
+			ss, err := smbios.NewDecoder(&buf).Decode()
+			if err != nil {
+				t.Fatalf("failed to decode structures: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.out, ss); diff != "" {
+				t.Fatalf("unexpected structures (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEncoderEntryPoint(t *testing.T) {
+	ss := []*smbios.Structure{{
+		Header: smbios.Header{
+			Type:   1,
+			Length: 5,
+		},
+		Formatted: []byte{0x01},
+		Strings:   []string{"serial"},
+	}}
+
+	ep := &smbios.EntryPoint32Bit{Major: 2, Minor: 8}
+
+	var buf bytes.Buffer
+	if err := smbios.NewEncoder(&buf, smbios.WithEntryPoint(ep)).Encode(ss); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	b, err := ep.MarshalBinary()
+	if err != nil {
+		t.Fatalf("failed to marshal entry point: %v", err)
+	}
+
+	rc, got, err := smbios.StreamFromBytes(b, buf.Bytes())
+	if err != nil {
+		t.Fatalf("failed to open stream: %v", err)
+	}
+	defer rc.Close()
+
+	_, size := got.Table()
+	if diff := cmp.Diff(buf.Len(), size); diff != "" {
+		t.Fatalf("unexpected table size (-want +got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff(2, int(got.(*smbios.EntryPoint32Bit).NumberStructures)); diff != "" {
+		t.Fatalf("unexpected number of structures (-want +got):\n%s", diff)
+	}
+}
@@ -17,9 +17,11 @@ package smbios
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math"
 )
 
 // Anchor strings used to detect entry points.
@@ -45,7 +47,12 @@ type EntryPoint interface {
 	Version() (major, minor, revision int)
 }
 
-// ParseEntryPoint parses an EntryPoint from the input stream.
+// ErrInvalidEntryPoint is wrapped by errors returned when an entry point's data
+// is malformed, such as when its anchor or checksum is incorrect.
+var ErrInvalidEntryPoint = errors.New("invalid SMBIOS entry point")
+
+// ParseEntryPoint parses an EntryPoint from the input stream.  If the entry
+// point is malformed, the error wraps ErrInvalidEntryPoint.
 func ParseEntryPoint(r io.Reader) (EntryPoint, error) {
 	// Prevent unbounded reads since this structure should be small.
 	b, err := ioutil.ReadAll(io.LimitReader(r, 64))
@@ -53,6 +60,16 @@ func ParseEntryPoint(r io.Reader) (EntryPoint, error) {
 		return nil, err
 	}
 
+	ep, err := parseEntryPoint(b)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidEntryPoint, err)
+	}
+
+	return ep, nil
+}
+
+// parseEntryPoint parses an EntryPoint from b.
+func parseEntryPoint(b []byte) (EntryPoint, error) {
 	if l := len(b); l < 4 {
 		return nil, fmt.Errorf("too few bytes for SMBIOS entry point magic: %d", l)
 	}
@@ -71,6 +88,9 @@ var _ EntryPoint = &EntryPoint32Bit{}
 
 // EntryPoint32Bit is the SMBIOS 32-bit Entry Point structure, used starting
 // in SMBIOS 2.1.
+//
+// EntryPoint32Bit is encoded as JSON using its Go field names, like Structure
+// and Header.  See EntryPoint32Bit.MarshalJSON for details.
 type EntryPoint32Bit struct {
 	Anchor                string
 	Checksum              uint8
@@ -98,14 +118,86 @@ func (e *EntryPoint32Bit) Version() (major, minor, revision int) {
 	return int(e.Major), int(e.Minor), 0
 }
 
+// MarshalBinary implements encoding.BinaryMarshaler.  The checksums are
+// encoded as-is; use Recalculate to update them after modifying e.
+func (e *EntryPoint32Bit) MarshalBinary() ([]byte, error) {
+	n := int(e.Length)
+	if n < expLen32 {
+		n = expLen32
+	}
+
+	b := make([]byte, n)
+	copy(b[0:4], magic32)
+	b[4] = e.Checksum
+	b[5] = e.Length
+	b[6] = e.Major
+	b[7] = e.Minor
+	binary.LittleEndian.PutUint16(b[8:10], e.MaxStructureSize)
+	b[10] = e.EntryPointRevision
+	copy(b[11:16], e.FormattedArea[:])
+	copy(b[16:21], magicDMI)
+	b[21] = e.IntermediateChecksum
+	binary.LittleEndian.PutUint16(b[22:24], e.StructureTableLength)
+	binary.LittleEndian.PutUint32(b[24:28], e.StructureTableAddress)
+	binary.LittleEndian.PutUint16(b[28:30], e.NumberStructures)
+	b[30] = e.BCDRevision
+
+	return b, nil
+}
+
+// Recalculate updates the maximum structure size, table length, number of
+// structures, and both checksums of e to describe the structure table in
+// table.  Recalculate is useful after patching an existing structure table,
+// such as to change a serial number in a dump for a test rig.
+func (e *EntryPoint32Bit) Recalculate(table []byte) error {
+	if l := len(table); l > math.MaxUint16 {
+		return fmt.Errorf("SMBIOS structure table too large for 32-bit entry point: %d", l)
+	}
+
+	ss, err := NewDecoder(bytes.NewReader(table)).Decode()
+	if err != nil {
+		return err
+	}
+
+	var max int
+	for _, s := range ss {
+		if l := len(appendStructure(nil, s)); l > max {
+			max = l
+		}
+	}
+
+	if e.Length == 0 {
+		e.Length = expLen32
+	}
+
+	e.Anchor = string(magic32)
+	e.IntermediateAnchor = string(magicDMI)
+	e.MaxStructureSize = uint16(max)
+	e.StructureTableLength = uint16(len(table))
+	e.NumberStructures = uint16(len(ss))
+
+	// The intermediate checksum covers the intermediate entry point, and the
+	// entry point checksum covers the entire entry point, including the
+	// intermediate checksum.
+	e.IntermediateChecksum, e.Checksum = 0, 0
+	b, _ := e.MarshalBinary()
+	e.IntermediateChecksum = -sum(b[16:expLen32])
+	b[21] = e.IntermediateChecksum
+	e.Checksum = -sum(b[:e.Length])
+
+	return nil
+}
+
+// expLen32 is the expected minimum length of a 32-bit entry point.
+// Correct minimum length as of SMBIOS 3.1.1.
+const expLen32 = 31
+
 // parse32 parses an EntryPoint32Bit from b.
 func parse32(b []byte) (*EntryPoint32Bit, error) {
 	l := len(b)
 
-	// Correct minimum length as of SMBIOS 3.1.1.
-	const expLen = 31
-	if l < expLen {
-		return nil, fmt.Errorf("expected SMBIOS 32-bit entry point minimum length of at least %d, but got: %d", expLen, l)
+	if l < expLen32 {
+		return nil, fmt.Errorf("expected SMBIOS 32-bit entry point minimum length of at least %d, but got: %d", expLen32, l)
 	}
 
 	// Allow more data in the buffer than the actual length, for when the
@@ -146,7 +238,7 @@ func parse32(b []byte) (*EntryPoint32Bit, error) {
 		NumberStructures:      binary.LittleEndian.Uint16(b[28:30]),
 		BCDRevision:           b[30],
 	}
-	copy(ep.FormattedArea[:], b[10:15])
+	copy(ep.FormattedArea[:], b[11:16])
 
 	return ep, nil
 }
@@ -155,6 +247,9 @@ var _ EntryPoint = &EntryPoint64Bit{}
 
 // EntryPoint64Bit is the SMBIOS 64-bit Entry Point structure, used starting
 // in SMBIOS 3.0.
+//
+// EntryPoint64Bit is encoded as JSON using its Go field names, like Structure
+// and Header.  See EntryPoint64Bit.MarshalJSON for details.
 type EntryPoint64Bit struct {
 	Anchor                string
 	Checksum              uint8
@@ -178,6 +273,56 @@ func (e *EntryPoint64Bit) Version() (major, minor, revision int) {
 	return int(e.Major), int(e.Minor), int(e.Revision)
 }
 
+// MarshalBinary implements encoding.BinaryMarshaler.  The checksum is
+// encoded as-is; use Recalculate to update it after modifying e.
+func (e *EntryPoint64Bit) MarshalBinary() ([]byte, error) {
+	n := int(e.Length)
+	if n < expLen64 {
+		n = expLen64
+	}
+
+	b := make([]byte, n)
+	copy(b[0:5], magic64)
+	b[chkIndex64] = e.Checksum
+	b[6] = e.Length
+	b[7] = e.Major
+	b[8] = e.Minor
+	b[9] = e.Revision
+	b[10] = e.EntryPointRevision
+	b[11] = e.Reserved
+	binary.LittleEndian.PutUint32(b[12:16], e.StructureTableMaxSize)
+	binary.LittleEndian.PutUint64(b[16:24], e.StructureTableAddress)
+
+	return b, nil
+}
+
+// Recalculate updates the maximum table size and checksum of e to describe
+// the structure table in table.  Recalculate is useful after patching an
+// existing structure table, such as to change a serial number in a dump for a
+// test rig.
+func (e *EntryPoint64Bit) Recalculate(table []byte) error {
+	if l := len(table); uint64(l) > math.MaxUint32 {
+		return fmt.Errorf("SMBIOS structure table too large for 64-bit entry point: %d", l)
+	}
+
+	if _, err := NewDecoder(bytes.NewReader(table)).Decode(); err != nil {
+		return err
+	}
+
+	if e.Length == 0 {
+		e.Length = expLen64
+	}
+
+	e.Anchor = string(magic64)
+	e.StructureTableMaxSize = uint32(len(table))
+
+	e.Checksum = 0
+	b, _ := e.MarshalBinary()
+	e.Checksum = -sum(b[:e.Length])
+
+	return nil
+}
+
 const (
 	// expLen64 is the expected minimum length of a 64-bit entry point.
 	// Correct minimum length as of SMBIOS 3.1.1.
@@ -245,9 +390,22 @@ func checksum(start uint8, chkIndex int, b []byte) error {
 	return nil
 }
 
+// sum computes the 8-bit sum of the bytes in b.
+func sum(b []byte) uint8 {
+	var s uint8
+	for _, c := range b {
+		s += c
+	}
+
+	return s
+}
+
 // WindowsEntryPoint contains SMBIOS Table entry point data returned from
 // GetSystemFirmwareTable. As raw access to the underlying memory is not given,
 // the full breadth of information is not available.
+//
+// WindowsEntryPoint is encoded as JSON using its Go field names, like
+// Structure and Header.  See WindowsEntryPoint.MarshalJSON for details.
 type WindowsEntryPoint struct {
 	Size         uint32
 	MajorVersion byte
@@ -261,6 +419,21 @@ func (e *WindowsEntryPoint) Table() (address, size int) {
 	return 0, int(e.Size)
 }
 
+// Recalculate updates the table size of e to describe the structure table in
+// table.
+func (e *WindowsEntryPoint) Recalculate(table []byte) error {
+	if l := len(table); uint64(l) > math.MaxUint32 {
+		return fmt.Errorf("SMBIOS structure table too large for Windows entry point: %d", l)
+	}
+
+	if _, err := NewDecoder(bytes.NewReader(table)).Decode(); err != nil {
+		return err
+	}
+
+	e.Size = uint32(len(table))
+	return nil
+}
+
 // Version implements EntryPoint.
 func (e *WindowsEntryPoint) Version() (major, minor, revision int) {
 	return int(e.MajorVersion), int(e.MinorVersion), int(e.Revision)
@@ -0,0 +1,147 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// A hexAddress is a physical memory address which is encoded as a
+// hexadecimal JSON string, such as "0x000f0000".
+type hexAddress uint64
+
+// MarshalText implements encoding.TextMarshaler.
+func (a hexAddress) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("0x%08x", uint64(a))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (a *hexAddress) UnmarshalText(b []byte) error {
+	s := string(b)
+	if !strings.HasPrefix(s, "0x") {
+		return fmt.Errorf("invalid hexadecimal address: %q", s)
+	}
+
+	v, err := strconv.ParseUint(s[2:], 16, 64)
+	if err != nil {
+		return fmt.Errorf("invalid hexadecimal address: %q", s)
+	}
+
+	*a = hexAddress(v)
+	return nil
+}
+
+// version formats the version of an EntryPoint for inclusion in its JSON
+// encoding.
+func version(ep EntryPoint) string {
+	major, minor, rev := ep.Version()
+	return fmt.Sprintf("%d.%d.%d", major, minor, rev)
+}
+
+// MarshalJSON implements json.Marshaler.  In addition to the fields of e,
+// the encoding includes the kind of entry point ("32-bit") and the SMBIOS
+// version it describes (such as "2.8.0"), and the structure table address is
+// encoded as a hexadecimal string (such as "0x000f0000").
+func (e EntryPoint32Bit) MarshalJSON() ([]byte, error) {
+	type alias EntryPoint32Bit
+	return json.Marshal(struct {
+		Kind    string
+		Version string
+		alias
+		StructureTableAddress hexAddress
+	}{
+		Kind:                  kind32,
+		Version:               version(&e),
+		alias:                 alias(e),
+		StructureTableAddress: hexAddress(e.StructureTableAddress),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding the encoding produced
+// by MarshalJSON.
+func (e *EntryPoint32Bit) UnmarshalJSON(b []byte) error {
+	type alias EntryPoint32Bit
+	v := struct {
+		*alias
+		StructureTableAddress hexAddress
+	}{alias: (*alias)(e)}
+
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	if v.StructureTableAddress > 0xffffffff {
+		return fmt.Errorf("32-bit entry point structure table address out of range: %#x", uint64(v.StructureTableAddress))
+	}
+
+	e.StructureTableAddress = uint32(v.StructureTableAddress)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.  In addition to the fields of e,
+// the encoding includes the kind of entry point ("64-bit") and the SMBIOS
+// version it describes (such as "3.2.0"), and the structure table address is
+// encoded as a hexadecimal string (such as "0x000f0000").
+func (e EntryPoint64Bit) MarshalJSON() ([]byte, error) {
+	type alias EntryPoint64Bit
+	return json.Marshal(struct {
+		Kind    string
+		Version string
+		alias
+		StructureTableAddress hexAddress
+	}{
+		Kind:                  kind64,
+		Version:               version(&e),
+		alias:                 alias(e),
+		StructureTableAddress: hexAddress(e.StructureTableAddress),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding the encoding produced
+// by MarshalJSON.
+func (e *EntryPoint64Bit) UnmarshalJSON(b []byte) error {
+	type alias EntryPoint64Bit
+	v := struct {
+		*alias
+		StructureTableAddress hexAddress
+	}{alias: (*alias)(e)}
+
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	e.StructureTableAddress = uint64(v.StructureTableAddress)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.  In addition to the fields of e,
+// the encoding includes the kind of entry point ("windows") and the SMBIOS
+// version it describes (such as "3.2.0").  No structure table address is
+// available on Windows.
+func (e WindowsEntryPoint) MarshalJSON() ([]byte, error) {
+	type alias WindowsEntryPoint
+	return json.Marshal(struct {
+		Kind    string
+		Version string
+		alias
+	}{
+		Kind:    kindWindows,
+		Version: version(&e),
+		alias:   alias(e),
+	})
+}
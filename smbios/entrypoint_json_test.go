@@ -0,0 +1,110 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEntryPointJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		ep   smbios.EntryPoint
+		json string
+	}{
+		{
+			name: "32-bit",
+			ep: &smbios.EntryPoint32Bit{
+				Anchor:                "_SM_",
+				Checksum:              0xc2,
+				Length:                0x1f,
+				Major:                 2,
+				Minor:                 8,
+				MaxStructureSize:      0x0100,
+				IntermediateAnchor:    "_DMI_",
+				IntermediateChecksum:  0x3a,
+				StructureTableLength:  0x0a00,
+				StructureTableAddress: 0x000f0000,
+				NumberStructures:      42,
+				BCDRevision:           0x28,
+			},
+			json: `{"Kind":"32-bit","Version":"2.8.0","Anchor":"_SM_","Checksum":194,"Length":31,"Major":2,"Minor":8,"MaxStructureSize":256,"EntryPointRevision":0,"FormattedArea":[0,0,0,0,0],"IntermediateAnchor":"_DMI_","IntermediateChecksum":58,"StructureTableLength":2560,"NumberStructures":42,"BCDRevision":40,"StructureTableAddress":"0x000f0000"}`,
+		},
+		{
+			name: "64-bit",
+			ep: &smbios.EntryPoint64Bit{
+				Anchor:                "_SM3_",
+				Checksum:              0x8f,
+				Length:                0x18,
+				Major:                 3,
+				Minor:                 2,
+				EntryPointRevision:    1,
+				StructureTableMaxSize: 0x1000,
+				StructureTableAddress: 0x7b8f1000,
+			},
+			json: `{"Kind":"64-bit","Version":"3.2.0","Anchor":"_SM3_","Checksum":143,"Length":24,"Major":3,"Minor":2,"Revision":0,"EntryPointRevision":1,"Reserved":0,"StructureTableMaxSize":4096,"StructureTableAddress":"0x7b8f1000"}`,
+		},
+		{
+			name: "Windows",
+			ep: &smbios.WindowsEntryPoint{
+				Size:         0x1000,
+				MajorVersion: 3,
+				MinorVersion: 4,
+			},
+			json: `{"Kind":"windows","Version":"3.4.0","Size":4096,"MajorVersion":3,"MinorVersion":4,"Revision":0}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.ep)
+			if err != nil {
+				t.Fatalf("failed to marshal: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.json, string(b)); diff != "" {
+				t.Fatalf("unexpected JSON (-want +got):\n%s", diff)
+			}
+
+			// The encoding must round trip.
+			ep := reflect.New(reflect.TypeOf(tt.ep).Elem()).Interface()
+			if err := json.Unmarshal(b, ep); err != nil {
+				t.Fatalf("failed to unmarshal: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.ep, ep); diff != "" {
+				t.Fatalf("unexpected entry point (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEntryPointJSONInvalidAddress(t *testing.T) {
+	for _, s := range []string{
+		`{"StructureTableAddress":"000f0000"}`,
+		`{"StructureTableAddress":"0xnope"}`,
+		`{"StructureTableAddress":"0x100000000"}`,
+	} {
+		var ep smbios.EntryPoint32Bit
+		if err := json.Unmarshal([]byte(s), &ep); err == nil {
+			t.Fatalf("expected an error, but none occurred: %s", s)
+		}
+	}
+}
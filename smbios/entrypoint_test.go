@@ -16,6 +16,7 @@ package smbios_test
 
 import (
 	"bytes"
+	"encoding"
 	"testing"
 
 	"github.com/digitalocean/go-smbios/smbios"
@@ -307,3 +308,168 @@ func TestParseEntryPoint(t *testing.T) {
 		})
 	}
 }
+
+func TestEntryPointMarshalBinary(t *testing.T) {
+	tests := []struct {
+		name string
+		b    []byte
+	}{
+		{
+			name: "32",
+			b: []byte{
+				'_', 'S', 'M', '_',
+				0xa4,
+				0x1f,
+				0x2,
+				0x8,
+				0xd4,
+				0x1, 0x0,
+				0x0, 0x0, 0x0, 0x0, 0x0,
+				'_', 'D', 'M', 'I', '_',
+				0x95,
+				0x5f, 0xf,
+				0x0, 0x90, 0xf0, 0x7a,
+				0x43, 0x0,
+				0x28,
+			},
+		},
+		{
+			name: "64",
+			b: []byte{
+				'_', 'S', 'M', '3', '_',
+				0x86,
+				0x18,
+				0x3,
+				0x0,
+				0x0,
+				0x1,
+				0x0,
+				0x53, 0x9, 0x0, 0x0,
+				0xb0, 0xb3, 0xe, 0x0, 0x0, 0x0, 0x0, 0x0,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ep, err := smbios.ParseEntryPoint(bytes.NewReader(tt.b))
+			if err != nil {
+				t.Fatalf("failed to parse entry point: %v", err)
+			}
+
+			b, err := ep.(encoding.BinaryMarshaler).MarshalBinary()
+			if err != nil {
+				t.Fatalf("failed to marshal entry point: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.b, b); diff != "" {
+				t.Fatalf("unexpected entry point bytes (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEntryPointRecalculate(t *testing.T) {
+	table := []byte{
+		0x01, 0x05, 0x00, 0x00,
+		0x01,
+		's', 'e', 'r', 'i', 'a', 'l', 0x00,
+		0x00,
+
+		127, 0x04, 0x01, 0x00,
+		0x00,
+		0x00,
+	}
+
+	t.Run("32", func(t *testing.T) {
+		ep := &smbios.EntryPoint32Bit{
+			Major:                 2,
+			Minor:                 8,
+			StructureTableAddress: 0x7af09000,
+		}
+
+		if err := ep.Recalculate(table); err != nil {
+			t.Fatalf("failed to recalculate: %v", err)
+		}
+
+		b, err := ep.MarshalBinary()
+		if err != nil {
+			t.Fatalf("failed to marshal entry point: %v", err)
+		}
+
+		// Parsing verifies the checksum.
+		got, err := smbios.ParseEntryPoint(bytes.NewReader(b))
+		if err != nil {
+			t.Fatalf("failed to parse recalculated entry point: %v", err)
+		}
+
+		want := &smbios.EntryPoint32Bit{
+			Anchor:                "_SM_",
+			Checksum:              ep.Checksum,
+			Length:                0x1f,
+			Major:                 2,
+			Minor:                 8,
+			MaxStructureSize:      13,
+			IntermediateAnchor:    "_DMI_",
+			IntermediateChecksum:  ep.IntermediateChecksum,
+			StructureTableLength:  uint16(len(table)),
+			StructureTableAddress: 0x7af09000,
+			NumberStructures:      2,
+		}
+
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Fatalf("unexpected entry point (-want +got):\n%s", diff)
+		}
+
+		// The intermediate entry point has its own checksum.
+		var sum uint8
+		for _, c := range b[16:31] {
+			sum += c
+		}
+		if sum != 0 {
+			t.Fatalf("invalid intermediate checksum: %#02x", sum)
+		}
+	})
+
+	t.Run("64", func(t *testing.T) {
+		ep := &smbios.EntryPoint64Bit{
+			Major:                 3,
+			Minor:                 2,
+			StructureTableAddress: 0x0eb3b0,
+		}
+
+		if err := ep.Recalculate(table); err != nil {
+			t.Fatalf("failed to recalculate: %v", err)
+		}
+
+		b, err := ep.MarshalBinary()
+		if err != nil {
+			t.Fatalf("failed to marshal entry point: %v", err)
+		}
+
+		got, err := smbios.ParseEntryPoint(bytes.NewReader(b))
+		if err != nil {
+			t.Fatalf("failed to parse recalculated entry point: %v", err)
+		}
+
+		want := &smbios.EntryPoint64Bit{
+			Anchor:                "_SM3_",
+			Checksum:              ep.Checksum,
+			Length:                0x18,
+			Major:                 3,
+			Minor:                 2,
+			StructureTableMaxSize: uint32(len(table)),
+			StructureTableAddress: 0x0eb3b0,
+		}
+
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Fatalf("unexpected entry point (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("bad table", func(t *testing.T) {
+		if err := (&smbios.EntryPoint32Bit{}).Recalculate([]byte{0xff}); err == nil {
+			t.Fatal("expected an error, but none occurred")
+		}
+	})
+}
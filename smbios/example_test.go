@@ -0,0 +1,180 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+)
+
+// This example demonstrates the typical usage of Stream and Decoder: finding
+// SMBIOS data in an operating system-specific location and decoding it.
+func Example() {
+	// Find SMBIOS data in operating system-specific location.
+	rc, ep, err := smbios.Stream()
+	if err != nil {
+		log.Fatalf("failed to open stream: %v", err)
+	}
+	// Be sure to close the stream!
+	defer rc.Close()
+
+	// Decode SMBIOS structures from the stream.
+	d := smbios.NewDecoder(rc)
+	ss, err := d.Decode()
+	if err != nil {
+		log.Fatalf("failed to decode structures: %v", err)
+	}
+
+	// Determine SMBIOS version and table location from entry point.
+	major, minor, rev := ep.Version()
+	addr, size := ep.Table()
+
+	fmt.Printf("SMBIOS %d.%d.%d - table: address: %#x, size: %d\n",
+		major, minor, rev, addr, size)
+
+	for _, s := range ss {
+		fmt.Println(s)
+	}
+}
+
+// This example demonstrates decoding a structure table which was previously
+// captured to a file, such as a copy of Linux's /sys/firmware/dmi/tables/DMI.
+func ExampleNewDecoder() {
+	f, err := os.Open("DMI")
+	if err != nil {
+		log.Fatalf("failed to open dump: %v", err)
+	}
+	defer f.Close()
+
+	ss, err := smbios.NewDecoder(f).Decode()
+	if err != nil {
+		log.Fatalf("failed to decode structures: %v", err)
+	}
+
+	fmt.Printf("decoded %d structures\n", len(ss))
+}
+
+// This example demonstrates reading the location, size, and speed of memory
+// devices (type 17) from decoded structures using package structures.
+func Example_memoryDevice() {
+	// Two memory devices followed by an end-of-table structure.  The second
+	// device is too large for the size field and uses the extended size field.
+	var b []byte
+	b = append(b, memoryDevice(0x0010, "DIMM A1", 16384, 0, 3200)...)
+	b = append(b, memoryDevice(0x0011, "DIMM A2", 0x7fff, 65536, 0)...)
+	b = append(b, 127, 0x04, 0xff, 0xff, 0x00, 0x00)
+
+	ss, err := smbios.NewDecoder(bytes.NewReader(b)).Decode()
+	if err != nil {
+		log.Fatalf("failed to decode structures: %v", err)
+	}
+
+	for _, s := range ss {
+		if s.Header.Type != structures.TypeMemoryDevice {
+			continue
+		}
+
+		md, err := structures.ParseMemoryDevice(s)
+		if err != nil {
+			log.Fatalf("failed to parse memory device: %v", err)
+		}
+
+		// HumanSize and EffectiveSpeed consult the extended size and speed
+		// fields when required.
+		speed := "unknown speed"
+		if mts, ok := md.EffectiveSpeed(); ok {
+			speed = fmt.Sprintf("%d MT/s", mts)
+		}
+
+		fmt.Printf("%s: %s, %s\n", md.DeviceLocator, md.HumanSize(), speed)
+	}
+
+	// Output:
+	// DIMM A1: 16 GiB, 3200 MT/s
+	// DIMM A2: 64 GiB, unknown speed
+}
+
+// This example demonstrates exporting decoded structures as JSON.
+func Example_json() {
+	b := []byte{
+		0x01, 0x0c, 0x01, 0x00,
+		0xde, 0xad, 0xbe, 0xef, 0xde, 0xad, 0xbe, 0xef,
+		'd', 'e', 'a', 'd', 'b', 'e', 'e', 'f', 0x00,
+		0x00,
+
+		127, 0x04, 0x02, 0x00,
+		0x00,
+		0x00,
+	}
+
+	ss, err := smbios.NewDecoder(bytes.NewReader(b)).Decode()
+	if err != nil {
+		log.Fatalf("failed to decode structures: %v", err)
+	}
+
+	out, err := json.MarshalIndent(ss, "", "\t")
+	if err != nil {
+		log.Fatalf("failed to marshal JSON: %v", err)
+	}
+
+	fmt.Println(string(out))
+
+	// Output:
+	// [
+	// 	{
+	// 		"Header": {
+	// 			"Type": 1,
+	// 			"Length": 12,
+	// 			"Handle": 1
+	// 		},
+	// 		"Formatted": "3q2+796tvu8=",
+	// 		"Strings": [
+	// 			"deadbeef"
+	// 		]
+	// 	},
+	// 	{
+	// 		"Header": {
+	// 			"Type": 127,
+	// 			"Length": 4,
+	// 			"Handle": 2
+	// 		},
+	// 		"Formatted": null,
+	// 		"Strings": null
+	// 	}
+	// ]
+}
+
+// memoryDevice builds a minimal SMBIOS 2.7 memory device structure.
+func memoryDevice(handle uint16, locator string, size uint16, extended uint32, speed uint16) []byte {
+	b := make([]byte, 32)
+
+	b[0] = 17
+	b[1] = byte(len(b))
+	binary.LittleEndian.PutUint16(b[2:4], handle)
+	binary.LittleEndian.PutUint16(b[0x0c:0x0e], size)
+	b[0x10] = 1 // Device locator string index.
+	binary.LittleEndian.PutUint16(b[0x15:0x17], speed)
+	binary.LittleEndian.PutUint32(b[0x1c:0x20], extended)
+
+	b = append(b, locator...)
+	return append(b, 0x00, 0x00)
+}
@@ -0,0 +1,115 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// platformFixtures open recorded SMBIOS data for each operating system using
+// the same code paths that Stream uses on that system, so that each path can
+// be exercised on any platform.  Each fixture contains the same structures.
+var platformFixtures = []struct {
+	name string
+	open func(dir string) (io.ReadCloser, EntryPoint, error)
+}{
+	{
+		// Linux sysfs entry point and DMI table files.
+		name: "linux",
+		open: func(dir string) (io.ReadCloser, EntryPoint, error) {
+			return sysfsStream(
+				filepath.Join(dir, "smbios_entry_point"),
+				filepath.Join(dir, "DMI"),
+			)
+		},
+	},
+	{
+		// A RawSMBIOSData structure from GetSystemFirmwareTable, recorded on
+		// a little-endian system.
+		name: "windows",
+		open: func(dir string) (io.ReadCloser, EntryPoint, error) {
+			b, err := ioutil.ReadFile(filepath.Join(dir, "RawSMBIOSData.bin"))
+			if err != nil {
+				return nil, nil, err
+			}
+
+			return rawSMBIOSDataStream(b, binary.LittleEndian)
+		},
+	},
+	{
+		// An image of /dev/mem beginning at the start of the entry point
+		// search range.
+		name: "freebsd",
+		open: func(dir string) (io.ReadCloser, EntryPoint, error) {
+			f, err := os.Open(filepath.Join(dir, "mem"))
+			if err != nil {
+				return nil, nil, err
+			}
+			defer f.Close()
+
+			return StreamFromMemory(f, func(addr int64) (int64, error) {
+				if addr < startAddr || addr > endAddr {
+					return 0, fmt.Errorf("address %#x not captured", addr)
+				}
+
+				return addr - startAddr, nil
+			})
+		},
+	},
+}
+
+func TestPlatformFixtures(t *testing.T) {
+	var want []*Structure
+	for _, fx := range platformFixtures {
+		t.Run(fx.name, func(t *testing.T) {
+			rc, ep, err := fx.open(filepath.Join("testdata", "fixtures", fx.name))
+			if err != nil {
+				t.Fatalf("failed to open fixture: %v", err)
+			}
+			defer rc.Close()
+
+			if major, minor, _ := ep.Version(); major != 3 || minor != 2 {
+				t.Fatalf("unexpected SMBIOS version: %d.%d", major, minor)
+			}
+
+			ss, err := NewDecoder(rc).Decode()
+			if err != nil {
+				t.Fatalf("failed to decode structures: %v", err)
+			}
+
+			if l := len(ss); l != 3 {
+				t.Fatalf("unexpected number of structures: %d", l)
+			}
+
+			// Each acquisition path must produce identical structures.
+			if want == nil {
+				want = ss
+				return
+			}
+
+			if diff := cmp.Diff(want, ss); diff != "" {
+				t.Fatalf("unexpected structures (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+)
+
+const (
+	// typeReservedStart and typeReservedEnd bound the range of Structure
+	// types which are reserved by the SMBIOS specification, and are not
+	// expected to appear in a structure table.
+	typeReservedStart = 47
+	typeReservedEnd   = 125
+
+	// handleReserved is a reserved handle value, which is used to indicate
+	// an unknown handle in fields which reference other Structures.
+	handleReserved = 0xffff
+)
+
+// DecodeFragment decodes Structures from b, a fragment of an SMBIOS structure
+// table which may begin or end partway through a Structure, such as data
+// recovered from a corrupted or partially overwritten firmware region.
+//
+// DecodeFragment searches b for the first plausible Structure and decodes
+// Structures from that point until an End-of-table structure or the end of b.
+// Bytes which do not form a plausible Structure, such as a truncated or
+// overwritten Structure, are skipped until the next plausible Structure is
+// found.  Because the search is heuristic, DecodeFragment may decode
+// Structures from arbitrary data which happens to resemble a Structure.
+//
+// The Provenance of each Structure records its offset in b.  If b contains no
+// plausible Structures, DecodeFragment returns an error.
+func DecodeFragment(b []byte) ([]*Structure, error) {
+	sc := newStructureScanner(b)
+
+	var ss []*Structure
+	for off := 0; off < len(b); {
+		n, ok := sc.plausible(off)
+		if !ok {
+			// Resynchronize by searching for a Structure at the next byte.
+			off++
+			continue
+		}
+
+		d := NewDecoder(bytes.NewReader(b[off : off+n]))
+		s, err := d.next()
+		if err != nil {
+			// Should not happen, but treat the Structure as
+			// implausible and resynchronize.
+			off++
+			continue
+		}
+
+		s.Provenance = &Provenance{Offset: off}
+		ss = append(ss, s)
+
+		if s.Header.Type == typeEndOfTable {
+			break
+		}
+
+		off += n
+	}
+
+	if len(ss) == 0 {
+		return nil, errors.New("no plausible SMBIOS structures found in fragment")
+	}
+
+	return ss, nil
+}
+
+// A structureScanner finds plausible Structures at arbitrary offsets of a
+// buffer.  Searching each offset of a buffer in turn takes linear time
+// overall, because the end of each string set is found using a precomputed
+// index rather than by scanning the remainder of the buffer.
+type structureScanner struct {
+	b []byte
+
+	// stop[i] is the offset of the first byte at or after offset i which
+	// cannot appear within a string set, or the first of two null bytes
+	// which terminate one.  stop[i] is len(b) if there is no such byte.
+	stop []int
+}
+
+// newStructureScanner creates a structureScanner for b.
+func newStructureScanner(b []byte) *structureScanner {
+	stop := make([]int, len(b)+1)
+	stop[len(b)] = len(b)
+	for i := len(b) - 1; i >= 0; i-- {
+		c := b[i]
+		switch {
+		case c == 0 && i+1 < len(b) && b[i+1] == 0, c != 0 && (c < 0x20 || c == 0x7f):
+			stop[i] = i
+		default:
+			stop[i] = stop[i+1]
+		}
+	}
+
+	return &structureScanner{b: b, stop: stop}
+}
+
+// plausible reports whether the scanner's buffer contains a plausible
+// Structure at offset off, and if so, the length of the Structure including
+// its strings.
+func (sc *structureScanner) plausible(off int) (int, bool) {
+	b := sc.b[off:]
+	if len(b) < headerLen {
+		return 0, false
+	}
+
+	typ, l := b[0], int(b[1])
+	if typ >= typeReservedStart && typ <= typeReservedEnd {
+		return 0, false
+	}
+	if l < headerLen || binary.LittleEndian.Uint16(b[2:4]) == handleReserved {
+		return 0, false
+	}
+
+	// The string set must follow the formatted area within b.  A Structure
+	// with no strings is terminated by two null bytes.
+	if len(b) < l+len(endStringSet) {
+		return 0, false
+	}
+	if bytes.Equal(b[l:l+len(endStringSet)], endStringSet) {
+		return l + len(endStringSet), true
+	}
+
+	// Otherwise, each string must be non-empty and printable, and the set is
+	// terminated by an empty string.
+	if b[l] == 0 {
+		return 0, false
+	}
+
+	end := sc.stop[off+l] - off
+	if end >= len(b) || b[end] != 0 {
+		return 0, false
+	}
+
+	return end + len(endStringSet), true
+}
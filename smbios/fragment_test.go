@@ -0,0 +1,136 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDecodeFragment(t *testing.T) {
+	tests := []struct {
+		name string
+		b    []byte
+		ss   []*smbios.Structure
+		ok   bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "no plausible structures",
+			b: []byte{
+				// Reserved type, invalid length, reserved handle, and
+				// unterminated strings.
+				0x50, 0x04, 0x00, 0x00,
+				0x01, 0x02, 0x00, 0x00,
+				0x01, 0x04, 0xff, 0xff,
+				0x01, 0x05, 0x00, 0x00, 0x01, 'a', 'b',
+			},
+		},
+		{
+			// Every offset appears to begin a Structure whose strings
+			// continue to the end of the data, which must not be
+			// scanned again at each offset.
+			name: "unterminated strings",
+			b:    bytes.Repeat([]byte{'!'}, 1<<20),
+		},
+		{
+			name: "truncated start and end",
+			b: []byte{
+				// Tail of a previous structure's strings.
+				'n', 'd', 'o', 'r', 0x00, 0x00,
+
+				0x01, 0x05, 0x01, 0x00,
+				0x01,
+				's', 'e', 'r', 'i', 'a', 'l', 0x00,
+				0x00,
+
+				// Truncated structure.
+				0x04, 0x1a, 0x02, 0x00,
+				0x01, 0x02,
+			},
+			ss: []*smbios.Structure{{
+				Header: smbios.Header{
+					Type:   1,
+					Length: 5,
+					Handle: 1,
+				},
+				Formatted:  []byte{0x01},
+				Strings:    []string{"serial"},
+				Provenance: &smbios.Provenance{Offset: 6},
+			}},
+			ok: true,
+		},
+		{
+			name: "resynchronize",
+			b: []byte{
+				0x00, 0x05, 0x00, 0x00,
+				0x00,
+				0x00, 0x00,
+
+				// Overwritten structure.
+				0x02, 0x06, 0xff,
+				0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+
+				127, 0x04, 0x02, 0x00,
+				0x00, 0x00,
+
+				// Trailing data after End-of-table.
+				0x01, 0x04, 0x03, 0x00,
+				0x00, 0x00,
+			},
+			ss: []*smbios.Structure{
+				{
+					Header: smbios.Header{
+						Type:   0,
+						Length: 5,
+					},
+					Formatted:  []byte{0x00},
+					Provenance: &smbios.Provenance{},
+				},
+				{
+					Header: smbios.Header{
+						Type:   127,
+						Length: 4,
+						Handle: 2,
+					},
+					Provenance: &smbios.Provenance{Offset: 16},
+				},
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ss, err := smbios.DecodeFragment(tt.b)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.ss, ss); diff != "" {
+				t.Fatalf("unexpected structures (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -20,7 +20,16 @@ import (
 	"bytes"
 )
 
+// Fuzz is the go-fuzz entry point.  Minimized inputs which found bugs are kept
+// in testdata/corpus and replayed by the tests.
 func Fuzz(data []byte) int {
+	// DecodeFragment must handle arbitrary input, but its result does not
+	// affect the fuzzer's priority for data.
+	_, _ = DecodeFragment(data)
+
+	// Likewise for resynchronization after invalid lengths.
+	_, _ = NewDecoder(bytes.NewReader(data), WithResynchronization()).Decode()
+
 	return fuzzDecoder(data)
 }
 
@@ -0,0 +1,229 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package guest
+
+import (
+	"strings"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+)
+
+// A Provider is a cloud provider which hosts a virtual machine.
+type Provider string
+
+// Possible Provider values.
+const (
+	ProviderAWS          Provider = "aws"
+	ProviderAzure        Provider = "azure"
+	ProviderDigitalOcean Provider = "digitalocean"
+	ProviderGCP          Provider = "gcp"
+	ProviderOpenStack    Provider = "openstack"
+)
+
+// azureAssetTag is the chassis asset tag which identifies Azure virtual
+// machines.
+const azureAssetTag = "7783-7084-3265-9085-8269-3286-77"
+
+// CloudMetadata is normalized metadata which identifies a cloud virtual
+// machine.  Fields which a Provider does not expose through SMBIOS are left
+// empty.
+type CloudMetadata struct {
+	// Provider is the cloud provider which hosts the virtual machine.
+	Provider Provider
+
+	// InstanceID is the provider's identifier for the virtual machine, such
+	// as a DigitalOcean droplet ID or an EC2 instance ID.
+	InstanceID string
+
+	// InstanceType is the provider's name for the size or type of the
+	// virtual machine, such as an EC2 instance type.
+	InstanceType string
+
+	// ProjectID is the provider's identifier for the project or account
+	// which owns the virtual machine.
+	ProjectID string
+}
+
+// OEM string keys which identify a virtual machine.  Providers which do not
+// store identifiers in other structures may inject OEM strings of the form
+// "key=value" using these keys.
+const (
+	oemProvider     = "provider"
+	oemInstanceID   = "instance-id"
+	oemInstanceType = "instance-type"
+	oemProjectID    = "project-id"
+)
+
+// Cloud detects the cloud provider which hosts a virtual machine from its
+// SMBIOS structures, and extracts its CloudMetadata.
+//
+// Identifiers are read from the System Information, Baseboard, and Chassis
+// structures, and from "key=value" OEM strings with the keys "provider",
+// "instance-id", "instance-type", and "project-id".  OEM strings are used
+// only for identifiers which no other structure provides.
+//
+// If no known cloud provider is detected, Cloud returns nil and no error.
+func Cloud(ss []*smbios.Structure) (*CloudMetadata, error) {
+	var (
+		si  *structures.SystemInformation
+		bb  *structures.Baseboard
+		ch  *structures.Chassis
+		oem []string
+		err error
+	)
+
+	// Only the first structure of each type identifies the system.
+	for _, s := range ss {
+		switch {
+		case s.Header.Type == structures.TypeSystemInformation && si == nil:
+			si, err = structures.ParseSystemInformation(s)
+		case s.Header.Type == structures.TypeBaseboard && bb == nil:
+			bb, err = structures.ParseBaseboard(s)
+		case s.Header.Type == structures.TypeChassis && ch == nil:
+			ch, err = structures.ParseChassis(s)
+		case s.Header.Type == structures.TypeOEMStrings:
+			// All OEM strings structures are considered.
+			var o *structures.OEMStrings
+			if o, err = structures.ParseOEMStrings(s); err == nil {
+				oem = append(oem, o.Strings...)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Simplify detection by using empty structures in place of missing ones.
+	if si == nil {
+		si = &structures.SystemInformation{}
+	}
+	if bb == nil {
+		bb = &structures.Baseboard{}
+	}
+	if ch == nil {
+		ch = &structures.Chassis{}
+	}
+
+	return detect(si, bb, ch, oem), nil
+}
+
+// detect detects a cloud provider and its CloudMetadata from the structures
+// and OEM strings which identify a system.
+func detect(si *structures.SystemInformation, bb *structures.Baseboard, ch *structures.Chassis, oem []string) *CloudMetadata {
+	kv := oemValues(oem)
+
+	cm := detectProvider(si, bb, ch, kv)
+	if cm == nil {
+		return nil
+	}
+
+	// Fill in identifiers which only OEM strings provide.
+	for _, f := range []struct {
+		v   *string
+		key string
+	}{
+		{v: &cm.InstanceID, key: oemInstanceID},
+		{v: &cm.InstanceType, key: oemInstanceType},
+		{v: &cm.ProjectID, key: oemProjectID},
+	} {
+		if *f.v == "" {
+			*f.v = kv[f.key]
+		}
+	}
+
+	return cm
+}
+
+// detectProvider detects a cloud provider and the CloudMetadata which its
+// SMBIOS conventions provide.
+func detectProvider(si *structures.SystemInformation, bb *structures.Baseboard, ch *structures.Chassis, kv map[string]string) *CloudMetadata {
+	switch {
+	case si.Manufacturer == "DigitalOcean" || si.Family == "DigitalOcean_Droplet":
+		// The serial number is the droplet ID.
+		return &CloudMetadata{
+			Provider:   ProviderDigitalOcean,
+			InstanceID: si.SerialNumber,
+		}
+	case si.Manufacturer == "Amazon EC2":
+		// Nitro instances store the instance ID in the baseboard asset tag
+		// and the instance type in the product name.
+		cm := &CloudMetadata{
+			Provider:     ProviderAWS,
+			InstanceType: si.ProductName,
+		}
+
+		if strings.HasPrefix(bb.AssetTag, "i-") {
+			cm.InstanceID = bb.AssetTag
+		}
+
+		return cm
+	case strings.HasPrefix(strings.ToLower(si.SerialNumber), "ec2"):
+		// Xen instances expose no identifiers, but are marked by an "ec2"
+		// serial number prefix.
+		return &CloudMetadata{Provider: ProviderAWS}
+	case si.Manufacturer == "Google" && si.ProductName == "Google Compute Engine":
+		return &CloudMetadata{Provider: ProviderGCP}
+	case ch.AssetTag == azureAssetTag:
+		// The system UUID is the VM ID.
+		return &CloudMetadata{
+			Provider:   ProviderAzure,
+			InstanceID: uuid(si.UUID),
+		}
+	case si.Manufacturer == "OpenStack Foundation" || si.ProductName == "OpenStack Nova":
+		// The system UUID is the instance ID.
+		return &CloudMetadata{
+			Provider:   ProviderOpenStack,
+			InstanceID: uuid(si.UUID),
+		}
+	}
+
+	// As a last resort, trust a provider named by OEM strings.
+	switch p := Provider(strings.ToLower(kv[oemProvider])); p {
+	case ProviderAWS, ProviderAzure, ProviderDigitalOcean, ProviderGCP, ProviderOpenStack:
+		return &CloudMetadata{Provider: p}
+	default:
+		return nil
+	}
+}
+
+// oemValues parses OEM strings of the form "key=value" into a map of
+// lowercase keys to values.  Other strings are ignored, and the first value
+// for a key is used.
+func oemValues(oem []string) map[string]string {
+	kv := make(map[string]string)
+	for _, s := range oem {
+		i := strings.Index(s, "=")
+		if i < 1 {
+			continue
+		}
+
+		k := strings.ToLower(strings.TrimSpace(s[:i]))
+		if _, ok := kv[k]; !ok {
+			kv[k] = strings.TrimSpace(s[i+1:])
+		}
+	}
+
+	return kv
+}
+
+// uuid returns the string form of u, or empty if u is not present.
+func uuid(u structures.UUID) string {
+	if !u.Present() {
+		return ""
+	}
+
+	return u.String()
+}
@@ -0,0 +1,248 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package guest
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCloud(t *testing.T) {
+	uuid := structures.UUID{
+		0x33, 0x22, 0x11, 0x00, 0x55, 0x44, 0x77, 0x66,
+		0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff,
+	}
+
+	tests := []struct {
+		name string
+		si   structures.SystemInformation
+		bb   structures.Baseboard
+		ch   structures.Chassis
+		oem  []string
+		cm   *CloudMetadata
+	}{
+		{
+			name: "unknown",
+			si: structures.SystemInformation{
+				Manufacturer: "Supermicro",
+				SerialNumber: "0123456789",
+			},
+		},
+		{
+			name: "DigitalOcean",
+			si: structures.SystemInformation{
+				Manufacturer: "DigitalOcean",
+				ProductName:  "Droplet",
+				SerialNumber: "123456789",
+				Family:       "DigitalOcean_Droplet",
+			},
+			cm: &CloudMetadata{
+				Provider:   ProviderDigitalOcean,
+				InstanceID: "123456789",
+			},
+		},
+		{
+			name: "AWS Nitro",
+			si: structures.SystemInformation{
+				Manufacturer: "Amazon EC2",
+				ProductName:  "m5.large",
+				SerialNumber: "ec2e1916-9099-7caf-fd21-012345abcdef",
+			},
+			bb: structures.Baseboard{
+				Manufacturer: "Amazon EC2",
+				AssetTag:     "i-0123456789abcdef0",
+			},
+			cm: &CloudMetadata{
+				Provider:     ProviderAWS,
+				InstanceID:   "i-0123456789abcdef0",
+				InstanceType: "m5.large",
+			},
+		},
+		{
+			name: "AWS Xen",
+			si: structures.SystemInformation{
+				Manufacturer: "Xen",
+				ProductName:  "HVM domU",
+				SerialNumber: "ec2e1916-9099-7caf-fd21-012345abcdef",
+			},
+			cm: &CloudMetadata{Provider: ProviderAWS},
+		},
+		{
+			name: "GCP",
+			si: structures.SystemInformation{
+				Manufacturer: "Google",
+				ProductName:  "Google Compute Engine",
+				SerialNumber: "GoogleCloud-0123456789ABCDEF",
+			},
+			cm: &CloudMetadata{Provider: ProviderGCP},
+		},
+		{
+			name: "Azure",
+			si: structures.SystemInformation{
+				Manufacturer: "Microsoft Corporation",
+				ProductName:  "Virtual Machine",
+				UUID:         uuid,
+			},
+			ch: structures.Chassis{
+				AssetTag: azureAssetTag,
+			},
+			cm: &CloudMetadata{
+				Provider:   ProviderAzure,
+				InstanceID: "00112233-4455-6677-8899-aabbccddeeff",
+			},
+		},
+		{
+			name: "OpenStack",
+			si: structures.SystemInformation{
+				Manufacturer: "OpenStack Foundation",
+				ProductName:  "OpenStack Nova",
+				UUID:         uuid,
+			},
+			cm: &CloudMetadata{
+				Provider:   ProviderOpenStack,
+				InstanceID: "00112233-4455-6677-8899-aabbccddeeff",
+			},
+		},
+		{
+			name: "OpenStack, no UUID",
+			si: structures.SystemInformation{
+				ProductName: "OpenStack Nova",
+			},
+			cm: &CloudMetadata{Provider: ProviderOpenStack},
+		},
+		{
+			name: "DigitalOcean, OEM strings",
+			si: structures.SystemInformation{
+				Manufacturer: "DigitalOcean",
+				ProductName:  "Droplet",
+			},
+			oem: []string{"instance-id=123456789", "project-id=0f1e2d3c"},
+			cm: &CloudMetadata{
+				Provider:   ProviderDigitalOcean,
+				InstanceID: "123456789",
+				ProjectID:  "0f1e2d3c",
+			},
+		},
+		{
+			name: "DigitalOcean, serial number preferred",
+			si: structures.SystemInformation{
+				Manufacturer: "DigitalOcean",
+				SerialNumber: "123456789",
+			},
+			oem: []string{"instance-id=987654321"},
+			cm: &CloudMetadata{
+				Provider:   ProviderDigitalOcean,
+				InstanceID: "123456789",
+			},
+		},
+		{
+			name: "GCP, OEM strings",
+			si: structures.SystemInformation{
+				Manufacturer: "Google",
+				ProductName:  "Google Compute Engine",
+			},
+			oem: []string{
+				"Google Compute Engine",
+				" Instance-ID = 1234567890123456789 ",
+				"instance-type=e2-medium",
+				"project-id=example-project",
+			},
+			cm: &CloudMetadata{
+				Provider:     ProviderGCP,
+				InstanceID:   "1234567890123456789",
+				InstanceType: "e2-medium",
+				ProjectID:    "example-project",
+			},
+		},
+		{
+			name: "OEM strings only",
+			si: structures.SystemInformation{
+				Manufacturer: "QEMU",
+				ProductName:  "Standard PC (Q35 + ICH9, 2009)",
+			},
+			oem: []string{"provider=OpenStack", "instance-id=i-0001", "project-id=p-0002"},
+			cm: &CloudMetadata{
+				Provider:   ProviderOpenStack,
+				InstanceID: "i-0001",
+				ProjectID:  "p-0002",
+			},
+		},
+		{
+			name: "OEM strings, unknown provider",
+			oem:  []string{"provider=example", "instance-id=1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.cm, detect(&tt.si, &tt.bb, &tt.ch, tt.oem)); diff != "" {
+				t.Fatalf("unexpected metadata (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCloudStructures(t *testing.T) {
+	ss := []*smbios.Structure{
+		{
+			Header: smbios.Header{
+				Type:   structures.TypeSystemInformation,
+				Length: 0x08,
+			},
+			Formatted: []byte{0x01, 0x02, 0x00, 0x03},
+			Strings:   []string{"DigitalOcean", "Droplet", "123456789"},
+		},
+		{
+			Header: smbios.Header{
+				Type:   structures.TypeSystemInformation,
+				Length: 0x08,
+			},
+			Formatted: []byte{0x01, 0x00, 0x00, 0x00},
+			Strings:   []string{"ignored"},
+		},
+		{
+			Header: smbios.Header{
+				Type:   structures.TypeOEMStrings,
+				Length: 0x05,
+			},
+			Formatted: []byte{0x02},
+			Strings:   []string{"Droplet", "project-id=0f1e2d3c"},
+		},
+	}
+
+	cm, err := Cloud(ss)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := &CloudMetadata{
+		Provider:   ProviderDigitalOcean,
+		InstanceID: "123456789",
+		ProjectID:  "0f1e2d3c",
+	}
+
+	if diff := cmp.Diff(want, cm); diff != "" {
+		t.Fatalf("unexpected metadata (-want +got):\n%s", diff)
+	}
+
+	// A malformed structure should produce an error.
+	ss[0].Formatted = nil
+	if _, err := Cloud(ss); err == nil {
+		t.Fatal("expected an error, but none occurred")
+	}
+}
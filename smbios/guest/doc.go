@@ -0,0 +1,22 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package guest extracts metadata which hypervisors and cloud providers inject
+// into the SMBIOS structures of virtual machine guests.
+//
+// Cloud providers identify their virtual machines using a variety of
+// conventions, such as storing an instance ID in the system serial number,
+// baseboard asset tag, or OEM strings.  This package normalizes these
+// conventions so agents need not implement per-cloud parsing.
+package guest
@@ -0,0 +1,68 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package humanize formats the quantities reported by SMBIOS for display, so
+// that tools built on go-smbios produce consistent, locale-independent output.
+//
+// Sizes are formatted using IEC binary units (KiB, MiB, GiB, ...), memory
+// speeds in megatransfers per second (MT/s), frequencies in megahertz (MHz),
+// and voltages in volts (V).  Numbers never contain digit grouping separators
+// and always use a period as the decimal separator, regardless of the system
+// locale.
+package humanize
+
+import (
+	"strconv"
+	"strings"
+)
+
+// iecUnits are the IEC binary unit suffixes, in increasing order of size.
+var iecUnits = []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+
+// Bytes formats a size in bytes using the largest IEC binary unit in which
+// the size is at least 1, such as "16 GiB" or "1.5 MiB".  Sizes which are not
+// a whole number of units are rounded to one decimal place, omitting a
+// trailing zero.
+func Bytes(n uint64) string {
+	i := 0
+	for i < len(iecUnits)-1 && n>>(10*uint(i+1)) > 0 {
+		i++
+	}
+
+	unit := uint64(1) << (10 * uint(i))
+	if n%unit == 0 {
+		return strconv.FormatUint(n/unit, 10) + " " + iecUnits[i]
+	}
+
+	v := strconv.FormatFloat(float64(n)/float64(unit), 'f', 1, 64)
+	return strings.TrimSuffix(v, ".0") + " " + iecUnits[i]
+}
+
+// TransferRate formats a memory speed in megatransfers per second, such as
+// "3200 MT/s".  TransferRate accepts 32-bit speeds so that SMBIOS 3.3+
+// extended speeds can be formatted.
+func TransferRate(mts uint32) string {
+	return strconv.FormatUint(uint64(mts), 10) + " MT/s"
+}
+
+// Frequency formats a frequency in megahertz, such as "2400 MHz".
+func Frequency(mhz uint32) string {
+	return strconv.FormatUint(uint64(mhz), 10) + " MHz"
+}
+
+// Voltage formats a voltage given in millivolts as volts, such as "1.2 V" or
+// "1.35 V".
+func Voltage(mv int) string {
+	return strconv.FormatFloat(float64(mv)/1000, 'f', -1, 64) + " V"
+}
@@ -0,0 +1,65 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package humanize_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios/humanize"
+)
+
+func TestBytes(t *testing.T) {
+	tests := []struct {
+		n uint64
+		s string
+	}{
+		{n: 0, s: "0 B"},
+		{n: 1023, s: "1023 B"},
+		{n: 1 << 10, s: "1 KiB"},
+		{n: 1536 << 10, s: "1.5 MiB"},
+		{n: 16 << 30, s: "16 GiB"},
+		{n: 16<<30 + 1, s: "16 GiB"},
+		{n: 2 << 40, s: "2 TiB"},
+		{n: 1 << 63, s: "8 EiB"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if got := humanize.Bytes(tt.n); tt.s != got {
+				t.Fatalf("unexpected size: want %q, got %q", tt.s, got)
+			}
+		})
+	}
+}
+
+func TestTransferRate(t *testing.T) {
+	if want, got := "4800 MT/s", humanize.TransferRate(4800); want != got {
+		t.Fatalf("unexpected transfer rate: want %q, got %q", want, got)
+	}
+}
+
+func TestFrequency(t *testing.T) {
+	if want, got := "2400 MHz", humanize.Frequency(2400); want != got {
+		t.Fatalf("unexpected frequency: want %q, got %q", want, got)
+	}
+}
+
+func TestVoltage(t *testing.T) {
+	for mv, want := range map[int]string{1200: "1.2 V", 1350: "1.35 V", 5000: "5 V"} {
+		if got := humanize.Voltage(mv); want != got {
+			t.Fatalf("unexpected voltage: want %q, got %q", want, got)
+		}
+	}
+}
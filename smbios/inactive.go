@@ -0,0 +1,61 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"fmt"
+)
+
+// TypeInactive is the structure type of an Inactive (type 126) structure.
+// Firmware deactivates a structure by changing its type to TypeInactive,
+// leaving the rest of its content intact.
+const TypeInactive = 126
+
+// Inactive reports whether s is an Inactive (type 126) structure.
+func (s *Structure) Inactive() bool {
+	return s.Header.Type == TypeInactive
+}
+
+// Reactivate returns a copy of the Inactive (type 126) structure s with its
+// type changed to typ, so that the original content of a deactivated
+// structure can be decoded by a parser for its assumed original type.
+//
+// The original type of an Inactive structure is not recorded by firmware, so
+// callers must determine typ by other means, such as by comparing the
+// structure against a table from a system with the same firmware.  Decoding
+// an Inactive structure is useful when debugging firmware, but its content
+// does not describe the current system.
+func (s *Structure) Reactivate(typ uint8) (*Structure, error) {
+	if !s.Inactive() {
+		return nil, fmt.Errorf("cannot reactivate SMBIOS structure of type %d, expected inactive type %d", s.Header.Type, TypeInactive)
+	}
+	if typ == TypeInactive || typ == typeEndOfTable {
+		return nil, fmt.Errorf("cannot reactivate SMBIOS structure as type %d", typ)
+	}
+
+	out := &Structure{
+		Header:    s.Header,
+		Formatted: append([]byte(nil), s.Formatted...),
+		Strings:   append([]string(nil), s.Strings...),
+	}
+	out.Header.Type = typ
+
+	if s.Provenance != nil {
+		p := *s.Provenance
+		out.Provenance = &p
+	}
+
+	return out, nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestStructureReactivate(t *testing.T) {
+	inactive := &smbios.Structure{
+		Header: smbios.Header{
+			Type:   smbios.TypeInactive,
+			Length: 0x08,
+			Handle: 0x0100,
+		},
+		Formatted: []byte{0x01, 0x02, 0x00, 0x00},
+		Strings:   []string{"DigitalOcean", "Droplet"},
+	}
+
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		typ  uint8
+		want *smbios.Structure
+		ok   bool
+	}{
+		{
+			name: "not inactive",
+			s:    &smbios.Structure{Header: smbios.Header{Type: 1}},
+			typ:  1,
+		},
+		{
+			name: "inactive type",
+			s:    inactive,
+			typ:  smbios.TypeInactive,
+		},
+		{
+			name: "end of table type",
+			s:    inactive,
+			typ:  127,
+		},
+		{
+			name: "OK",
+			s:    inactive,
+			typ:  1,
+			want: &smbios.Structure{
+				Header: smbios.Header{
+					Type:   1,
+					Length: 0x08,
+					Handle: 0x0100,
+				},
+				Formatted: []byte{0x01, 0x02, 0x00, 0x00},
+				Strings:   []string{"DigitalOcean", "Droplet"},
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := tt.s.Reactivate(tt.typ)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.want, s); diff != "" {
+				t.Fatalf("unexpected structure (-want +got):\n%s", diff)
+			}
+			if !tt.ok {
+				return
+			}
+
+			// The original structure must not be modified by changes to
+			// the copy.
+			s.Formatted[0] = 0xff
+			if !tt.s.Inactive() || tt.s.Formatted[0] != 0x01 {
+				t.Fatalf("original structure was modified: %+v", tt.s)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package manufacturer normalizes the manufacturer strings found in SMBIOS
+// structures.
+//
+// Firmware from a single vendor commonly reports its name using several
+// variants, such as "Dell Inc." and "Dell Computer Corporation", which makes
+// aggregating inventory across a fleet difficult.  This package maps known
+// variants to canonical vendor identifiers using an extensible table.
+package manufacturer
@@ -0,0 +1,148 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manufacturer
+
+import (
+	"strings"
+	"unicode"
+)
+
+// A Vendor is a canonical identifier for a hardware vendor.
+type Vendor string
+
+// Vendors known to the default Normalizer.
+const (
+	AMD        Vendor = "amd"
+	Cisco      Vendor = "cisco"
+	Dell       Vendor = "dell"
+	Google     Vendor = "google"
+	HPE        Vendor = "hpe"
+	IBM        Vendor = "ibm"
+	Intel      Vendor = "intel"
+	Lenovo     Vendor = "lenovo"
+	Micron     Vendor = "micron"
+	Microsoft  Vendor = "microsoft"
+	QEMU       Vendor = "qemu"
+	Quanta     Vendor = "quanta"
+	Samsung    Vendor = "samsung"
+	SKHynix    Vendor = "skhynix"
+	Supermicro Vendor = "supermicro"
+	VMware     Vendor = "vmware"
+)
+
+// defaultVariants are the manufacturer string variants known for each Vendor.
+// Case, punctuation, trademark symbols, and legal suffixes such as "Inc." are
+// ignored when matching, so they need not be listed.
+var defaultVariants = map[Vendor][]string{
+	AMD:        {"AMD", "Advanced Micro Devices", "AuthenticAMD"},
+	Cisco:      {"Cisco", "Cisco Systems"},
+	Dell:       {"Dell", "Dell Computer", "Dell EMC"},
+	Google:     {"Google"},
+	HPE:        {"HPE", "HP", "Hewlett-Packard", "Hewlett Packard Enterprise"},
+	IBM:        {"IBM", "International Business Machines"},
+	Intel:      {"Intel", "GenuineIntel"},
+	Lenovo:     {"Lenovo"},
+	Micron:     {"Micron", "Micron Technology"},
+	Microsoft:  {"Microsoft"},
+	QEMU:       {"QEMU"},
+	Quanta:     {"Quanta", "Quanta Computer", "Quanta Cloud Technology", "QCT"},
+	Samsung:    {"Samsung", "Samsung Electronics"},
+	SKHynix:    {"SK Hynix", "Hynix", "Hynix Semiconductor"},
+	Supermicro: {"Supermicro", "Super Micro", "Super Micro Computer", "SMCI"},
+	VMware:     {"VMware"},
+}
+
+// legalSuffixes are words which are ignored at the end of a manufacturer
+// string.
+var legalSuffixes = map[string]bool{
+	"ag":           true,
+	"co":           true,
+	"company":      true,
+	"corp":         true,
+	"corporation":  true,
+	"gmbh":         true,
+	"inc":          true,
+	"incorporated": true,
+	"limited":      true,
+	"llc":          true,
+	"lp":           true,
+	"ltd":          true,
+}
+
+// A Normalizer maps manufacturer string variants to canonical Vendors.
+type Normalizer struct {
+	m map[string]Vendor
+}
+
+// NewNormalizer creates a Normalizer populated with the variants of all
+// Vendors declared by this package.
+func NewNormalizer() *Normalizer {
+	n := &Normalizer{m: make(map[string]Vendor)}
+	for v, variants := range defaultVariants {
+		n.Add(v, variants...)
+	}
+
+	return n
+}
+
+// Add maps the specified manufacturer string variants to v, replacing any
+// existing mapping for the same variants.  Add can be used to teach a
+// Normalizer about vendors not known to this package.
+func (n *Normalizer) Add(v Vendor, variants ...string) {
+	for _, s := range variants {
+		n.m[key(s)] = v
+	}
+}
+
+// Normalize returns the canonical Vendor for manufacturer string s.  If s is
+// not a known variant, Normalize returns false.
+func (n *Normalizer) Normalize(s string) (Vendor, bool) {
+	k := key(s)
+	if k == "" {
+		return "", false
+	}
+
+	v, ok := n.m[k]
+	return v, ok
+}
+
+// defaultNormalizer is used by Normalize.
+var defaultNormalizer = NewNormalizer()
+
+// Normalize returns the canonical Vendor for manufacturer string s using the
+// default mapping table.  If s is not a known variant, Normalize returns
+// false.
+func Normalize(s string) (Vendor, bool) {
+	return defaultNormalizer.Normalize(s)
+}
+
+// key produces the lookup key for manufacturer string s.
+func key(s string) string {
+	s = strings.ToLower(s)
+	for _, tm := range []string{"(r)", "(tm)", "®", "™"} {
+		s = strings.Replace(s, tm, "", -1)
+	}
+
+	// Treat all punctuation as word separators.
+	words := strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	for len(words) > 1 && legalSuffixes[words[len(words)-1]] {
+		words = words[:len(words)-1]
+	}
+
+	return strings.Join(words, " ")
+}
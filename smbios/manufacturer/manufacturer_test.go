@@ -0,0 +1,78 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manufacturer_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios/manufacturer"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		s  string
+		v  manufacturer.Vendor
+		ok bool
+	}{
+		{s: ""},
+		{s: "To Be Filled By O.E.M."},
+		{s: "Dell Inc.", v: manufacturer.Dell, ok: true},
+		{s: "Dell Computer Corporation", v: manufacturer.Dell, ok: true},
+		{s: "DELL", v: manufacturer.Dell, ok: true},
+		{s: "HPE", v: manufacturer.HPE, ok: true},
+		{s: "Hewlett-Packard", v: manufacturer.HPE, ok: true},
+		{s: "Supermicro", v: manufacturer.Supermicro, ok: true},
+		{s: "Super Micro Computer, Inc.", v: manufacturer.Supermicro, ok: true},
+		{s: "Intel(R) Corporation", v: manufacturer.Intel, ok: true},
+		{s: "  Samsung Electronics Co., Ltd. ", v: manufacturer.Samsung, ok: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			v, ok := manufacturer.Normalize(tt.s)
+			if tt.ok != ok {
+				t.Fatalf("unexpected ok: %v", ok)
+			}
+
+			if tt.v != v {
+				t.Fatalf("unexpected vendor: want %q, got %q", tt.v, v)
+			}
+		})
+	}
+}
+
+func TestNormalizerAdd(t *testing.T) {
+	const acme manufacturer.Vendor = "acme"
+
+	n := manufacturer.NewNormalizer()
+	n.Add(acme, "ACME", "Acme Widgets")
+	n.Add(manufacturer.Dell, "Acme Widgets")
+
+	for s, want := range map[string]manufacturer.Vendor{
+		"ACME Inc.":    acme,
+		"Acme Widgets": manufacturer.Dell,
+		"Dell Inc.":    manufacturer.Dell,
+	} {
+		v, ok := n.Normalize(s)
+		if !ok || v != want {
+			t.Fatalf("unexpected vendor for %q: want %q, got %q", s, want, v)
+		}
+	}
+
+	// The default Normalizer is not modified.
+	if _, ok := manufacturer.Normalize("ACME"); ok {
+		t.Fatal("default normalizer should not know vendor added to another normalizer")
+	}
+}
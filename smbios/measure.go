@@ -0,0 +1,167 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+)
+
+// defaultMeasuredTypes are the Structure types measured when no types are
+// specified: BIOS, System, Baseboard, and Processor Information.
+var defaultMeasuredTypes = []uint8{0, 1, 2, 4}
+
+// A Measurement is a SHA-256 digest of the raw bytes of a Structure, for use
+// as evidence of a system's hardware identity in attestation pipelines.
+type Measurement struct {
+	Type   uint8
+	Handle uint16
+	Digest [sha256.Size]byte
+}
+
+// Measurements are a list of Measurement values in canonical order: sorted by
+// Structure type, then handle, then digest.
+type Measurements []Measurement
+
+// measurementsVersion is the version of the binary encoding of Measurements.
+const measurementsVersion = 1
+
+// MarshalBinary implements encoding.BinaryMarshaler.  The encoding is stable
+// and will not change without a change to its leading version byte, so it is
+// suitable for inclusion in TPM quotes or other attestation evidence.
+//
+// The encoding consists of a version byte (1), the number of Measurements as
+// a big endian uint32, and then for each Measurement its type byte, its
+// handle as a big endian uint16, and its 32 byte digest.
+func (ms Measurements) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 5, 5+len(ms)*(3+sha256.Size))
+	b[0] = measurementsVersion
+	binary.BigEndian.PutUint32(b[1:5], uint32(len(ms)))
+
+	for _, m := range ms {
+		var h [3]byte
+		h[0] = m.Type
+		binary.BigEndian.PutUint16(h[1:3], m.Handle)
+
+		b = append(b, h[:]...)
+		b = append(b, m.Digest[:]...)
+	}
+
+	return b, nil
+}
+
+// Digest returns the SHA-256 digest of the binary encoding of ms, which
+// summarizes all Measurements in a single value, such as for extending a TPM
+// PCR or binding to an attestation nonce.
+func (ms Measurements) Digest() [sha256.Size]byte {
+	// MarshalBinary cannot fail.
+	b, _ := ms.MarshalBinary()
+	return sha256.Sum256(b)
+}
+
+// Measure computes Measurements of each Structure in ss whose type is one of
+// types, in canonical order.  If no types are specified, BIOS (type 0), System
+// (type 1), Baseboard (type 2), and Processor (type 4) Information structures
+// are measured.
+//
+// The binary encoding of a decoded Structure is identical to its raw bytes in
+// the structure table, so Measurements can be compared against digests of the
+// table computed by other tools.
+func Measure(ss []*Structure, types ...uint8) Measurements {
+	m := newMeasurer(types)
+	for _, s := range ss {
+		m.measure(s)
+	}
+
+	return m.measurements()
+}
+
+// WithMeasurement enables a trusted decode mode in which the Decoder measures
+// each Structure whose type is one of types as it is decoded.  Measurements
+// are retrieved using Decoder.Measurements.  See Measure for details.
+//
+// Measurements are computed after redaction, so WithMeasurement should not be
+// combined with WithRedaction for measured types.
+func WithMeasurement(types ...uint8) DecoderOption {
+	return func(d *Decoder) {
+		d.measurer = newMeasurer(types)
+	}
+}
+
+// Measurements returns the Measurements recorded by the Decoder when
+// configured using WithMeasurement, in canonical order.
+func (d *Decoder) Measurements() Measurements {
+	if d.measurer == nil {
+		return nil
+	}
+
+	return d.measurer.measurements()
+}
+
+// A measurer accumulates Measurements.
+type measurer struct {
+	types map[uint8]bool
+	ms    Measurements
+	b     []byte
+}
+
+// newMeasurer creates a measurer for the specified types.
+func newMeasurer(types []uint8) *measurer {
+	if len(types) == 0 {
+		types = defaultMeasuredTypes
+	}
+
+	m := &measurer{types: make(map[uint8]bool, len(types))}
+	for _, t := range types {
+		m.types[t] = true
+	}
+
+	return m
+}
+
+// measure measures s if its type is measured.
+func (m *measurer) measure(s *Structure) {
+	if !m.types[s.Header.Type] {
+		return
+	}
+
+	m.b = appendStructure(m.b[:0], s)
+	m.ms = append(m.ms, Measurement{
+		Type:   s.Header.Type,
+		Handle: s.Header.Handle,
+		Digest: sha256.Sum256(m.b),
+	})
+}
+
+// measurements returns a sorted copy of the accumulated Measurements.
+func (m *measurer) measurements() Measurements {
+	ms := make(Measurements, len(m.ms))
+	copy(ms, m.ms)
+
+	sort.Slice(ms, func(i, j int) bool {
+		if ms[i].Type != ms[j].Type {
+			return ms[i].Type < ms[j].Type
+		}
+		if ms[i].Handle != ms[j].Handle {
+			return ms[i].Handle < ms[j].Handle
+		}
+
+		return bytes.Compare(ms[i].Digest[:], ms[j].Digest[:]) < 0
+	})
+
+	return ms
+}
@@ -0,0 +1,135 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWithMeasurement(t *testing.T) {
+	var (
+		bios = []byte{
+			0x00, 0x05, 0x02, 0x00,
+			0x01,
+			'v', 'e', 'n', 'd', 'o', 'r', 0x00,
+			0x00,
+		}
+		system = []byte{
+			0x01, 0x05, 0x01, 0x00,
+			0x01,
+			's', 'e', 'r', 'i', 'a', 'l', 0x00,
+			0x00,
+		}
+		memory = []byte{
+			0x11, 0x05, 0x03, 0x00,
+			0xff,
+			0x00,
+			0x00,
+		}
+		eot = []byte{
+			127, 0x04, 0x04, 0x00,
+			0x00,
+			0x00,
+		}
+	)
+
+	var table []byte
+	for _, b := range [][]byte{memory, system, bios, eot} {
+		table = append(table, b...)
+	}
+
+	tests := []struct {
+		name  string
+		types []uint8
+		want  smbios.Measurements
+	}{
+		{
+			name: "default",
+			want: smbios.Measurements{
+				{Type: 0, Handle: 2, Digest: sha256.Sum256(bios)},
+				{Type: 1, Handle: 1, Digest: sha256.Sum256(system)},
+			},
+		},
+		{
+			name:  "memory",
+			types: []uint8{17},
+			want: smbios.Measurements{
+				{Type: 17, Handle: 3, Digest: sha256.Sum256(memory)},
+			},
+		},
+		{
+			name:  "none",
+			types: []uint8{4},
+			want:  smbios.Measurements{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := smbios.NewDecoder(bytes.NewReader(table), smbios.WithMeasurement(tt.types...))
+
+			ss, err := d.Decode()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.want, d.Measurements()); diff != "" {
+				t.Fatalf("unexpected measurements (-want +got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tt.want, smbios.Measure(ss, tt.types...)); diff != "" {
+				t.Fatalf("unexpected measurements from Measure (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMeasurementsMarshalBinary(t *testing.T) {
+	ms := smbios.Measurements{
+		{Type: 0, Handle: 0x0102, Digest: [sha256.Size]byte{0: 0xaa, 31: 0xbb}},
+	}
+
+	b, err := ms.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []byte{
+		0x01,
+		0x00, 0x00, 0x00, 0x01,
+		0x00, 0x01, 0x02,
+	}
+	want = append(want, ms[0].Digest[:]...)
+
+	if diff := cmp.Diff(want, b); diff != "" {
+		t.Fatalf("unexpected encoding (-want +got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff(sha256.Sum256(want), ms.Digest()); diff != "" {
+		t.Fatalf("unexpected digest (-want +got):\n%s", diff)
+	}
+}
+
+func TestDecoderMeasurementsDisabled(t *testing.T) {
+	d := smbios.NewDecoder(bytes.NewReader(nil))
+	if ms := d.Measurements(); ms != nil {
+		t.Fatalf("expected no measurements, but got: %v", ms)
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"time"
+
+	"github.com/digitalocean/go-smbios/internal/platform"
+)
+
+// A StreamOption configures the behavior of Stream.
+type StreamOption func(*streamConfig)
+
+// streamConfig is the configuration built from StreamOptions.
+type streamConfig struct {
+	tracer     Tracer
+	verify     bool
+	cache      string
+	preference EntryPointPreference
+	retry      *RetryPolicy
+	noDevMem   bool
+
+	// sources is only used if selectSources is set, so that WithSources
+	// with no arguments selects no sources.
+	sources       []SourceKind
+	selectSources bool
+
+	// platform and sleep are swapped out in tests.
+	platform platform.Platform
+	sleep    func(time.Duration)
+}
+
+// newStreamConfig applies options to a default streamConfig.
+func newStreamConfig(options []StreamOption) *streamConfig {
+	c := &streamConfig{
+		tracer:   nopTracer{},
+		noDevMem: devMemDisabled(),
+		platform: platform.Current(),
+		sleep:    time.Sleep,
+	}
+
+	for _, o := range options {
+		o(c)
+	}
+
+	return c
+}
+
+// WithTracer annotates Stream with Spans from the specified Tracer.
+func WithTracer(t Tracer) StreamOption {
+	return func(c *streamConfig) {
+		c.tracer = t
+	}
+}
+
+// A DecoderOption configures the behavior of a Decoder.
+type DecoderOption func(*Decoder)
+
+// WithTrimmedStrings configures a Decoder to remove trailing spaces from each
+// decoded string, as some firmware pads strings to a fixed width.
+//
+// By default, a Decoder preserves strings exactly as they appear in the
+// stream, so that hashes and measurements of decoded Structures match the
+// original data.  With WithTrimmedStrings, hashes and measurements are
+// computed over the trimmed strings.
+func WithTrimmedStrings() DecoderOption {
+	return func(d *Decoder) {
+		d.trim = true
+	}
+}
+
+// WithResynchronization configures a Decoder to skip a Structure whose
+// Header specifies an invalid length, rather than returning a *LengthError.
+// The Decoder resumes at the next plausible Structure in the stream, using
+// the same heuristics as DecodeFragment, and the invalid Structure is
+// dropped.  If no plausible Structure follows, the *LengthError is returned.
+func WithResynchronization() DecoderOption {
+	return func(d *Decoder) {
+		d.resync = true
+	}
+}
+
+// WithDecoderTracer annotates Decoder.Decode with Spans from the specified
+// Tracer.
+func WithDecoderTracer(t Tracer) DecoderOption {
+	return func(d *Decoder) {
+		d.tracer = t
+	}
+}
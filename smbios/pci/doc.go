@@ -0,0 +1,22 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pci correlates SMBIOS system slots with the live PCI topology of a
+// Linux system.
+//
+// SMBIOS System Slots (type 9) structures describe the expansion slots on a
+// system board and, since SMBIOS 2.6, the PCI bus address of each slot.  This
+// package resolves those addresses in sysfs to report which slots are
+// occupied and by which devices.
+package pci
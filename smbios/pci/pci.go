@@ -0,0 +1,149 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pci
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/digitalocean/go-smbios/smbios/structures"
+)
+
+// A Device is a PCI device present in sysfs.
+type Device struct {
+	// Address is the device's PCI address, such as "0000:3b:00.0".
+	Address string
+
+	VendorID uint16
+	DeviceID uint16
+	Class    uint32
+}
+
+// A Slot is a system slot which has been resolved against the live PCI
+// topology.
+type Slot struct {
+	SystemSlot *structures.SystemSlot
+
+	// Address is the PCI address of the slot, or empty if the slot has no
+	// bus address.
+	Address string
+
+	// Device is the device occupying the slot, or nil if the slot is empty
+	// or has no bus address.
+	Device *Device
+}
+
+// Occupied reports whether a device was found in the slot.
+func (s Slot) Occupied() bool {
+	return s.Device != nil
+}
+
+// pciBridge is the class code of PCI-to-PCI bridges, without the
+// programming interface byte.
+const pciBridge = 0x0604
+
+// resolveSlots resolves system slots against a sysfs PCI devices directory
+// such as /sys/bus/pci/devices, producing one Slot per system slot in the
+// order the system slots were specified.
+func resolveSlots(root string, slots []*structures.SystemSlot) ([]Slot, error) {
+	out := make([]Slot, 0, len(slots))
+	for _, ss := range slots {
+		s := Slot{SystemSlot: ss}
+
+		addr, ok := ss.PCIAddress()
+		if !ok {
+			out = append(out, s)
+			continue
+		}
+		s.Address = addr
+
+		d, err := resolve(root, addr)
+		if err != nil {
+			return nil, err
+		}
+		s.Device = d
+
+		out = append(out, s)
+	}
+
+	return out, nil
+}
+
+// resolve finds the device occupying the slot with the specified address.
+// Firmware commonly reports the address of the root port or bridge which
+// leads to a slot, so the first device below a bridge occupies the slot.
+func resolve(root, addr string) (*Device, error) {
+	dir := filepath.Join(root, addr)
+
+	d, err := readDevice(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// No device at this address.
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if d.Class>>8 != pciBridge {
+		return d, nil
+	}
+
+	// Devices below a bridge appear as subdirectories named by address.
+	children, err := filepath.Glob(filepath.Join(dir, "[0-9a-f]*:*:*.*"))
+	if err != nil {
+		return nil, err
+	}
+	if len(children) == 0 {
+		return nil, nil
+	}
+
+	sort.Strings(children)
+	return readDevice(children[0])
+}
+
+// readDevice reads a Device from its sysfs directory.
+func readDevice(dir string) (*Device, error) {
+	d := Device{Address: filepath.Base(dir)}
+
+	for _, f := range []struct {
+		name string
+		bits int
+		fn   func(v uint64)
+	}{
+		{name: "vendor", bits: 16, fn: func(v uint64) { d.VendorID = uint16(v) }},
+		{name: "device", bits: 16, fn: func(v uint64) { d.DeviceID = uint16(v) }},
+		{name: "class", bits: 32, fn: func(v uint64) { d.Class = uint32(v) }},
+	} {
+		b, err := ioutil.ReadFile(filepath.Join(dir, f.name))
+		if err != nil {
+			return nil, err
+		}
+
+		// sysfs reports values in hexadecimal with a 0x prefix.
+		v, err := strconv.ParseUint(strings.TrimSpace(string(b)), 0, f.bits)
+		if err != nil {
+			return nil, err
+		}
+
+		f.fn(v)
+	}
+
+	return &d, nil
+}
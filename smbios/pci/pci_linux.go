@@ -0,0 +1,32 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+// +build linux
+
+package pci
+
+import (
+	"github.com/digitalocean/go-smbios/smbios/structures"
+)
+
+// sysfsPCI is the sysfs location of PCI devices.
+const sysfsPCI = "/sys/bus/pci/devices"
+
+// Slots resolves system slots against the PCI devices present in sysfs,
+// producing one Slot per system slot in the order the system slots were
+// specified.
+func Slots(slots []*structures.SystemSlot) ([]Slot, error) {
+	return resolveSlots(sysfsPCI, slots)
+}
@@ -0,0 +1,130 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pci
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_resolveSlots(t *testing.T) {
+	root, err := ioutil.TempDir("", "go-smbios-pci")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	device := func(dir, vendor, device, class string) map[string]string {
+		return map[string]string{
+			dir + "/vendor": vendor + "\n",
+			dir + "/device": device + "\n",
+			dir + "/class":  class + "\n",
+		}
+	}
+
+	var files []map[string]string
+	files = append(files,
+		// Occupied bridge.
+		device("0000:3a:00.0", "0x8086", "0x2030", "0x060400"),
+		device("0000:3a:00.0/0000:3b:00.0", "0x15b3", "0x1017", "0x020000"),
+		// Empty bridge.
+		device("0000:5d:00.0", "0x8086", "0x2032", "0x060400"),
+		// Device reported directly.
+		device("0000:af:00.0", "0x144d", "0xa808", "0x010802"),
+	)
+
+	for _, fs := range files {
+		for f, s := range fs {
+			path := filepath.Join(root, f)
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				t.Fatalf("failed to create directory: %v", err)
+			}
+			if err := ioutil.WriteFile(path, []byte(s), 0644); err != nil {
+				t.Fatalf("failed to write file: %v", err)
+			}
+		}
+	}
+
+	slot := func(length uint8, bus, devfn uint8) *structures.SystemSlot {
+		return &structures.SystemSlot{
+			Header:               smbios.Header{Type: 9, Length: length},
+			BusNumber:            bus,
+			DeviceFunctionNumber: devfn,
+		}
+	}
+
+	slots := []*structures.SystemSlot{
+		slot(0x11, 0x3a, 0x00),
+		slot(0x11, 0x5d, 0x00),
+		slot(0x11, 0xaf, 0x00),
+		slot(0x11, 0xd7, 0x00),
+		slot(0x0c, 0, 0),
+	}
+
+	want := []Slot{
+		{
+			SystemSlot: slots[0],
+			Address:    "0000:3a:00.0",
+			Device: &Device{
+				Address:  "0000:3b:00.0",
+				VendorID: 0x15b3,
+				DeviceID: 0x1017,
+				Class:    0x020000,
+			},
+		},
+		{
+			SystemSlot: slots[1],
+			Address:    "0000:5d:00.0",
+		},
+		{
+			SystemSlot: slots[2],
+			Address:    "0000:af:00.0",
+			Device: &Device{
+				Address:  "0000:af:00.0",
+				VendorID: 0x144d,
+				DeviceID: 0xa808,
+				Class:    0x010802,
+			},
+		},
+		{
+			SystemSlot: slots[3],
+			Address:    "0000:d7:00.0",
+		},
+		{
+			SystemSlot: slots[4],
+		},
+	}
+
+	got, err := resolveSlots(root, slots)
+	if err != nil {
+		t.Fatalf("failed to resolve slots: %v", err)
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected slots (-want +got):\n%s", diff)
+	}
+
+	for i, want := range []bool{true, false, true, false, false} {
+		if got := got[i].Occupied(); want != got {
+			t.Fatalf("unexpected occupied for slot %d: %v", i, got)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"sync"
+)
+
+// A TypedStructure is a type-specific representation of a Structure, such as
+// those provided by package structures.
+type TypedStructure interface {
+	// StructureType returns the structure type represented by the
+	// TypedStructure.  StructureType must not depend on the receiver's
+	// value, so that it can be called on the zero value, such as a nil
+	// pointer.
+	StructureType() uint8
+}
+
+var (
+	// postMu guards postProcessors.
+	postMu sync.RWMutex
+
+	// postProcessors are the functions registered using
+	// RegisterPostProcessor, keyed by structure type.
+	postProcessors = make(map[uint8][]*postProcessor)
+)
+
+// A postProcessor is a registered post-processor.  Each is allocated
+// separately so that it can be identified when it is unregistered.
+type postProcessor struct {
+	fn func(TypedStructure) error
+}
+
+// addPostProcessor registers fn for structure type typ, and returns a
+// function which unregisters it.
+func addPostProcessor(typ uint8, fn func(TypedStructure) error) func() {
+	pp := &postProcessor{fn: fn}
+
+	postMu.Lock()
+	defer postMu.Unlock()
+
+	postProcessors[typ] = append(postProcessors[typ], pp)
+
+	return func() {
+		postMu.Lock()
+		defer postMu.Unlock()
+
+		pps := postProcessors[typ]
+		for i := range pps {
+			if pps[i] != pp {
+				continue
+			}
+
+			// Copy rather than modify in place, as PostProcess may be
+			// iterating over the previous slice.
+			out := make([]*postProcessor, 0, len(pps)-1)
+			out = append(out, pps[:i]...)
+			postProcessors[typ] = append(out, pps[i+1:]...)
+			return
+		}
+	}
+}
+
+// PostProcess applies the post-processors registered using
+// RegisterPostProcessor for v's structure type to v, in the order in which
+// they were registered, and stops at the first error.  PostProcess is called
+// by Get, and by the functions of package structures which parse many
+// Structures, but not by those which parse a single Structure.
+func PostProcess(v TypedStructure) error {
+	postMu.RLock()
+	pps := postProcessors[v.StructureType()]
+	postMu.RUnlock()
+
+	for _, pp := range pps {
+		if err := pp.fn(v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"io"
+	"time"
+)
+
+// Provenance describes where and when a Structure was acquired, for auditable
+// inventory records.
+type Provenance struct {
+	// Source is the operating system-specific location from which the
+	// Structure was read, such as a sysfs file or /dev/mem, or empty if
+	// unknown.
+	Source string
+
+	// Offset is the offset of the Structure in bytes from the beginning of
+	// the Decoder's stream.
+	Offset int
+
+	// Acquired is the time at which the stream containing the Structure was
+	// opened by Stream, or the time the Decoder was created for other
+	// streams.
+	Acquired time.Time
+}
+
+// WithProvenance configures a Decoder to annotate each decoded Structure with
+// its Provenance.
+//
+// The source and acquisition time are only known for streams returned by
+// Stream or Detach.  Streams returned by StreamFromBytes have no source, and
+// their acquisition time is the time the Decoder was created.
+func WithProvenance() DecoderOption {
+	return func(d *Decoder) {
+		d.provenance = &Provenance{}
+	}
+}
+
+// streamProvenance returns the Provenance template for Structures decoded
+// from r.
+func streamProvenance(r io.Reader) *Provenance {
+	orc, ok := r.(*opaqueReadCloser)
+	if !ok || orc.acquired.IsZero() {
+		return &Provenance{Acquired: time.Now()}
+	}
+
+	return &Provenance{
+		Source:   orc.source,
+		Acquired: orc.acquired,
+	}
+}
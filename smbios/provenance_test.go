@@ -0,0 +1,117 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDecoderProvenance(t *testing.T) {
+	b := []byte{
+		0x01, 0x05, 0x01, 0x00,
+		0x01,
+		'a', 0x00,
+		0x00,
+
+		127, 0x04, 0x02, 0x00,
+		0x00,
+		0x00,
+	}
+
+	acquired := time.Date(2018, time.January, 1, 0, 0, 0, 0, time.UTC)
+	rc := &opaqueReadCloser{
+		rc:       ioutil.NopCloser(bytes.NewReader(b)),
+		source:   "/sys/firmware/dmi/tables/DMI",
+		acquired: acquired,
+	}
+
+	// Detaching the stream must retain its provenance.
+	drc, err := Detach(rc)
+	if err != nil {
+		t.Fatalf("failed to detach: %v", err)
+	}
+
+	ss, err := NewDecoder(drc, WithProvenance()).Decode()
+	if err != nil {
+		t.Fatalf("failed to decode structures: %v", err)
+	}
+
+	var got []Provenance
+	for _, s := range ss {
+		got = append(got, *s.Provenance)
+	}
+
+	want := []Provenance{
+		{
+			Source:   "/sys/firmware/dmi/tables/DMI",
+			Offset:   0,
+			Acquired: acquired,
+		},
+		{
+			Source:   "/sys/firmware/dmi/tables/DMI",
+			Offset:   8,
+			Acquired: acquired,
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected provenance (-want +got):\n%s", diff)
+	}
+}
+
+func TestDecoderProvenanceUnknownSource(t *testing.T) {
+	b := []byte{
+		127, 0x04, 0x01, 0x00,
+		0x00,
+		0x00,
+	}
+
+	start := time.Now()
+
+	ss, err := NewDecoder(bytes.NewReader(b), WithProvenance()).Decode()
+	if err != nil {
+		t.Fatalf("failed to decode structures: %v", err)
+	}
+
+	p := ss[0].Provenance
+	if p.Source != "" {
+		t.Fatalf("unexpected source: %q", p.Source)
+	}
+	if p.Acquired.Before(start) {
+		t.Fatalf("acquisition time %v precedes decoding start %v", p.Acquired, start)
+	}
+}
+
+func TestDecoderNoProvenance(t *testing.T) {
+	b := []byte{
+		127, 0x04, 0x01, 0x00,
+		0x00,
+		0x00,
+	}
+
+	ss, err := NewDecoder(bytes.NewReader(b)).Decode()
+	if err != nil {
+		t.Fatalf("failed to decode structures: %v", err)
+	}
+
+	if ss[0].Provenance != nil {
+		t.Fatalf("unexpected provenance: %+v", ss[0].Provenance)
+	}
+}
@@ -0,0 +1,230 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// A QuickField is an identifying field of the first structure of its type,
+// as retrieved by QuickValue.
+type QuickField struct {
+	// Keyword is the keyword used by dmidecode's --string option, such as
+	// "system-serial-number".
+	Keyword string
+
+	// Type and Offset are the structure type and offset of the field,
+	// including the header.
+	Type   uint8
+	Offset int
+
+	// UUID specifies that the field is a 16 byte UUID rather than a string
+	// reference.
+	UUID bool
+}
+
+// quickFields are the fields retrieved by QuickValue, in the order listed by
+// dmidecode.
+var quickFields = []QuickField{
+	{Keyword: "bios-vendor", Type: 0, Offset: 0x04},
+	{Keyword: "bios-version", Type: 0, Offset: 0x05},
+	{Keyword: "bios-release-date", Type: 0, Offset: 0x08},
+	{Keyword: "system-manufacturer", Type: 1, Offset: 0x04},
+	{Keyword: "system-product-name", Type: 1, Offset: 0x05},
+	{Keyword: "system-version", Type: 1, Offset: 0x06},
+	{Keyword: "system-serial-number", Type: 1, Offset: 0x07},
+	{Keyword: "system-uuid", Type: 1, Offset: 0x08, UUID: true},
+	{Keyword: "system-sku-number", Type: 1, Offset: 0x19},
+	{Keyword: "system-family", Type: 1, Offset: 0x1a},
+	{Keyword: "baseboard-manufacturer", Type: 2, Offset: 0x04},
+	{Keyword: "baseboard-product-name", Type: 2, Offset: 0x05},
+	{Keyword: "baseboard-version", Type: 2, Offset: 0x06},
+	{Keyword: "baseboard-serial-number", Type: 2, Offset: 0x07},
+	{Keyword: "baseboard-asset-tag", Type: 2, Offset: 0x08},
+	{Keyword: "chassis-manufacturer", Type: 3, Offset: 0x04},
+	{Keyword: "chassis-version", Type: 3, Offset: 0x06},
+	{Keyword: "chassis-serial-number", Type: 3, Offset: 0x07},
+	{Keyword: "chassis-asset-tag", Type: 3, Offset: 0x08},
+	{Keyword: "processor-manufacturer", Type: 4, Offset: 0x07},
+	{Keyword: "processor-version", Type: 4, Offset: 0x10},
+}
+
+// QuickFields returns the fields retrieved by QuickValue, in the order listed
+// by dmidecode's --string option.  Package structures uses the same fields,
+// so that each API reports the same identifying values.
+func QuickFields() []QuickField {
+	return append([]QuickField(nil), quickFields...)
+}
+
+// lookupQuickField returns the QuickField with the specified keyword.
+func lookupQuickField(keyword string) (QuickField, bool) {
+	for _, f := range quickFields {
+		if f.Keyword == keyword {
+			return f, true
+		}
+	}
+
+	return QuickField{}, false
+}
+
+// QuickKeywords returns the keywords accepted by QuickValue, in sorted order.
+func QuickKeywords() []string {
+	ks := make([]string, 0, len(quickFields))
+	for _, f := range quickFields {
+		ks = append(ks, f.Keyword)
+	}
+	sort.Strings(ks)
+
+	return ks
+}
+
+// QuickValue retrieves a single identifying value from the system's SMBIOS
+// table, such as "system-serial-number" or "system-uuid".  The keywords are
+// those used by dmidecode's --string option, as listed by QuickKeywords.
+//
+// QuickValue is optimized for hot paths such as per-request host
+// identification: it stops reading at the first structure of the
+// appropriate type, and does not allocate for the structures it skips.
+// If the structure, field, or string is not present, QuickValue returns the
+// empty string and no error.
+func QuickValue(keyword string) (string, error) {
+	f, ok := lookupQuickField(keyword)
+	if !ok {
+		return "", fmt.Errorf("smbios: unknown keyword %q", keyword)
+	}
+
+	rc, _, err := Stream()
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+
+	return NewDecoder(rc).quickValue(f)
+}
+
+// quickValue decodes the field f from the first structure of its type in the
+// Decoder's stream.
+func (d *Decoder) quickValue(f QuickField) (string, error) {
+	for {
+		h, err := d.parseHeader()
+		if err != nil {
+			return "", err
+		}
+
+		if h.Length < headerLen {
+			return "", &LengthError{Offset: d.n - headerLen, Header: *h}
+		}
+		l := int(h.Length) - headerLen
+
+		if h.Type != f.Type {
+			if h.Type == typeEndOfTable {
+				return "", nil
+			}
+
+			if err := d.skip(l); err != nil {
+				return "", err
+			}
+
+			continue
+		}
+
+		// The formatted section is read into the Decoder's buffer, which
+		// remains valid until the next read.
+		if _, err := io.ReadFull(d.br, d.b[:l]); err != nil {
+			return "", err
+		}
+		d.n += l
+
+		fb := d.b[:l]
+		off := f.Offset - headerLen
+
+		if f.UUID {
+			if off+16 > len(fb) {
+				return "", nil
+			}
+
+			u := fb[off : off+16]
+			return fmt.Sprintf("%08x-%04x-%04x-%x-%x",
+				binary.LittleEndian.Uint32(u[0:4]),
+				binary.LittleEndian.Uint16(u[4:6]),
+				binary.LittleEndian.Uint16(u[6:8]),
+				u[8:10],
+				u[10:16],
+			), nil
+		}
+
+		if off >= len(fb) || fb[off] == 0 {
+			return "", nil
+		}
+
+		return d.quickString(int(fb[off]))
+	}
+}
+
+// quickString returns the string with 1-based index i from the current
+// structure's string-set, without allocating for the strings which precede
+// it.
+func (d *Decoder) quickString(i int) (string, error) {
+	for n := 1; ; n++ {
+		raw, err := d.br.ReadSlice(0x00)
+		if err != nil {
+			return "", err
+		}
+		d.n += len(raw)
+
+		// An empty string terminates the string-set.
+		b := raw[:len(raw)-1]
+		if len(b) == 0 {
+			return "", nil
+		}
+
+		if n == i {
+			if d.trim {
+				b = bytes.TrimRight(b, " ")
+			}
+
+			return string(b), nil
+		}
+	}
+}
+
+// skip discards a structure's formatted section of length l and its
+// string-set from the stream.
+func (d *Decoder) skip(l int) error {
+	if _, err := d.br.Discard(l); err != nil {
+		return err
+	}
+	d.n += l
+
+	// The string-set ends with two consecutive null bytes, which is also
+	// the representation of an empty string-set.
+	prev := byte(0xff)
+	for {
+		b, err := d.br.ReadByte()
+		if err != nil {
+			return err
+		}
+		d.n++
+
+		if b == 0x00 && prev == 0x00 {
+			return nil
+		}
+		prev = b
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDecoderQuickValue(t *testing.T) {
+	system := make([]byte, 0x1b-headerLen)
+	system[0x05-headerLen] = 1
+	system[0x07-headerLen] = 2
+	copy(system[0x08-headerLen:], []byte{
+		0x33, 0x22, 0x11, 0x00, 0x55, 0x44, 0x77, 0x66,
+		0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff,
+	})
+
+	ss := []*Structure{
+		// Skipped, with and without strings.
+		{
+			Header:    Header{Type: 0, Length: 0x08},
+			Formatted: []byte{0x00, 0x00, 0x00, 0x00},
+		},
+		{
+			Header:    Header{Type: 2, Length: 0x05},
+			Formatted: []byte{0x00},
+			Strings:   []string{"a", "b"},
+		},
+		{
+			Header:    Header{Type: 1, Length: 0x1b},
+			Formatted: system,
+			Strings:   []string{"Server", "SN-1234 "},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := NewEncoder(&buf).Encode(ss); err != nil {
+		t.Fatalf("failed to encode: %v", err)
+	}
+
+	tests := []struct {
+		keyword string
+		options []DecoderOption
+		v       string
+	}{
+		{keyword: "system-product-name", v: "Server"},
+		{keyword: "system-serial-number", v: "SN-1234 "},
+		{
+			keyword: "system-serial-number",
+			options: []DecoderOption{WithTrimmedStrings()},
+			v:       "SN-1234",
+		},
+		{keyword: "system-uuid", v: "00112233-4455-6677-8899-aabbccddeeff"},
+		// No string referenced.
+		{keyword: "system-manufacturer"},
+		// Field beyond the end of the structure.
+		{keyword: "bios-release-date"},
+		// No such structure.
+		{keyword: "chassis-asset-tag"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.keyword, func(t *testing.T) {
+			d := NewDecoder(bytes.NewReader(buf.Bytes()), tt.options...)
+
+			f, ok := lookupQuickField(tt.keyword)
+			if !ok {
+				t.Fatalf("unknown keyword: %q", tt.keyword)
+			}
+
+			v, err := d.quickValue(f)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tt.v != v {
+				t.Fatalf("unexpected value: want %q, got %q", tt.v, v)
+			}
+		})
+	}
+}
+
+func TestQuickValueUnknownKeyword(t *testing.T) {
+	if _, err := QuickValue("system-favorite-color"); err == nil {
+		t.Fatal("expected an error, but none occurred")
+	}
+}
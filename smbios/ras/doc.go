@@ -0,0 +1,28 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ras bridges SMBIOS memory device information to reliability,
+// availability, and serviceability (RAS) tooling.
+//
+// Platform firmware commonly reports memory errors, such as in the UEFI CPER
+// records logged by rasdaemon, using the SMBIOS handle of the affected memory
+// device.  Handles are meaningless to operators on their own, so this package
+// exports the mapping of handles to the strings which identify a memory
+// device's physical location, in the DIMM labels format read by rasdaemon's
+// ras-mc-ctl.
+//
+// Memory devices can also be cross-checked against the serial presence detect
+// (SPD) data of memory modules, to detect firmware which misreports module
+// identities.
+package ras
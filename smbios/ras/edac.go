@@ -0,0 +1,379 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ras
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/digitalocean/go-smbios/smbios/structures"
+)
+
+// An EDACDIMM is a DIMM reported by the Linux EDAC subsystem.
+type EDACDIMM struct {
+	// Name identifies the DIMM by its memory controller and DIMM directory
+	// names in sysfs, such as "mc0/dimm3".
+	Name string
+
+	// Label and Location are the contents of the DIMM's dimm_label and
+	// dimm_location files.
+	Label    string
+	Location string
+
+	// Size is the DIMM's size in megabytes.
+	Size int
+}
+
+// A DIMMSlot is a memory device which may have been correlated with a DIMM
+// reported by the Linux EDAC subsystem.  The Location of a correlated
+// DIMMSlot's HandleMapping is set from its EDAC DIMM, so that it can be
+// written using WriteHandleMappings.
+type DIMMSlot struct {
+	HandleMapping
+
+	// EDAC is the correlated EDAC DIMM, or nil if none was found.
+	EDAC *EDACDIMM
+}
+
+// Correlate correlates memory devices with DIMMs reported by the Linux EDAC
+// subsystem, producing one DIMMSlot per memory device in the order the memory
+// devices were specified.  Each EDAC DIMM is correlated with at most one
+// memory device.
+//
+// An EDAC DIMM is first correlated with a memory device by label, when its
+// label is either the memory device's bank locator and device locator
+// separated by a space, which is the label assigned by the ghes_edac driver,
+// or the memory device's device locator alone, which is common for labels
+// configured by operators.
+//
+// Remaining memory devices are then correlated using their physical address
+// ranges, from the Memory Array Mapped Address (type 19) structures mas and
+// the Memory Device Mapped Address (type 20) structures mdas.  A memory array
+// may be mapped by several ranges, such as those either side of the PCI hole,
+// so the ranges are grouped by memory array.  EDAC numbers memory controllers
+// in physical address order, so the memory devices mapped into the Nth memory
+// array, in order of lowest starting address, are correlated with the
+// remaining DIMMs of the Nth memory controller, in order of both the memory
+// devices' starting addresses and the DIMMs' numbers.  Memory devices are
+// only correlated by address when the number of memory arrays equals the
+// number of memory controllers, and a memory device and DIMM are only
+// correlated by address when their sizes agree.  The DIMMs may be specified
+// in any order.
+func Correlate(
+	mds []*structures.MemoryDevice,
+	mas []*structures.MemoryArrayMappedAddress,
+	mdas []*structures.MemoryDeviceMappedAddress,
+	dimms []EDACDIMM,
+) []DIMMSlot {
+	// Correlation by address relies on the DIMMs being in order, so sort a
+	// copy to leave the caller's DIMMs untouched.
+	dimms = append([]EDACDIMM(nil), dimms...)
+	sortDIMMs(dimms)
+
+	used := make([]bool, len(dimms))
+
+	// find returns the first unused DIMM with the specified label.
+	find := func(label string) *EDACDIMM {
+		if label == "" {
+			return nil
+		}
+
+		for i := range dimms {
+			if !used[i] && dimms[i].Label == label {
+				used[i] = true
+				d := dimms[i]
+				return &d
+			}
+		}
+
+		return nil
+	}
+
+	// Prefer the most specific match across all memory devices before
+	// falling back to device locators alone.
+	slots := make([]DIMMSlot, 0, len(mds))
+	for i, m := range HandleMappings(mds) {
+		slots = append(slots, DIMMSlot{
+			HandleMapping: m,
+			EDAC:          find(strings.TrimSpace(mds[i].BankLocator + " " + mds[i].DeviceLocator)),
+		})
+	}
+
+	for i := range slots {
+		if slots[i].EDAC == nil {
+			slots[i].EDAC = find(slots[i].DeviceLocator)
+		}
+	}
+
+	correlateRanges(slots, mds, mas, mdas, dimms, used)
+
+	for i := range slots {
+		if slots[i].EDAC != nil {
+			slots[i].Location = slots[i].EDAC.location()
+		}
+	}
+
+	return slots
+}
+
+// correlateRanges correlates the uncorrelated slots with unused DIMMs using
+// physical address ranges, as described by Correlate.
+func correlateRanges(
+	slots []DIMMSlot,
+	mds []*structures.MemoryDevice,
+	mas []*structures.MemoryArrayMappedAddress,
+	mdas []*structures.MemoryDeviceMappedAddress,
+	dimms []EDACDIMM,
+	used []bool,
+) {
+	// Group the memory array ranges by memory array, and number the
+	// memory arrays in physical address order, which is the order of the
+	// EDAC memory controllers.
+	type array struct {
+		handle uint16
+		start  uint64
+	}
+
+	var arrays []array
+	arrayIndex := make(map[uint16]int)
+	for _, ma := range mas {
+		start, _, ok := ma.AddressRange()
+		if !ok {
+			continue
+		}
+
+		i, ok := arrayIndex[ma.MemoryArrayHandle]
+		if !ok {
+			arrayIndex[ma.MemoryArrayHandle] = len(arrays)
+			arrays = append(arrays, array{handle: ma.MemoryArrayHandle, start: start})
+			continue
+		}
+
+		if start < arrays[i].start {
+			arrays[i].start = start
+		}
+	}
+
+	sort.SliceStable(arrays, func(i, j int) bool {
+		return arrays[i].start < arrays[j].start
+	})
+
+	// The memory controller numbers, in order.  dimms is sorted by memory
+	// controller number.
+	var mcs []int
+	for _, d := range dimms {
+		mc, _ := d.index()
+		if mc < 0 {
+			continue
+		}
+		if len(mcs) == 0 || mcs[len(mcs)-1] != mc {
+			mcs = append(mcs, mc)
+		}
+	}
+
+	// Without one memory controller per memory array, there is no way to
+	// tell which memory controller a memory array belongs to.
+	if len(arrays) == 0 || len(arrays) != len(mcs) {
+		return
+	}
+
+	arrayMCs := make(map[uint16]int, len(arrays))
+	for i, a := range arrays {
+		arrayMCs[a.handle] = mcs[i]
+	}
+
+	controllers := make(map[uint16]int, len(mas))
+	for _, ma := range mas {
+		if mc, ok := arrayMCs[ma.MemoryArrayHandle]; ok {
+			controllers[ma.Header.Handle] = mc
+		}
+	}
+
+	// Determine the memory controller and starting address of each
+	// uncorrelated slot.
+	type deviceRange struct {
+		slot, mc int
+		start    uint64
+	}
+
+	var devices []deviceRange
+	for i := range slots {
+		if slots[i].EDAC != nil {
+			continue
+		}
+
+		for _, mda := range mdas {
+			if mda.MemoryDeviceHandle != slots[i].Handle {
+				continue
+			}
+
+			mc, ok := controllers[mda.MemoryArrayMappedAddressHandle]
+			start, _, rok := mda.AddressRange()
+			if ok && rok {
+				devices = append(devices, deviceRange{slot: i, mc: mc, start: start})
+				break
+			}
+		}
+	}
+
+	sort.SliceStable(devices, func(i, j int) bool {
+		return devices[i].start < devices[j].start
+	})
+
+	for _, d := range devices {
+		size, _ := mds[d.slot].SizeBytes()
+
+		// dimms is sorted by memory controller and DIMM number, so the
+		// first suitable DIMM is the next one on the controller.
+		for i := range dimms {
+			mc, _ := dimms[i].index()
+			if used[i] || mc != d.mc {
+				continue
+			}
+			if dimms[i].Size != 0 && uint64(dimms[i].Size)<<20 != size {
+				continue
+			}
+
+			used[i] = true
+			dimm := dimms[i]
+			slots[d.slot].EDAC = &dimm
+			break
+		}
+	}
+}
+
+// index returns the memory controller and DIMM numbers of d from its Name,
+// or -1 for numbers which cannot be determined.
+func (d EDACDIMM) index() (mc, dimm int) {
+	mc, dimm = -1, -1
+
+	ss := strings.SplitN(d.Name, "/", 2)
+	if n, err := strconv.Atoi(strings.TrimPrefix(ss[0], "mc")); err == nil {
+		mc = n
+	}
+	if len(ss) == 2 {
+		if n, err := strconv.Atoi(strings.TrimLeft(ss[1], "abcdefghijklmnopqrstuvwxyz")); err == nil {
+			dimm = n
+		}
+	}
+
+	return mc, dimm
+}
+
+// location returns the location of d in the "mc.top.mid.low" form used by
+// rasdaemon, derived from its memory controller number and the layer numbers
+// of its dimm_location, such as "channel 1 slot 0".  If the location cannot
+// be determined, location returns the empty string.
+func (d EDACDIMM) location() string {
+	mc, _ := d.index()
+	if mc < 0 {
+		return ""
+	}
+
+	// dimm_location alternates layer names and numbers.
+	ss := []string{strconv.Itoa(mc)}
+	fs := strings.Fields(d.Location)
+	for i := 1; i < len(fs); i += 2 {
+		if _, err := strconv.Atoi(fs[i]); err != nil {
+			return ""
+		}
+
+		ss = append(ss, fs[i])
+	}
+
+	if len(ss) == 1 {
+		return ""
+	}
+
+	return strings.Join(ss, ".")
+}
+
+// readEDAC reads EDAC DIMM information from a sysfs memory controller
+// directory such as /sys/devices/system/edac/mc.
+func readEDAC(root string) ([]EDACDIMM, error) {
+	// Older kernels expose ranks rather than DIMMs; accept either.
+	var dirs []string
+	for _, pattern := range []string{"mc*/dimm*", "mc*/rank*"} {
+		matches, err := filepath.Glob(filepath.Join(root, pattern))
+		if err != nil {
+			return nil, err
+		}
+
+		dirs = append(dirs, matches...)
+	}
+
+	var dimms []EDACDIMM
+	for _, dir := range dirs {
+		label, err := readSysfsString(filepath.Join(dir, "dimm_label"))
+		if err != nil {
+			return nil, err
+		}
+
+		// Not all drivers report the location or size.
+		location, err := readSysfsString(filepath.Join(dir, "dimm_location"))
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+
+		var size int
+		if s, err := readSysfsString(filepath.Join(dir, "size")); err == nil {
+			size, _ = strconv.Atoi(s)
+		}
+
+		dimms = append(dimms, EDACDIMM{
+			Name:     filepath.Join(filepath.Base(filepath.Dir(dir)), filepath.Base(dir)),
+			Label:    label,
+			Location: location,
+			Size:     size,
+		})
+	}
+
+	sortDIMMs(dimms)
+	return dimms, nil
+}
+
+// sortDIMMs sorts dimms by memory controller and DIMM number.  Numbers are
+// compared numerically, so that dimm10 follows dimm9 rather than dimm1, and
+// DIMMs precede ranks.
+func sortDIMMs(dimms []EDACDIMM) {
+	sort.SliceStable(dimms, func(i, j int) bool {
+		mci, di := dimms[i].index()
+		mcj, dj := dimms[j].index()
+		if mci != mcj {
+			return mci < mcj
+		}
+
+		ki, kj := strings.Contains(dimms[i].Name, "rank"), strings.Contains(dimms[j].Name, "rank")
+		if ki != kj {
+			return kj
+		}
+
+		return di < dj
+	})
+}
+
+// readSysfsString reads a sysfs file and trims its trailing newline.
+func readSysfsString(file string) (string, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(b)), nil
+}
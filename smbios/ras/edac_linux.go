@@ -0,0 +1,57 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+// +build linux
+
+package ras
+
+import (
+	"github.com/digitalocean/go-smbios/smbios/structures"
+)
+
+// sysfsEDAC is the sysfs location of EDAC memory controllers.
+const sysfsEDAC = "/sys/devices/system/edac/mc"
+
+// EDACDIMMs reads the DIMMs reported by the Linux EDAC subsystem.  If no EDAC
+// driver is loaded, no DIMMs are returned.
+func EDACDIMMs() ([]EDACDIMM, error) {
+	return readEDAC(sysfsEDAC)
+}
+
+// EDACSlots correlates the memory devices of a Table with the DIMMs reported
+// by the Linux EDAC subsystem.  See Correlate for details.
+func EDACSlots(t *structures.Table) ([]DIMMSlot, error) {
+	mds, err := t.MemoryDevices()
+	if err != nil {
+		return nil, err
+	}
+
+	mas, err := structures.MemoryArrayMappedAddresses(t.Structures)
+	if err != nil {
+		return nil, err
+	}
+
+	mdas, err := structures.MemoryDeviceMappedAddresses(t.Structures)
+	if err != nil {
+		return nil, err
+	}
+
+	dimms, err := EDACDIMMs()
+	if err != nil {
+		return nil, err
+	}
+
+	return Correlate(mds, mas, mdas, dimms), nil
+}
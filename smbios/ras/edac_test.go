@@ -0,0 +1,320 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ras
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCorrelate(t *testing.T) {
+	md := func(handle uint16, bank, device string) *structures.MemoryDevice {
+		return &structures.MemoryDevice{
+			Header:        smbios.Header{Type: 17, Handle: handle},
+			BankLocator:   bank,
+			DeviceLocator: device,
+			Size:          16384,
+		}
+	}
+
+	mds := []*structures.MemoryDevice{
+		md(0x11, "NODE 0", "DIMM_A1"),
+		md(0x12, "NODE 0", "DIMM_A2"),
+		md(0x13, "", "DIMM_B1"),
+		md(0x14, "NODE 1", "DIMM_B2"),
+		md(0x15, "NODE 1", "DIMM_B3"),
+	}
+
+	// Memory array 0x21 is listed first, but maps the higher addresses, so
+	// its devices are attributed to mc1.
+	mas := []*structures.MemoryArrayMappedAddress{
+		{
+			Header:            smbios.Header{Type: 19, Handle: 0x21},
+			StartingAddress:   0x02000000,
+			EndingAddress:     0x03ffffff,
+			MemoryArrayHandle: 0x31,
+		},
+		{
+			Header:            smbios.Header{Type: 19, Handle: 0x20},
+			StartingAddress:   0x00000000,
+			EndingAddress:     0x01ffffff,
+			MemoryArrayHandle: 0x30,
+		},
+	}
+
+	mda := func(handle, array uint16, start uint32) *structures.MemoryDeviceMappedAddress {
+		return &structures.MemoryDeviceMappedAddress{
+			Header:                         smbios.Header{Type: 20},
+			StartingAddress:                start,
+			EndingAddress:                  start + 0x00ffffff,
+			MemoryDeviceHandle:             handle,
+			MemoryArrayMappedAddressHandle: array,
+		}
+	}
+
+	mdas := []*structures.MemoryDeviceMappedAddress{
+		mda(0x15, 0x21, 0x02000000),
+		mda(0x14, 0x21, 0x03000000),
+		mda(0x13, 0x20, 0x01000000),
+	}
+
+	dimms := []EDACDIMM{
+		{Name: "mc0/dimm0", Label: "NODE 0 DIMM_A1", Location: "channel 0 slot 0"},
+		{Name: "mc0/dimm1", Label: "DIMM_A2", Location: "channel 0 slot 1"},
+		{Name: "mc1/dimm0", Label: "DIMM_B1", Location: "channel 0 slot 0"},
+		// Too small to be either remaining memory device.
+		{Name: "mc1/dimm1", Label: "CPU_SrcID#1_MC#1_Chan#0_DIMM#1", Location: "channel 0 slot 1", Size: 8192},
+		{Name: "mc1/dimm2", Label: "CPU_SrcID#1_MC#1_Chan#1_DIMM#0", Location: "channel 1 slot 0", Size: 16384},
+	}
+
+	want := []DIMMSlot{
+		{
+			HandleMapping: HandleMapping{Handle: 0x11, BankLocator: "NODE 0", DeviceLocator: "DIMM_A1", Location: "0.0.0"},
+			EDAC:          &dimms[0],
+		},
+		{
+			HandleMapping: HandleMapping{Handle: 0x12, BankLocator: "NODE 0", DeviceLocator: "DIMM_A2", Location: "0.0.1"},
+			EDAC:          &dimms[1],
+		},
+		{
+			// Correlated by label, despite its mapping to mc0.
+			HandleMapping: HandleMapping{Handle: 0x13, DeviceLocator: "DIMM_B1", Location: "1.0.0"},
+			EDAC:          &dimms[2],
+		},
+		{
+			HandleMapping: HandleMapping{Handle: 0x14, BankLocator: "NODE 1", DeviceLocator: "DIMM_B2"},
+		},
+		{
+			// Correlated by address, as the first device mapped into
+			// the memory array of mc1.
+			HandleMapping: HandleMapping{Handle: 0x15, BankLocator: "NODE 1", DeviceLocator: "DIMM_B3", Location: "1.1.0"},
+			EDAC:          &dimms[4],
+		},
+	}
+
+	if diff := cmp.Diff(want, Correlate(mds, mas, mdas, dimms)); diff != "" {
+		t.Fatalf("unexpected slots (-want +got):\n%s", diff)
+	}
+}
+
+func TestCorrelateUnsortedDIMMs(t *testing.T) {
+	mds := []*structures.MemoryDevice{
+		{Header: smbios.Header{Type: 17, Handle: 0x11}, DeviceLocator: "A", Size: 16384},
+		{Header: smbios.Header{Type: 17, Handle: 0x12}, DeviceLocator: "B", Size: 16384},
+	}
+
+	mas := []*structures.MemoryArrayMappedAddress{{
+		Header:          smbios.Header{Type: 19, Handle: 0x20},
+		StartingAddress: 0x00000000,
+		EndingAddress:   0x01ffffff,
+	}}
+
+	mdas := []*structures.MemoryDeviceMappedAddress{
+		{MemoryDeviceHandle: 0x12, MemoryArrayMappedAddressHandle: 0x20, StartingAddress: 0x01000000, EndingAddress: 0x01ffffff},
+		{MemoryDeviceHandle: 0x11, MemoryArrayMappedAddressHandle: 0x20, StartingAddress: 0x00000000, EndingAddress: 0x00ffffff},
+	}
+
+	// The DIMMs are not in order of their numbers.
+	dimms := []EDACDIMM{
+		{Name: "mc0/dimm1", Location: "channel 0 slot 1"},
+		{Name: "mc0/dimm0", Location: "channel 0 slot 0"},
+	}
+
+	want := []DIMMSlot{
+		{
+			HandleMapping: HandleMapping{Handle: 0x11, DeviceLocator: "A", Location: "0.0.0"},
+			EDAC:          &dimms[1],
+		},
+		{
+			HandleMapping: HandleMapping{Handle: 0x12, DeviceLocator: "B", Location: "0.0.1"},
+			EDAC:          &dimms[0],
+		},
+	}
+
+	if diff := cmp.Diff(want, Correlate(mds, mas, mdas, dimms)); diff != "" {
+		t.Fatalf("unexpected slots (-want +got):\n%s", diff)
+	}
+
+	// The caller's DIMMs must not be reordered.
+	if diff := cmp.Diff("mc0/dimm1", dimms[0].Name); diff != "" {
+		t.Fatalf("unexpected first DIMM (-want +got):\n%s", diff)
+	}
+}
+
+func TestCorrelateSplitArray(t *testing.T) {
+	mds := []*structures.MemoryDevice{
+		{Header: smbios.Header{Type: 17, Handle: 0x11}, DeviceLocator: "A1", Size: 2048},
+		{Header: smbios.Header{Type: 17, Handle: 0x12}, DeviceLocator: "A2", Size: 2048},
+		{Header: smbios.Header{Type: 17, Handle: 0x13}, DeviceLocator: "B1", Size: 2048},
+		{Header: smbios.Header{Type: 17, Handle: 0x14}, DeviceLocator: "B2", Size: 2048},
+	}
+
+	// Memory array 0x30 is split by the PCI hole between 2GiB and 4GiB, so
+	// its second range follows the first range of no other memory array.
+	mas := []*structures.MemoryArrayMappedAddress{
+		{
+			Header:            smbios.Header{Type: 19, Handle: 0x40},
+			StartingAddress:   0x00000000,
+			EndingAddress:     0x001fffff,
+			MemoryArrayHandle: 0x30,
+		},
+		{
+			Header:            smbios.Header{Type: 19, Handle: 0x41},
+			StartingAddress:   0x00400000,
+			EndingAddress:     0x005fffff,
+			MemoryArrayHandle: 0x30,
+		},
+		{
+			Header:            smbios.Header{Type: 19, Handle: 0x42},
+			StartingAddress:   0x00600000,
+			EndingAddress:     0x009fffff,
+			MemoryArrayHandle: 0x31,
+		},
+	}
+
+	mda := func(handle, array uint16, start uint32) *structures.MemoryDeviceMappedAddress {
+		return &structures.MemoryDeviceMappedAddress{
+			Header:                         smbios.Header{Type: 20},
+			StartingAddress:                start,
+			EndingAddress:                  start + 0x001fffff,
+			MemoryDeviceHandle:             handle,
+			MemoryArrayMappedAddressHandle: array,
+		}
+	}
+
+	mdas := []*structures.MemoryDeviceMappedAddress{
+		mda(0x11, 0x40, 0x00000000),
+		mda(0x12, 0x41, 0x00400000),
+		mda(0x13, 0x42, 0x00600000),
+		mda(0x14, 0x42, 0x00800000),
+	}
+
+	dimms := []EDACDIMM{
+		{Name: "mc0/dimm0", Location: "channel 0 slot 0"},
+		{Name: "mc0/dimm1", Location: "channel 1 slot 0"},
+		{Name: "mc1/dimm0", Location: "channel 0 slot 0"},
+		{Name: "mc1/dimm1", Location: "channel 1 slot 0"},
+	}
+
+	want := []DIMMSlot{
+		{
+			HandleMapping: HandleMapping{Handle: 0x11, DeviceLocator: "A1", Location: "0.0.0"},
+			EDAC:          &dimms[0],
+		},
+		{
+			HandleMapping: HandleMapping{Handle: 0x12, DeviceLocator: "A2", Location: "0.1.0"},
+			EDAC:          &dimms[1],
+		},
+		{
+			HandleMapping: HandleMapping{Handle: 0x13, DeviceLocator: "B1", Location: "1.0.0"},
+			EDAC:          &dimms[2],
+		},
+		{
+			HandleMapping: HandleMapping{Handle: 0x14, DeviceLocator: "B2", Location: "1.1.0"},
+			EDAC:          &dimms[3],
+		},
+	}
+
+	if diff := cmp.Diff(want, Correlate(mds, mas, mdas, dimms)); diff != "" {
+		t.Fatalf("unexpected slots (-want +got):\n%s", diff)
+	}
+
+	// With fewer memory controllers than memory arrays, the memory arrays
+	// cannot be attributed to memory controllers, so nothing is correlated
+	// by address.
+	want = []DIMMSlot{
+		{HandleMapping: HandleMapping{Handle: 0x11, DeviceLocator: "A1"}},
+		{HandleMapping: HandleMapping{Handle: 0x12, DeviceLocator: "A2"}},
+		{HandleMapping: HandleMapping{Handle: 0x13, DeviceLocator: "B1"}},
+		{HandleMapping: HandleMapping{Handle: 0x14, DeviceLocator: "B2"}},
+	}
+
+	if diff := cmp.Diff(want, Correlate(mds, mas, mdas, dimms[:2])); diff != "" {
+		t.Fatalf("unexpected slots with one memory controller (-want +got):\n%s", diff)
+	}
+}
+
+func Test_readEDAC(t *testing.T) {
+	root, err := ioutil.TempDir("", "go-smbios-edac")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	files := map[string]string{
+		"mc0/dimm0/dimm_label":    "NODE 0 DIMM_A1\n",
+		"mc0/dimm0/dimm_location": "memory 0 \n",
+		"mc0/dimm0/size":          "16384\n",
+		"mc0/dimm10/dimm_label":   "DIMM_A11\n",
+		"mc0/dimm2/dimm_label":    "DIMM_A3\n",
+		"mc1/rank0/dimm_label":    "DIMM_B1\n",
+		"mc10/dimm0/dimm_label":   "DIMM_K1\n",
+		"mc2/dimm0/dimm_label":    "DIMM_C1\n",
+	}
+
+	for f, s := range files {
+		path := filepath.Join(root, f)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("failed to create directory: %v", err)
+		}
+		if err := ioutil.WriteFile(path, []byte(s), 0644); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+	}
+
+	dimms, err := readEDAC(root)
+	if err != nil {
+		t.Fatalf("failed to read EDAC: %v", err)
+	}
+
+	want := []EDACDIMM{
+		{
+			Name:     "mc0/dimm0",
+			Label:    "NODE 0 DIMM_A1",
+			Location: "memory 0",
+			Size:     16384,
+		},
+		{
+			Name:  "mc0/dimm2",
+			Label: "DIMM_A3",
+		},
+		{
+			Name:  "mc0/dimm10",
+			Label: "DIMM_A11",
+		},
+		{
+			Name:  "mc1/rank0",
+			Label: "DIMM_B1",
+		},
+		{
+			Name:  "mc2/dimm0",
+			Label: "DIMM_C1",
+		},
+		{
+			Name:  "mc10/dimm0",
+			Label: "DIMM_K1",
+		},
+	}
+
+	if diff := cmp.Diff(want, dimms); diff != "" {
+		t.Fatalf("unexpected DIMMs (-want +got):\n%s", diff)
+	}
+}
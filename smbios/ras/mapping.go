@@ -0,0 +1,165 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ras
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/digitalocean/go-smbios/smbios/structures"
+)
+
+// A HandleMapping maps the SMBIOS handle of a memory device to the strings
+// which identify it.
+type HandleMapping struct {
+	Handle        uint16
+	DeviceLocator string
+	BankLocator   string
+	SerialNumber  string
+
+	// Location is the memory device's EDAC location in the
+	// "mc.top.mid.low" form used by rasdaemon, such as "0.1.0", or empty
+	// if it is unknown.
+	Location string
+}
+
+// HandleMappings produces HandleMappings for a list of memory devices.  The
+// Location of each HandleMapping is empty.
+func HandleMappings(mds []*structures.MemoryDevice) []HandleMapping {
+	ms := make([]HandleMapping, 0, len(mds))
+	for _, md := range mds {
+		ms = append(ms, HandleMapping{
+			Handle:        md.Header.Handle,
+			DeviceLocator: md.DeviceLocator,
+			BankLocator:   md.BankLocator,
+			SerialNumber:  md.SerialNumber,
+		})
+	}
+
+	return ms
+}
+
+// A Mainboard identifies the mainboard to which a rasdaemon DIMM labels file
+// applies.  rasdaemon matches Vendor and Model against the DMI board vendor
+// and board name, and Product against the DMI product name.
+type Mainboard struct {
+	Vendor  string
+	Product string
+	Model   string
+}
+
+// NewMainboard produces a Mainboard from the identity of a system.
+func NewMainboard(si *structures.SystemInfo) Mainboard {
+	return Mainboard{
+		Vendor:  strings.TrimSpace(si.BaseboardManufacturer()),
+		Product: strings.TrimSpace(si.SystemProductName()),
+		Model:   strings.TrimSpace(si.BaseboardProduct()),
+	}
+}
+
+// mappingComment is the format of the comment which records each
+// HandleMapping in a DIMM labels file.
+const mappingComment = "# handle 0x%04x locator %q bank %q serial %q"
+
+// WriteHandleMappings writes HandleMappings to w in the DIMM labels format
+// read by rasdaemon's ras-mc-ctl, so that memory errors reported by EDAC are
+// attributed to the DIMM labels of mb.  Each HandleMapping with a Location
+// produces a "label: mc.top.mid.low" line, labeled by its device locator.
+//
+// Each HandleMapping is also recorded in a comment, which rasdaemon ignores,
+// with its handle in hexadecimal, matching the format used by dmidecode and
+// firmware error logs.  The output can be persisted and later read using
+// ReadHandleMappings, so that errors can be attributed even after the memory
+// configuration changes.
+func WriteHandleMappings(w io.Writer, mb Mainboard, ms []HandleMapping) error {
+	if mb.Vendor == "" || mb.Model == "" {
+		return fmt.Errorf("DIMM labels require a mainboard vendor and model, but got: %q, %q", mb.Vendor, mb.Model)
+	}
+	for _, v := range []string{mb.Vendor, mb.Product, mb.Model} {
+		if strings.ContainsAny(v, "#\n") {
+			return fmt.Errorf("invalid mainboard identifier for DIMM labels: %q", v)
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintf(bw, "Vendor: %s\n", mb.Vendor)
+	if mb.Product != "" {
+		fmt.Fprintf(bw, "  Product: %s\n", mb.Product)
+	}
+	fmt.Fprintf(bw, "  Model: %s\n", mb.Model)
+
+	for _, m := range ms {
+		fmt.Fprintf(bw, "    "+mappingComment+"\n", m.Handle, m.DeviceLocator, m.BankLocator, m.SerialNumber)
+
+		if m.Location == "" {
+			continue
+		}
+
+		// Labels are delimited by colons and semicolons, and cannot
+		// contain comments.
+		label := strings.TrimSpace(m.DeviceLocator)
+		if label == "" || strings.ContainsAny(label, ":;#\n") {
+			return fmt.Errorf("invalid DIMM label for memory device handle 0x%04x: %q", m.Handle, m.DeviceLocator)
+		}
+
+		fmt.Fprintf(bw, "    %s: %s\n", label, m.Location)
+	}
+
+	return bw.Flush()
+}
+
+// ReadHandleMappings reads HandleMappings written by WriteHandleMappings
+// from r.
+func ReadHandleMappings(r io.Reader) ([]HandleMapping, error) {
+	var ms []HandleMapping
+
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+
+		if strings.HasPrefix(line, "# handle ") {
+			var m HandleMapping
+			_, err := fmt.Sscanf(line, mappingComment, &m.Handle, &m.DeviceLocator, &m.BankLocator, &m.SerialNumber)
+			if err != nil {
+				return nil, fmt.Errorf("invalid memory device mapping %q: %v", line, err)
+			}
+
+			ms = append(ms, m)
+			continue
+		}
+
+		// A label line follows the comment of the HandleMapping it
+		// describes; anything else is part of the header or another
+		// comment.
+		i := strings.Index(line, ":")
+		if i == -1 || len(ms) == 0 {
+			continue
+		}
+
+		m := &ms[len(ms)-1]
+		if m.Location == "" && line[:i] == strings.TrimSpace(m.DeviceLocator) {
+			m.Location = strings.TrimSpace(line[i+1:])
+		}
+	}
+
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	return ms, nil
+}
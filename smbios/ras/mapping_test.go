@@ -0,0 +1,149 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ras_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/ras"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestHandleMappingsRoundTrip(t *testing.T) {
+	mds := []*structures.MemoryDevice{
+		{
+			Header:        smbios.Header{Type: 17, Handle: 0x0011},
+			DeviceLocator: "DIMM_A1",
+			BankLocator:   "NODE 0",
+			SerialNumber:  "12345678",
+		},
+		{
+			Header:        smbios.Header{Type: 17, Handle: 0x0012},
+			DeviceLocator: "DIMM_A2",
+			BankLocator:   "NODE 0",
+		},
+		{
+			// Locators containing separators must survive a round trip.
+			Header:        smbios.Header{Type: 17, Handle: 0x1013},
+			DeviceLocator: "DIMM\t\"B1\"",
+		},
+	}
+
+	want := ras.HandleMappings(mds)
+	want[0].Location = "0.0.0"
+	want[1].Location = "0.1.0"
+
+	mb := ras.Mainboard{
+		Vendor:  "Dell Inc.",
+		Product: "PowerEdge R640",
+		Model:   "0H28RR",
+	}
+
+	var buf bytes.Buffer
+	if err := ras.WriteHandleMappings(&buf, mb, want); err != nil {
+		t.Fatalf("failed to write mappings: %v", err)
+	}
+
+	wantText := strings.Join([]string{
+		"Vendor: Dell Inc.",
+		"  Product: PowerEdge R640",
+		"  Model: 0H28RR",
+		`    # handle 0x0011 locator "DIMM_A1" bank "NODE 0" serial "12345678"`,
+		"    DIMM_A1: 0.0.0",
+		`    # handle 0x0012 locator "DIMM_A2" bank "NODE 0" serial ""`,
+		"    DIMM_A2: 0.1.0",
+		`    # handle 0x1013 locator "DIMM\t\"B1\"" bank "" serial ""`,
+		"",
+	}, "\n")
+
+	if diff := cmp.Diff(wantText, buf.String()); diff != "" {
+		t.Fatalf("unexpected mapping text (-want +got):\n%s", diff)
+	}
+
+	got, err := ras.ReadHandleMappings(&buf)
+	if err != nil {
+		t.Fatalf("failed to read mappings: %v", err)
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected mappings (-want +got):\n%s", diff)
+	}
+}
+
+func TestWriteHandleMappingsError(t *testing.T) {
+	mb := ras.Mainboard{Vendor: "Dell Inc.", Model: "0H28RR"}
+
+	tests := []struct {
+		name string
+		mb   ras.Mainboard
+		ms   []ras.HandleMapping
+	}{
+		{
+			name: "no model",
+			mb:   ras.Mainboard{Vendor: "Dell Inc."},
+		},
+		{
+			name: "bad vendor",
+			mb:   ras.Mainboard{Vendor: "Dell #1", Model: "0H28RR"},
+		},
+		{
+			name: "empty label",
+			mb:   mb,
+			ms:   []ras.HandleMapping{{Handle: 0x11, Location: "0.0.0"}},
+		},
+		{
+			name: "label with separator",
+			mb:   mb,
+			ms:   []ras.HandleMapping{{Handle: 0x11, DeviceLocator: "CPU1: DIMM_A1", Location: "0.0.0"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ras.WriteHandleMappings(ioutil.Discard, tt.mb, tt.ms); err == nil {
+				t.Fatal("expected an error, but none occurred")
+			}
+		})
+	}
+}
+
+func TestReadHandleMappingsError(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+	}{
+		{
+			name: "bad handle",
+			s:    `# handle DIMM locator "DIMM_A1" bank "NODE 0" serial "1234"` + "\n",
+		},
+		{
+			name: "too few fields",
+			s:    `# handle 0x0011 locator "DIMM_A1"` + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ras.ReadHandleMappings(strings.NewReader(tt.s)); err == nil {
+				t.Fatal("expected an error, but none occurred")
+			}
+		})
+	}
+}
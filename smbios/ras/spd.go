@@ -0,0 +1,189 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ras
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/digitalocean/go-smbios/smbios/structures"
+)
+
+// An SPDModule is a memory module identified by the contents of its serial
+// presence detect (SPD) EEPROM.
+type SPDModule struct {
+	// Name identifies the module's EEPROM by its I2C device name in sysfs,
+	// such as "0-0050".
+	Name string
+
+	ManufacturerID structures.JEDECID
+	SerialNumber   string
+	PartNumber     string
+}
+
+// DDR4 SPD EEPROM layout, as specified by JEDEC Standard 21-C.
+const (
+	spdLen          = 512
+	spdDeviceType   = 2
+	spdManufacturer = 320
+	spdSerialNumber = 325
+	spdPartNumber   = 329
+	spdPartLen      = 20
+)
+
+// ErrUnsupportedSPD is returned by ParseSPD when SPD data describes a DRAM
+// device type other than DDR4, such as a DDR5 module.
+var ErrUnsupportedSPD = errors.New("unsupported SPD DRAM device type")
+
+// ParseSPD parses the identity of a DDR4 memory module from the contents of
+// its SPD EEPROM.
+func ParseSPD(b []byte) (*SPDModule, error) {
+	if len(b) < spdLen {
+		return nil, fmt.Errorf("expected SPD data length of at least %d, but got: %d", spdLen, len(b))
+	}
+
+	switch b[spdDeviceType] {
+	case 0x0c, 0x0e:
+		// DDR4 and DDR4E SDRAM.
+	default:
+		return nil, fmt.Errorf("%w: %#02x", ErrUnsupportedSPD, b[spdDeviceType])
+	}
+
+	return &SPDModule{
+		ManufacturerID: structures.JEDECID(b[spdManufacturer]) | structures.JEDECID(b[spdManufacturer+1])<<8,
+		SerialNumber:   fmt.Sprintf("%X", b[spdSerialNumber:spdSerialNumber+4]),
+		PartNumber:     strings.TrimSpace(string(b[spdPartNumber : spdPartNumber+spdPartLen])),
+	}, nil
+}
+
+// An SPDCheck is the result of cross-checking the identity of a memory device
+// reported by SMBIOS against SPD data.
+type SPDCheck struct {
+	HandleMapping
+
+	// SPD is the module with the same serial number as the memory device, or
+	// nil if none was found.
+	SPD *SPDModule
+
+	// PartNumberMismatch and ManufacturerMismatch report whether the memory
+	// device's part number and module manufacturer ID differ from those of
+	// its SPD module.  A manufacturer ID is only compared when both sources
+	// report one.
+	PartNumberMismatch   bool
+	ManufacturerMismatch bool
+}
+
+// OK reports whether the memory device was found in SPD data with a
+// consistent identity.
+func (c SPDCheck) OK() bool {
+	return c.SPD != nil && !c.PartNumberMismatch && !c.ManufacturerMismatch
+}
+
+// CrossCheckSPD cross-checks installed memory devices against SPD modules
+// using their serial numbers, producing one SPDCheck per installed memory
+// device in the order the memory devices were specified.  Memory devices of
+// unknown size are skipped along with those which are not installed.  Memory devices with
+// no SPD module, or with mismatched identities, indicate firmware which
+// misreports module identities.
+//
+// CrossCheckSPD also returns any SPD modules which were not matched with a
+// memory device.
+func CrossCheckSPD(mds []*structures.MemoryDevice, modules []SPDModule) ([]SPDCheck, []SPDModule) {
+	used := make([]bool, len(modules))
+
+	var checks []SPDCheck
+	for i, m := range HandleMappings(mds) {
+		md := mds[i]
+		if _, ok := md.SizeBytes(); !ok {
+			// Not installed, or of unknown size, so the memory device may
+			// not correspond to a module.
+			continue
+		}
+
+		c := SPDCheck{HandleMapping: m}
+
+		serial := normalizeSerial(md.SerialNumber)
+		for j := range modules {
+			if used[j] || serial == "" || modules[j].SerialNumber != serial {
+				continue
+			}
+
+			used[j] = true
+			spd := modules[j]
+			c.SPD = &spd
+			c.PartNumberMismatch = !strings.EqualFold(strings.TrimSpace(md.PartNumber), spd.PartNumber)
+			c.ManufacturerMismatch = md.ModuleManufacturerID.Known() && spd.ManufacturerID.Known() &&
+				md.ModuleManufacturerID != spd.ManufacturerID
+			break
+		}
+
+		checks = append(checks, c)
+	}
+
+	var unmatched []SPDModule
+	for i := range modules {
+		if !used[i] {
+			unmatched = append(unmatched, modules[i])
+		}
+	}
+
+	return checks, unmatched
+}
+
+// normalizeSerial normalizes a memory device serial number string to the
+// format used by SPDModule.
+func normalizeSerial(s string) string {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	return strings.TrimPrefix(s, "0X")
+}
+
+// readSPD reads SPD modules from a sysfs I2C driver directory such as
+// /sys/bus/i2c/drivers/ee1004.  EEPROMs of unsupported DRAM device types are
+// skipped.
+func readSPD(root string) ([]SPDModule, error) {
+	files, err := filepath.Glob(filepath.Join(root, "*", "eeprom"))
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(files)
+
+	var modules []SPDModule
+	for _, f := range files {
+		b, err := ioutil.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+
+		name := filepath.Base(filepath.Dir(f))
+
+		m, err := ParseSPD(b)
+		if errors.Is(err, ErrUnsupportedSPD) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse SPD data for %s: %v", name, err)
+		}
+
+		m.Name = name
+		modules = append(modules, *m)
+	}
+
+	return modules, nil
+}
@@ -0,0 +1,45 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+// +build linux
+
+package ras
+
+import (
+	"github.com/digitalocean/go-smbios/smbios/structures"
+)
+
+// sysfsEE1004 is the sysfs location of the ee1004 driver, which exposes the
+// SPD EEPROMs of DDR4 memory modules.
+const sysfsEE1004 = "/sys/bus/i2c/drivers/ee1004"
+
+// SPDModules reads the SPD EEPROMs of memory modules exposed by the Linux
+// ee1004 driver.  If the driver is not loaded, no modules are returned.
+// EEPROMs of DRAM device types other than DDR4 are skipped.
+func SPDModules() ([]SPDModule, error) {
+	return readSPD(sysfsEE1004)
+}
+
+// SPDChecks cross-checks memory devices against the SPD EEPROMs exposed by
+// the Linux ee1004 driver.  See CrossCheckSPD for details.
+func SPDChecks(mds []*structures.MemoryDevice) ([]SPDCheck, []SPDModule, error) {
+	modules, err := SPDModules()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	checks, unmatched := CrossCheckSPD(mds, modules)
+	return checks, unmatched, nil
+}
@@ -0,0 +1,202 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ras
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+// spd builds DDR4 SPD EEPROM contents with the specified identity.
+func spd(mfr structures.JEDECID, serial []byte, part string) []byte {
+	b := make([]byte, spdLen)
+	b[spdDeviceType] = 0x0c
+	b[spdManufacturer] = byte(mfr)
+	b[spdManufacturer+1] = byte(mfr >> 8)
+	copy(b[spdSerialNumber:], serial)
+
+	p := []byte(part + "                    ")
+	copy(b[spdPartNumber:], p[:spdPartLen])
+
+	return b
+}
+
+func TestParseSPD(t *testing.T) {
+	tests := []struct {
+		name string
+		b    []byte
+		m    *SPDModule
+		ok   bool
+	}{
+		{
+			name: "short",
+			b:    make([]byte, 256),
+		},
+		{
+			name: "not DDR4",
+			b: func() []byte {
+				b := spd(0, nil, "")
+				b[spdDeviceType] = 0x0b
+				return b
+			}(),
+		},
+		{
+			name: "OK",
+			b:    spd(0x2c80, []byte{0x12, 0x34, 0xab, 0x0c}, "36ASF4G72PZ-2G9E2"),
+			m: &SPDModule{
+				ManufacturerID: 0x2c80,
+				SerialNumber:   "1234AB0C",
+				PartNumber:     "36ASF4G72PZ-2G9E2",
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := ParseSPD(tt.b)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if !tt.ok {
+				// Don't bother doing comparison if output is incorrect.
+				return
+			}
+
+			if diff := cmp.Diff(tt.m, m); diff != "" {
+				t.Fatalf("unexpected SPD module (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCrossCheckSPD(t *testing.T) {
+	md := func(handle uint16, size uint16, mfr structures.JEDECID, serial, part string) *structures.MemoryDevice {
+		return &structures.MemoryDevice{
+			Header:               smbios.Header{Type: 17, Handle: handle},
+			Size:                 size,
+			ModuleManufacturerID: mfr,
+			SerialNumber:         serial,
+			PartNumber:           part,
+		}
+	}
+
+	mds := []*structures.MemoryDevice{
+		md(0x11, 32768, 0x2c80, "1234AB0C", "36ASF4G72PZ-2G9E2 "),
+		md(0x12, 32768, 0, "0x0000beef", "36ASF4G72PZ-2G9E2"),
+		md(0x13, 32768, 0xce00, "00000001", "36ASF4G72PZ-2G9E2"),
+		md(0x14, 32768, 0, "Unknown", ""),
+		md(0x15, 0, 0, "", ""),
+		// Unknown size.
+		md(0x16, 0xffff, 0, "Unknown", ""),
+	}
+
+	modules := []SPDModule{
+		{Name: "0-0050", ManufacturerID: 0x2c80, SerialNumber: "1234AB0C", PartNumber: "36ASF4G72PZ-2G9E2"},
+		{Name: "0-0051", ManufacturerID: 0x2c80, SerialNumber: "0000BEEF", PartNumber: "M393A4K40DB3-CWE"},
+		{Name: "0-0052", ManufacturerID: 0x2c80, SerialNumber: "00000001", PartNumber: "36ASF4G72PZ-2G9E2"},
+		{Name: "0-0053", ManufacturerID: 0x2c80, SerialNumber: "00000002", PartNumber: "36ASF4G72PZ-2G9E2"},
+	}
+
+	wantChecks := []SPDCheck{
+		{
+			HandleMapping: HandleMapping{Handle: 0x11, SerialNumber: "1234AB0C"},
+			SPD:           &modules[0],
+		},
+		{
+			HandleMapping:      HandleMapping{Handle: 0x12, SerialNumber: "0x0000beef"},
+			SPD:                &modules[1],
+			PartNumberMismatch: true,
+		},
+		{
+			HandleMapping:        HandleMapping{Handle: 0x13, SerialNumber: "00000001"},
+			SPD:                  &modules[2],
+			ManufacturerMismatch: true,
+		},
+		{
+			HandleMapping: HandleMapping{Handle: 0x14, SerialNumber: "Unknown"},
+		},
+	}
+
+	checks, unmatched := CrossCheckSPD(mds, modules)
+
+	if diff := cmp.Diff(wantChecks, checks); diff != "" {
+		t.Fatalf("unexpected checks (-want +got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff(modules[3:], unmatched); diff != "" {
+		t.Fatalf("unexpected unmatched modules (-want +got):\n%s", diff)
+	}
+
+	for i, want := range []bool{true, false, false, false} {
+		if got := checks[i].OK(); want != got {
+			t.Fatalf("unexpected OK for check %d: %v", i, got)
+		}
+	}
+}
+
+func Test_readSPD(t *testing.T) {
+	root, err := ioutil.TempDir("", "go-smbios-spd")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	files := map[string][]byte{
+		"0-0051/eeprom": spd(0x2c80, []byte{0, 0, 0, 2}, "B"),
+		"0-0050/eeprom": spd(0x2c80, []byte{0, 0, 0, 1}, "A"),
+		// Unsupported DRAM device types are skipped.
+		"0-0052/eeprom": func() []byte {
+			b := spd(0x2c80, []byte{0, 0, 0, 3}, "C")
+			b[spdDeviceType] = 0x12
+			return b
+		}(),
+	}
+
+	for f, b := range files {
+		path := filepath.Join(root, f)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("failed to create directory: %v", err)
+		}
+		if err := ioutil.WriteFile(path, b, 0644); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+	}
+
+	modules, err := readSPD(root)
+	if err != nil {
+		t.Fatalf("failed to read SPD: %v", err)
+	}
+
+	want := []SPDModule{
+		{Name: "0-0050", ManufacturerID: 0x2c80, SerialNumber: "00000001", PartNumber: "A"},
+		{Name: "0-0051", ManufacturerID: 0x2c80, SerialNumber: "00000002", PartNumber: "B"},
+	}
+
+	if diff := cmp.Diff(want, modules); diff != "" {
+		t.Fatalf("unexpected SPD modules (-want +got):\n%s", diff)
+	}
+}
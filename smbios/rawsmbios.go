@@ -0,0 +1,77 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// smbiosDataHeaderSize is size of the "header" (non-variable) part of the
+// RawSMBIOSData struct. This serves as both the offset to the actual
+// SMBIOS table data, and the minimum possible size of a valid RawSMBIOSDATA
+// struct (with a table length of 0).
+const rawSMBIOSDataHeaderSize = 8
+
+// rawSMBIOSDataStream parses a RawSMBIOSData structure with the given byte
+// order and returns a stream and entrypoint that can be used to decode the
+// SMBIOS table it contains.  rawSMBIOSDataStream is used on Windows and in
+// tests with recorded Windows fixtures on other platforms.
+//
+// When calling GetSystemFirmwareTable with FirmwareTableProviderSignature = "RSMB",
+// Windows will write a RawSMBIOSData struct into the output buffer.
+// Thus, `buf` is expected to contain a valid RawSMBIOSData structure.
+//
+//	From windows.h:
+//
+//	struct RawSMBIOSData {
+//		BYTE 	Used20CallingMethod;
+//		BYTE	SMBIOSMajorVersion;
+//		BYTE 	SMBIOSMinorVersion;
+//		BYTE 	DMIRevision;
+//		DWORD 	Length;
+//		BYTE 	SMBIOSTableData[];
+//	}
+//
+// Note: a DWORD is equivalent to a uint32
+// See: https://msdn.microsoft.com/en-us/library/cc230318.aspx
+func rawSMBIOSDataStream(buf []byte, order binary.ByteOrder) (io.ReadCloser, EntryPoint, error) {
+	bufLen := uint32(len(buf))
+
+	// Do an additional check to make sure the actual amount written is sane.
+	if bufLen < rawSMBIOSDataHeaderSize {
+		return nil, nil, fmt.Errorf("GetSystemFirmwareTable wrote less data than expected: wrote %d bytes, expected at least 8 bytes", bufLen)
+	}
+
+	tableSize := order.Uint32(buf[4:8])
+	if rawSMBIOSDataHeaderSize+tableSize > bufLen {
+		return nil, nil, errors.New("reported SMBIOS table size exceeds buffer")
+	}
+
+	entryPoint := &WindowsEntryPoint{
+		MajorVersion: buf[1],
+		MinorVersion: buf[2],
+		Revision:     buf[3],
+		Size:         tableSize,
+	}
+
+	tableBuff := buf[rawSMBIOSDataHeaderSize : rawSMBIOSDataHeaderSize+tableSize]
+
+	return ioutil.NopCloser(bytes.NewReader(tableBuff)), entryPoint, nil
+}
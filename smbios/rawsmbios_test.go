@@ -16,6 +16,7 @@ package smbios
 
 import (
 	"bytes"
+	"encoding/binary"
 	"encoding/hex"
 	"io/ioutil"
 	"testing"
@@ -29,12 +30,12 @@ func makeRawSMBIOSData(major, minor, revision byte, stream []byte) []byte {
 	buffer[1] = major
 	buffer[2] = minor
 	buffer[3] = revision
-	nativeEndian().PutUint32(buffer[4:8], uint32(len(stream)))
+	binary.LittleEndian.PutUint32(buffer[4:8], uint32(len(stream)))
 	copy(buffer[8:], stream)
 	return buffer
 }
 
-func Test_windowsStream(t *testing.T) {
+func Test_rawSMBIOSDataStream(t *testing.T) {
 	const major = byte(2)
 	const minor = byte(4)
 	const revision = byte(1)
@@ -44,7 +45,7 @@ func Test_windowsStream(t *testing.T) {
 	// stream data in the struct definitions below for large test cases.
 	//
 	// Unlike in Test_memoryStream, we're not worrying about the actual decoding
-	// of the structures here. All we care about is whether or not windowsStream
+	// of the structures here. All we care about is whether or not rawSMBIOSDataStream
 	// gives us back the stream data we expect. Whether or not that is valid can
 	// be tested separately.
 	tests := []struct {
@@ -74,7 +75,7 @@ func Test_windowsStream(t *testing.T) {
 					0, 0, 0, 0, // length placeholder
 					1, 2, 3, 4, // stream
 				}
-				nativeEndian().PutUint32(buf[4:8], 5)
+				binary.LittleEndian.PutUint32(buf[4:8], 5)
 				return buf
 			}(),
 		},
@@ -118,7 +119,7 @@ func Test_windowsStream(t *testing.T) {
 		}
 
 		t.Run(tt.name, func(t *testing.T) {
-			rc, ep, err := windowsStream(tt.buffer)
+			rc, ep, err := rawSMBIOSDataStream(tt.buffer, binary.LittleEndian)
 
 			if tt.ok && err != nil {
 				t.Fatalf("unexpected error: %v", err)
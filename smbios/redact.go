@@ -0,0 +1,126 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"bytes"
+	"io"
+)
+
+// A RedactAction specifies how a Decoder handles Structures of a redacted
+// type.
+type RedactAction int
+
+// Possible RedactAction values.
+const (
+	// RedactDrop omits redacted Structures from the decoded output entirely.
+	RedactDrop RedactAction = iota
+
+	// RedactMask retains the Header of redacted Structures, but replaces
+	// their formatted data with zeros and omits their strings.
+	RedactMask
+)
+
+// WithRedaction configures a Decoder to apply action to all Structures of
+// the specified types, such as OEM Strings (Type 11) which may contain
+// secrets injected by a hypervisor.
+//
+// The formatted data and strings of redacted Structures are discarded as they
+// are read from the stream, and are never copied into decoded Structures.
+//
+// The End-of-table structure (Type 127) cannot be redacted.
+func WithRedaction(action RedactAction, types ...uint8) DecoderOption {
+	return func(d *Decoder) {
+		if d.redact == nil {
+			d.redact = make(map[uint8]RedactAction)
+		}
+
+		for _, t := range types {
+			if t == typeEndOfTable {
+				continue
+			}
+
+			d.redact[t] = action
+		}
+	}
+}
+
+// nextRedacted discards the remainder of a redacted Structure with Header h
+// from the stream.  If action is RedactDrop, it returns a nil Structure.
+func (d *Decoder) nextRedacted(h *Header, action RedactAction) (*Structure, error) {
+	// Guard against malformed input length.
+	l := int(h.Length) - headerLen
+	if l < 0 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	if _, err := d.br.Discard(l); err != nil {
+		return nil, err
+	}
+	d.n += l
+
+	if err := d.discardStrings(); err != nil {
+		return nil, err
+	}
+
+	if action == RedactDrop {
+		return nil, nil
+	}
+
+	var fb []byte
+	if l > 0 {
+		fb = make([]byte, l)
+	}
+
+	return &Structure{
+		Header:    *h,
+		Formatted: fb,
+	}, nil
+}
+
+// discardStrings discards a Structure's strings from the stream without
+// allocating them.
+func (d *Decoder) discardStrings() error {
+	term, err := d.br.Peek(2)
+	if err != nil {
+		return err
+	}
+
+	// If no string-set present, discard delimeter and end parsing.
+	if bytes.Equal(term, endStringSet) {
+		if _, err := d.br.Discard(2); err != nil {
+			return err
+		}
+		d.n += 2
+
+		return nil
+	}
+
+	// Strings are null-terminated, and two null bytes in a row indicate the
+	// end of the string-set.
+	prev := byte(0xff)
+	for {
+		c, err := d.br.ReadByte()
+		if err != nil {
+			return err
+		}
+		d.n++
+
+		if c == 0x00 && prev == 0x00 {
+			return nil
+		}
+		prev = c
+	}
+}
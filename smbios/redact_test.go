@@ -0,0 +1,142 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDecoderRedaction(t *testing.T) {
+	b := []byte{
+		// OEM strings with a secret.
+		11, 0x05, 0x01, 0x00,
+		0x02,
+		'i', 'd', 0x00,
+		's', 'e', 'c', 'r', 'e', 't', 0x00,
+		0x00,
+
+		// System information.
+		1, 0x05, 0x02, 0x00,
+		0x01,
+		'D', 'O', 0x00,
+		0x00,
+
+		// End of table.
+		127, 0x04, 0x03, 0x00,
+		0x00,
+		0x00,
+	}
+
+	system := &smbios.Structure{
+		Header: smbios.Header{
+			Type:   1,
+			Length: 5,
+			Handle: 0x0002,
+		},
+		Formatted: []byte{0x01},
+		Strings:   []string{"DO"},
+	}
+
+	eot := &smbios.Structure{
+		Header: smbios.Header{
+			Type:   127,
+			Length: 4,
+			Handle: 0x0003,
+		},
+	}
+
+	tests := []struct {
+		name    string
+		options []smbios.DecoderOption
+		ss      []*smbios.Structure
+	}{
+		{
+			name:    "drop",
+			options: []smbios.DecoderOption{smbios.WithRedaction(smbios.RedactDrop, 11)},
+			ss:      []*smbios.Structure{system, eot},
+		},
+		{
+			name:    "mask",
+			options: []smbios.DecoderOption{smbios.WithRedaction(smbios.RedactMask, 11)},
+			ss: []*smbios.Structure{
+				{
+					Header: smbios.Header{
+						Type:   11,
+						Length: 5,
+						Handle: 0x0001,
+					},
+					Formatted: []byte{0x00},
+				},
+				system,
+				eot,
+			},
+		},
+		{
+			name:    "end of table not dropped",
+			options: []smbios.DecoderOption{smbios.WithRedaction(smbios.RedactDrop, 1, 11, 127)},
+			ss:      []*smbios.Structure{eot},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := smbios.NewDecoder(bytes.NewReader(b), tt.options...)
+			ss, err := d.Decode()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.ss, ss); diff != "" {
+				t.Fatalf("unexpected structures (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDecoderRedactionMalformed(t *testing.T) {
+	tests := []struct {
+		name string
+		b    []byte
+	}{
+		{
+			name: "length too short",
+			b:    []byte{11, 0x00, 0x00, 0x00},
+		},
+		{
+			name: "length too long",
+			b:    []byte{11, 0xff, 0x00, 0x00},
+		},
+		{
+			name: "string not terminated",
+			b: []byte{
+				11, 0x04, 0x01, 0x00,
+				'a', 'b', 'c', 'd',
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := smbios.NewDecoder(bytes.NewReader(tt.b), smbios.WithRedaction(smbios.RedactDrop, 11))
+			if _, err := d.Decode(); err == nil {
+				t.Fatal("expected an error, but none occurred")
+			}
+		})
+	}
+}
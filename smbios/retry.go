@@ -0,0 +1,127 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"strings"
+	"time"
+)
+
+// A RetryPolicy configures how Stream retries failed attempts to acquire
+// SMBIOS data, such as transient failures of operating system APIs when a
+// system is under heavy load.
+type RetryPolicy struct {
+	// Attempts is the maximum number of attempts, including the first.
+	// Values less than 1 are treated as 1.
+	Attempts int
+
+	// Backoff is the delay before the second attempt, which doubles before
+	// each subsequent attempt up to MaxBackoff, if set.  A random jitter of
+	// up to half of each delay is subtracted so that many processes do not
+	// retry in lockstep.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+
+	// Retryable reports whether an error is transient and the attempt should
+	// be retried.  If nil, all errors are retried except those which
+	// indicate that the SMBIOS data does not exist or cannot be accessed due
+	// to insufficient permissions or disabled /dev/mem access.
+	Retryable func(err error) bool
+}
+
+// WithRetry enables retrying failed attempts to acquire SMBIOS data according
+// to a RetryPolicy.  If all attempts fail, Stream returns a *RetryError.
+// Retries are disabled by default.
+func WithRetry(p RetryPolicy) StreamOption {
+	return func(c *streamConfig) {
+		c.retry = &p
+	}
+}
+
+// A RetryError is returned by Stream when all attempts permitted by a
+// RetryPolicy fail, or an attempt fails with an error which is not retryable.
+type RetryError struct {
+	// Errors are the errors returned by each attempt, in order.
+	Errors []error
+}
+
+// Error implements error.
+func (e *RetryError) Error() string {
+	strs := make([]string, 0, len(e.Errors))
+	for i, err := range e.Errors {
+		strs = append(strs, fmt.Sprintf("attempt %d: %v", i+1, err))
+	}
+
+	return fmt.Sprintf("failed to acquire SMBIOS data after %d attempts: %s",
+		len(e.Errors), strings.Join(strs, "; "))
+}
+
+// Unwrap returns the error returned by the final attempt, so that the cause of
+// the failure can be inspected using errors.Is and errors.As.
+func (e *RetryError) Unwrap() error {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+
+	return e.Errors[len(e.Errors)-1]
+}
+
+// retryable is the default RetryPolicy.Retryable function.
+func retryable(err error) bool {
+	return !os.IsNotExist(err) && !os.IsPermission(err) && err != ErrDevMemDisabled
+}
+
+// retryStream calls open until it succeeds or the RetryPolicy p is exhausted,
+// using sleep to wait between attempts.
+func retryStream(
+	p RetryPolicy, sleep func(time.Duration),
+	open func() (io.ReadCloser, EntryPoint, string, error),
+) (io.ReadCloser, EntryPoint, string, error) {
+	if p.Attempts < 1 {
+		p.Attempts = 1
+	}
+	if p.Retryable == nil {
+		p.Retryable = retryable
+	}
+
+	var errs []error
+	backoff := p.Backoff
+	for i := 0; i < p.Attempts; i++ {
+		if i > 0 && backoff > 0 {
+			sleep(backoff - time.Duration(rand.Int63n(int64(backoff/2)+1)))
+
+			backoff *= 2
+			if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+				backoff = p.MaxBackoff
+			}
+		}
+
+		rc, ep, src, err := open()
+		if err == nil {
+			return rc, ep, src, nil
+		}
+
+		errs = append(errs, err)
+		if !p.Retryable(err) {
+			break
+		}
+	}
+
+	return nil, nil, "", &RetryError{Errors: errs}
+}
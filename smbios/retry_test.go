@@ -0,0 +1,127 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_retryStream(t *testing.T) {
+	errBusy := errors.New("procedure busy")
+
+	tests := []struct {
+		name     string
+		p        RetryPolicy
+		errs     []error
+		attempts int
+		ok       bool
+	}{
+		{
+			name:     "zero policy",
+			errs:     []error{errBusy},
+			attempts: 1,
+		},
+		{
+			name:     "OK after retries",
+			p:        RetryPolicy{Attempts: 5, Backoff: 100 * time.Millisecond, MaxBackoff: 300 * time.Millisecond},
+			errs:     []error{errBusy, errBusy, errBusy, errBusy},
+			attempts: 5,
+			ok:       true,
+		},
+		{
+			name:     "exhausted",
+			p:        RetryPolicy{Attempts: 3, Backoff: time.Millisecond},
+			errs:     []error{errBusy, errBusy, errBusy},
+			attempts: 3,
+		},
+		{
+			name:     "not retryable",
+			p:        RetryPolicy{Attempts: 3, Backoff: time.Millisecond},
+			errs:     []error{os.ErrNotExist},
+			attempts: 1,
+		},
+		{
+			name: "custom retryable",
+			p: RetryPolicy{
+				Attempts: 3,
+				Retryable: func(err error) bool {
+					return err != errBusy
+				},
+			},
+			errs:     []error{errBusy},
+			attempts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				attempts int
+				sleeps   []time.Duration
+			)
+
+			sleep := func(d time.Duration) {
+				sleeps = append(sleeps, d)
+			}
+
+			open := func() (io.ReadCloser, EntryPoint, string, error) {
+				attempts++
+				if attempts <= len(tt.errs) {
+					return nil, nil, "", tt.errs[attempts-1]
+				}
+
+				return ioutil.NopCloser(strings.NewReader("")), &EntryPoint64Bit{}, "test", nil
+			}
+
+			_, _, _, err := retryStream(tt.p, sleep, open)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok {
+				want := &RetryError{Errors: tt.errs[:tt.attempts]}
+				if diff := cmp.Diff(want.Error(), err.Error()); diff != "" {
+					t.Fatalf("unexpected error (-want +got):\n%s", diff)
+				}
+			}
+
+			if diff := cmp.Diff(tt.attempts, attempts); diff != "" {
+				t.Fatalf("unexpected number of attempts (-want +got):\n%s", diff)
+			}
+
+			// Each delay is jittered downward by at most half, and capped
+			// at the maximum backoff.
+			backoff := tt.p.Backoff
+			for i, d := range sleeps {
+				if d > backoff || d < backoff/2 {
+					t.Fatalf("unexpected delay %d: %v not in [%v, %v]", i, d, backoff/2, backoff)
+				}
+
+				backoff *= 2
+				if tt.p.MaxBackoff > 0 && backoff > tt.p.MaxBackoff {
+					backoff = tt.p.MaxBackoff
+				}
+			}
+		})
+	}
+}
@@ -12,17 +12,11 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-//+build !dragonfly,!freebsd,!linux,!netbsd,!openbsd,!solaris,!windows
+//go:build safe
+// +build safe
 
 package smbios
 
-import (
-	"fmt"
-	"io"
-	"runtime"
-)
-
-// stream is not implemented for unsupported platforms.
-func stream() (io.ReadCloser, EntryPoint, error) {
-	return nil, nil, fmt.Errorf("opening SMBIOS stream not implemented on %q", runtime.GOOS)
-}
+// safeBuild reports whether the safe build tag is set.  See the package
+// documentation for details.
+const safeBuild = true
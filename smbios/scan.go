@@ -0,0 +1,154 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"bytes"
+	"io"
+)
+
+// An EntryPointPreference selects between multiple SMBIOS entry points found
+// while scanning memory, such as when firmware provides both 32-bit and
+// 64-bit entry points.
+type EntryPointPreference int
+
+// Possible EntryPointPreference values.
+const (
+	// PreferFirst selects the first entry point found in memory.  This is
+	// the default.
+	PreferFirst EntryPointPreference = iota
+
+	// Prefer32Bit selects a 32-bit (_SM_) entry point if one is found.
+	Prefer32Bit
+
+	// Prefer64Bit selects a 64-bit (_SM3_) entry point if one is found.
+	Prefer64Bit
+)
+
+// WithEntryPointPreference configures which entry point Stream selects when
+// scanning system memory finds more than one.  If no entry point matches the
+// preference, the first one found is used.
+//
+// The preference only applies when Stream scans system memory, such as
+// /dev/mem.  Other sources, such as Linux sysfs, provide a single entry point
+// chosen by the operating system.
+func WithEntryPointPreference(p EntryPointPreference) StreamOption {
+	return func(c *streamConfig) {
+		c.preference = p
+	}
+}
+
+// matches reports whether ep satisfies the preference.
+func (p EntryPointPreference) matches(ep EntryPoint) bool {
+	switch p {
+	case Prefer32Bit:
+		_, ok := ep.(*EntryPoint32Bit)
+		return ok
+	case Prefer64Bit:
+		_, ok := ep.(*EntryPoint64Bit)
+		return ok
+	default:
+		return true
+	}
+}
+
+// An EntryPointCandidate is an SMBIOS entry point found while scanning memory.
+type EntryPointCandidate struct {
+	// Address is the physical memory address of the entry point.
+	Address    int64
+	EntryPoint EntryPoint
+}
+
+// ScanEntryPoints returns all valid SMBIOS entry points found in the legacy
+// BIOS memory area of an AddrSpace, in address order.  Anchor strings which
+// do not begin a valid entry point are skipped.
+//
+// An *os.File for /dev/mem may be used as the AddrSpace, and a candidate's
+// structure stream read using StreamFromEntryPoint.
+func ScanEntryPoints(as AddrSpace) ([]EntryPointCandidate, error) {
+	return scanEntryPoints(as, startAddr, endAddr)
+}
+
+// StreamFromEntryPoint reads the SMBIOS structure stream referred to by ep
+// from physical memory in an AddrSpace.
+//
+// The returned stream is always detached.
+func StreamFromEntryPoint(as AddrSpace, ep EntryPoint) (io.ReadCloser, error) {
+	rc, err := readTable(as, ep)
+	if err != nil {
+		return nil, err
+	}
+
+	return &opaqueReadCloser{
+		rc:       rc,
+		detached: true,
+	}, nil
+}
+
+// preferredStream reads the SMBIOS structure stream referred to by the entry
+// point in an AddrSpace which best matches p.
+func preferredStream(as AddrSpace, p EntryPointPreference) (io.ReadCloser, EntryPoint, error) {
+	cs, err := scanEntryPoints(as, startAddr, endAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(cs) == 0 {
+		return nil, nil, ErrEntryPointNotFound
+	}
+
+	ep := cs[0].EntryPoint
+	for _, c := range cs {
+		if p.matches(c.EntryPoint) {
+			ep = c.EntryPoint
+			break
+		}
+	}
+
+	rc, err := readTable(as, ep)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rc, ep, nil
+}
+
+// scanEntryPoints finds all valid entry points between the start and end
+// bound in an AddrSpace.
+func scanEntryPoints(as AddrSpace, start, end int) ([]EntryPointCandidate, error) {
+	b := make([]byte, paragraph)
+
+	var cs []EntryPointCandidate
+	for addr := start; addr < end; addr += paragraph {
+		if _, err := as.ReadAt(b, int64(addr)); err != nil {
+			return nil, err
+		}
+
+		if !bytes.HasPrefix(b, magicPrefix) {
+			continue
+		}
+
+		ep, err := ParseEntryPoint(io.NewSectionReader(as, int64(addr), 64))
+		if err != nil {
+			continue
+		}
+
+		cs = append(cs, EntryPointCandidate{
+			Address:    int64(addr),
+			EntryPoint: ep,
+		})
+	}
+
+	return cs, nil
+}
@@ -0,0 +1,125 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_preferredStream(t *testing.T) {
+	// table builds a structure table containing a single string.
+	table := func(s string) []byte {
+		b := []byte{0x01, 0x05, 0x01, 0x00, 0x01}
+		b = append(b, s...)
+		return append(b, 0x00, 0x00, 127, 0x04, 0x02, 0x00, 0x00, 0x00)
+	}
+
+	const (
+		addr32 = 0x1000
+		addr64 = 0x2000
+	)
+
+	t32, t64 := table("32-bit"), table("64-bit")
+
+	ep32 := &EntryPoint32Bit{Major: 2, Minor: 8, StructureTableAddress: addr32}
+	ep64 := &EntryPoint64Bit{Major: 3, Minor: 0, StructureTableAddress: addr64}
+
+	b := make([]byte, endAddr+1)
+	for _, x := range []struct {
+		ep interface {
+			MarshalBinary() ([]byte, error)
+			Recalculate(table []byte) error
+		}
+		addr  int
+		table []byte
+	}{
+		{ep: ep32, addr: startAddr + 0x100, table: t32},
+		{ep: ep64, addr: startAddr + 0x200, table: t64},
+	} {
+		if err := x.ep.Recalculate(x.table); err != nil {
+			t.Fatalf("failed to recalculate entry point: %v", err)
+		}
+
+		epb, err := x.ep.MarshalBinary()
+		if err != nil {
+			t.Fatalf("failed to marshal entry point: %v", err)
+		}
+
+		copy(b[x.addr:], epb)
+	}
+
+	// An anchor string which does not begin a valid entry point is skipped.
+	copy(b[startAddr:], "_SM_")
+	copy(b[addr32:], t32)
+	copy(b[addr64:], t64)
+
+	cs, err := ScanEntryPoints(bytes.NewReader(b))
+	if err != nil {
+		t.Fatalf("failed to scan entry points: %v", err)
+	}
+
+	want := []EntryPointCandidate{
+		{Address: startAddr + 0x100, EntryPoint: ep32},
+		{Address: startAddr + 0x200, EntryPoint: ep64},
+	}
+
+	if diff := cmp.Diff(want, cs); diff != "" {
+		t.Fatalf("unexpected candidates (-want +got):\n%s", diff)
+	}
+
+	tests := []struct {
+		name string
+		p    EntryPointPreference
+		s    string
+	}{
+		{
+			name: "first",
+			p:    PreferFirst,
+			s:    "32-bit",
+		},
+		{
+			name: "32-bit",
+			p:    Prefer32Bit,
+			s:    "32-bit",
+		},
+		{
+			name: "64-bit",
+			p:    Prefer64Bit,
+			s:    "64-bit",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rc, _, err := preferredStream(bytes.NewReader(b), tt.p)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer rc.Close()
+
+			ss, err := NewDecoder(rc).Decode()
+			if err != nil {
+				t.Fatalf("failed to decode structures: %v", err)
+			}
+
+			if diff := cmp.Diff([]string{tt.s}, ss[0].Strings); diff != "" {
+				t.Fatalf("unexpected strings (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
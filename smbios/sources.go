@@ -0,0 +1,107 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"fmt"
+
+	"github.com/digitalocean/go-smbios/internal/platform"
+)
+
+// A SourceKind is a method of acquiring SMBIOS data, for use with
+// WithSources.
+type SourceKind int
+
+// Possible SourceKind values.
+const (
+	// SysfsSource reads the entry point and structure table from files
+	// exported by the operating system, such as Linux sysfs.
+	SysfsSource SourceKind = iota
+
+	// DevMemSource searches physical memory for the entry point and
+	// structure table using a memory device such as /dev/mem.
+	DevMemSource
+
+	// FirmwareTableSource reads the structure table using an operating
+	// system API, such as GetSystemFirmwareTable on Windows.
+	FirmwareTableSource
+)
+
+// String returns the name of a SourceKind.
+func (k SourceKind) String() string {
+	switch k {
+	case SysfsSource:
+		return "sysfs"
+	case DevMemSource:
+		return "devmem"
+	case FirmwareTableSource:
+		return "firmware table"
+	default:
+		return fmt.Sprintf("SourceKind(%d)", int(k))
+	}
+}
+
+// platformKind returns the platform.Kind which corresponds to k.
+func (k SourceKind) platformKind() (platform.Kind, bool) {
+	switch k {
+	case SysfsSource:
+		return platform.Files, true
+	case DevMemSource:
+		return platform.Memory, true
+	case FirmwareTableSource:
+		return platform.FirmwareTable, true
+	default:
+		return 0, false
+	}
+}
+
+// WithSources configures Stream to try only the specified kinds of sources,
+// in the specified order, rather than the default order for the current
+// platform.  For example, WithSources(DevMemSource) forces Stream to read
+// /dev/mem on a system whose kernel exports bad SMBIOS data through sysfs.
+//
+// Kinds which the current platform does not provide are ignored, and Stream
+// returns an error which wraps ErrUnsupported if none remain.  WithVerification
+// only cross-checks sysfs data if DevMemSource is specified after SysfsSource.
+// WithoutDevMem takes precedence over DevMemSource.
+func WithSources(kinds ...SourceKind) StreamOption {
+	return func(c *streamConfig) {
+		c.sources = kinds
+		c.selectSources = true
+	}
+}
+
+// orderSources filters and reorders srcs as configured by WithSources.
+func (c *streamConfig) orderSources(srcs []platform.Source) []platform.Source {
+	if !c.selectSources {
+		return srcs
+	}
+
+	var out []platform.Source
+	for _, k := range c.sources {
+		pk, ok := k.platformKind()
+		if !ok {
+			continue
+		}
+
+		for _, src := range srcs {
+			if src.Kind == pk {
+				out = append(out, src)
+			}
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,153 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/internal/platform"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWithSources(t *testing.T) {
+	linux := filepath.Join("testdata", "fixtures", "linux")
+	sysfs := platform.Source{
+		Kind:       platform.Files,
+		Name:       filepath.Join(linux, "DMI"),
+		EntryPoint: filepath.Join(linux, "smbios_entry_point"),
+	}
+	mem := platform.Source{Kind: platform.Memory, Name: "/dev/mem"}
+
+	tests := []struct {
+		name    string
+		options []StreamOption
+		srcs    []platform.Source
+	}{
+		{
+			name: "default",
+			srcs: []platform.Source{sysfs, mem},
+		},
+		{
+			name:    "reordered",
+			options: []StreamOption{WithSources(DevMemSource, SysfsSource)},
+			srcs:    []platform.Source{mem, sysfs},
+		},
+		{
+			name:    "devmem only",
+			options: []StreamOption{WithSources(DevMemSource)},
+			srcs:    []platform.Source{mem},
+		},
+		{
+			name:    "not provided",
+			options: []StreamOption{WithSources(FirmwareTableSource, SysfsSource)},
+			srcs:    []platform.Source{sysfs},
+		},
+		{
+			name:    "none",
+			options: []StreamOption{WithSources()},
+		},
+		{
+			name:    "unknown",
+			options: []StreamOption{WithSources(SourceKind(-1))},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newStreamConfig(tt.options)
+
+			srcs := c.orderSources([]platform.Source{sysfs, mem})
+			if diff := cmp.Diff(tt.srcs, srcs); diff != "" {
+				t.Fatalf("unexpected sources (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_streamWithSources(t *testing.T) {
+	linux := filepath.Join("testdata", "fixtures", "linux")
+	sysfs := platform.Source{
+		Kind:       platform.Files,
+		Name:       filepath.Join(linux, "DMI"),
+		EntryPoint: filepath.Join(linux, "smbios_entry_point"),
+	}
+	mem := platform.Source{Kind: platform.Memory, Name: "/dev/mem"}
+
+	// Ensure /dev/mem is never opened by Stream.
+	defer os.Setenv(envNoDevMem, os.Getenv(envNoDevMem))
+	if err := os.Setenv(envNoDevMem, "1"); err != nil {
+		t.Fatalf("failed to set environment: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		options []StreamOption
+		source  string
+		err     error
+	}{
+		{
+			name:   "default",
+			source: sysfs.Name,
+		},
+		{
+			name:    "skip sysfs",
+			options: []StreamOption{WithSources(DevMemSource)},
+			source:  mem.Name,
+			err:     ErrDevMemDisabled,
+		},
+		{
+			name:    "none",
+			options: []StreamOption{WithSources(FirmwareTableSource)},
+			err:     ErrUnsupported,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newStreamConfig(tt.options)
+			c.platform = &fakePlatform{sources: []platform.Source{sysfs, mem}}
+
+			rc, _, source, err := stream(c)
+			if tt.err == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.err != nil && !errors.Is(err, tt.err) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err == nil {
+				_ = rc.Close()
+			}
+
+			if diff := cmp.Diff(tt.source, source); diff != "" {
+				t.Fatalf("unexpected source (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSourceKindString(t *testing.T) {
+	var got []string
+	for _, k := range []SourceKind{SysfsSource, DevMemSource, FirmwareTableSource, 10} {
+		got = append(got, k.String())
+	}
+
+	want := []string{"sysfs", "devmem", "firmware table", "SourceKind(10)"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected strings (-want +got):\n%s", diff)
+	}
+}
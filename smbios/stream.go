@@ -0,0 +1,122 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/digitalocean/go-smbios/internal/platform"
+)
+
+// stream opens the SMBIOS entry point and an SMBIOS structure stream from the
+// first available source on c's platform.
+func stream(c *streamConfig) (io.ReadCloser, EntryPoint, string, error) {
+	srcs, err := c.platform.Sources()
+	if err != nil {
+		return nil, nil, "", err
+	}
+	srcs = c.orderSources(srcs)
+
+	for i, src := range srcs {
+		switch src.Kind {
+		case platform.Files:
+			// Fall back to the next source if these files are not present,
+			// such as sysfs on older Linux kernels.
+			_, err := os.Stat(src.EntryPoint)
+			if os.IsNotExist(err) && i+1 < len(srcs) {
+				continue
+			}
+			if err != nil {
+				return nil, nil, "", err
+			}
+
+			rc, ep, err := sysfsStream(src.EntryPoint, src.Name)
+			if err != nil || !c.verify {
+				return rc, ep, src.Name, err
+			}
+
+			// Cross-check the files against system memory, if possible.
+			mem, ok := nextMemorySource(srcs[i+1:])
+			if !ok {
+				return rc, ep, src.Name, nil
+			}
+
+			rc, err = verifyStream(rc, ep, src.Name, func() (io.ReadCloser, EntryPoint, error) {
+				return devMemStream(c, mem.Name)
+			}, mem.Name)
+			return rc, ep, src.Name, err
+		case platform.Memory:
+			rc, ep, err := devMemStream(c, src.Name)
+			return rc, ep, src.Name, err
+		case platform.FirmwareTable:
+			buf, order, err := c.platform.FirmwareTable()
+			if err != nil {
+				return nil, nil, src.Name, err
+			}
+
+			rc, ep, err := rawSMBIOSDataStream(buf, order)
+			return rc, ep, src.Name, err
+		}
+	}
+
+	return nil, nil, "", fmt.Errorf("no SMBIOS sources found: %w", ErrUnsupported)
+}
+
+// available checks for SMBIOS data in the locations used by stream.
+func available(p platform.Platform) SourceInfo {
+	srcs, err := p.Sources()
+	if err != nil {
+		return SourceInfo{Err: err}
+	}
+
+	for i, src := range srcs {
+		switch src.Kind {
+		case platform.Files:
+			_, err := os.Stat(src.EntryPoint)
+			if os.IsNotExist(err) && i+1 < len(srcs) {
+				continue
+			}
+			if err != nil {
+				return SourceInfo{Source: src.Name, Err: err}
+			}
+
+			return checkOpen(src.Name)
+		case platform.Memory:
+			return checkDevMem(src.Name)
+		case platform.FirmwareTable:
+			// Only query the size, to avoid reading the table.
+			if _, err := p.FirmwareTableSize(); err != nil {
+				return SourceInfo{Source: src.Name, Err: err}
+			}
+
+			return SourceInfo{Source: src.Name}
+		}
+	}
+
+	return SourceInfo{Err: fmt.Errorf("no SMBIOS sources found: %w", ErrUnsupported)}
+}
+
+// nextMemorySource returns the first Memory source in srcs, if any.
+func nextMemorySource(srcs []platform.Source) (platform.Source, bool) {
+	for _, src := range srcs {
+		if src.Kind == platform.Memory {
+			return src, true
+		}
+	}
+
+	return platform.Source{}, false
+}
@@ -15,6 +15,7 @@
 package smbios_test
 
 import (
+	"bytes"
 	"os"
 	"runtime"
 	"testing"
@@ -81,3 +82,52 @@ func TestStreamIntegration(t *testing.T) {
 		t.Fatal("did not find end of table")
 	}
 }
+
+func TestStreamBytesIntegration(t *testing.T) {
+	if goos := runtime.GOOS; goos != "linux" {
+		t.Skipf("skipping on non-Linux platform: %q", goos)
+	}
+
+	b, _, err := smbios.StreamBytes()
+	if err != nil {
+		if os.IsPermission(err) {
+			t.Skipf("skipping, permission denied while reading SMBIOS stream: %v", err)
+		}
+
+		return
+	}
+
+	// The same bytes should be decodable more than once.
+	for i := 0; i < 2; i++ {
+		if _, err := smbios.NewDecoder(bytes.NewReader(b)).Decode(); err != nil {
+			t.Fatalf("failed to decode structures, pass %d: %v", i, err)
+		}
+	}
+}
+
+func TestAvailableIntegration(t *testing.T) {
+	if goos := runtime.GOOS; goos != "linux" {
+		t.Skipf("skipping on non-Linux platform: %q", goos)
+	}
+
+	ok, info := smbios.Available()
+	t.Logf("available: %v, source: %q, error: %v", ok, info.Source, info.Err)
+
+	if ok != (info.Err == nil) {
+		t.Fatalf("availability inconsistent with error: %v", info.Err)
+	}
+	if info.Source == "" {
+		t.Fatal("expected a source on Linux")
+	}
+
+	// If SMBIOS data is available, Stream must be able to open it.
+	if !ok {
+		return
+	}
+
+	rc, _, err := smbios.Stream()
+	if err != nil {
+		t.Fatalf("failed to open available stream: %v", err)
+	}
+	_ = rc.Close()
+}
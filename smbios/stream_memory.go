@@ -23,65 +23,114 @@ import (
 )
 
 const (
-	// devMem is the UNIX-like system memory device location used to
-	// find SMBIOS information.
-	devMem = "/dev/mem"
+	// envNoDevMem is an environment variable which disables /dev/mem access
+	// when set to a non-empty value.
+	envNoDevMem = "GO_SMBIOS_NO_DEVMEM"
 
 	// SMBIOS specification indicates that the entry point should exist
 	// between these two memory addresses.
 	startAddr = 0x000f0000
 	endAddr   = 0x000fffff
+
+	// Entry points are aligned on 16-byte "paragraph" boundaries.
+	paragraph = 16
 )
 
+// An AddressTranslator translates a physical memory address to an offset in
+// an io.ReadSeeker, such as a captured memory image which does not store
+// physical memory at identical offsets.
+type AddressTranslator func(addr int64) (offset int64, err error)
+
+// StreamFromMemory locates and reads the SMBIOS entry point and structure
+// stream from an io.ReadSeeker containing physical memory, such as a captured
+// memory image.
+//
+// If translate is nil, offsets in rs are assumed to be identical to physical
+// addresses, as they are in /dev/mem.  Otherwise, translate is used to locate
+// both the entry point search range and the structure table, which is useful
+// when the entry point's table address is not a valid offset in rs.
+//
+// The returned stream is always detached.
+func StreamFromMemory(rs io.ReadSeeker, translate AddressTranslator) (io.ReadCloser, EntryPoint, error) {
+	if translate == nil {
+		translate = func(addr int64) (int64, error) {
+			return addr, nil
+		}
+	}
+
+	return StreamFromAddrSpace(&translatedAddrSpace{
+		rs:        rs,
+		translate: translate,
+	})
+}
+
+// StreamFromAddrSpace locates and reads the SMBIOS entry point and structure
+// stream from physical memory in an AddrSpace, such as a full-memory capture
+// taken for post-mortem analysis.
+//
+// The returned stream is always detached.
+func StreamFromAddrSpace(as AddrSpace) (io.ReadCloser, EntryPoint, error) {
+	rc, ep, err := memoryStream(as, startAddr, endAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &opaqueReadCloser{
+		rc:       rc,
+		detached: true,
+	}, ep, nil
+}
+
 // memoryStream reads the SMBIOS entry point and structure stream from
-// an io.ReadSeeker (usually system memory).
+// an AddrSpace (usually system memory).
 //
 // memoryStream is an entry point for tests.
-func memoryStream(rs io.ReadSeeker, startAddr, endAddr int) (io.ReadCloser, EntryPoint, error) {
+func memoryStream(as AddrSpace, startAddr, endAddr int) (io.ReadCloser, EntryPoint, error) {
 	// Try to find the entry point.
-	addr, err := findEntryPoint(rs, startAddr, endAddr)
+	addr, err := findEntryPoint(as, startAddr, endAddr)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	// Found it; seek to the location of the entry point.
-	if _, err := rs.Seek(int64(addr), io.SeekStart); err != nil {
-		return nil, nil, err
-	}
+	return tableAt(as, int64(addr))
+}
 
-	// Read the entry point and determine where the SMBIOS table is.
-	ep, err := ParseEntryPoint(rs)
+// tableAt reads the SMBIOS entry point at addr and the structure stream it
+// points to from an AddrSpace.
+func tableAt(as AddrSpace, addr int64) (io.ReadCloser, EntryPoint, error) {
+	// Read the entry point and determine where the SMBIOS table is.  Entry
+	// points are no larger than 64 bytes.
+	ep, err := ParseEntryPoint(io.NewSectionReader(as, addr, 64))
 	if err != nil {
 		return nil, nil, err
 	}
 
-	// Seek to the start of the SMBIOS table.
-	tableAddr, tableSize := ep.Table()
-	if _, err := rs.Seek(int64(tableAddr), io.SeekStart); err != nil {
+	rc, err := readTable(as, ep)
+	if err != nil {
 		return nil, nil, err
 	}
 
+	return rc, ep, nil
+}
+
+// readTable reads the structure stream pointed to by ep from an AddrSpace.
+func readTable(as AddrSpace, ep EntryPoint) (io.ReadCloser, error) {
 	// Make a copy of the memory so we don't return a handle to system memory
 	// to the caller.
+	tableAddr, tableSize := ep.Table()
 	out := make([]byte, tableSize)
-	if _, err := io.ReadFull(rs, out); err != nil {
-		return nil, nil, err
+	if _, err := io.ReadFull(io.NewSectionReader(as, int64(tableAddr), int64(tableSize)), out); err != nil {
+		return nil, err
 	}
 
-	return ioutil.NopCloser(bytes.NewReader(out)), ep, nil
+	return ioutil.NopCloser(bytes.NewReader(out)), nil
 }
 
-// findEntryPoint attempts to locate the entry point structure in the io.ReadSeeker
+// findEntryPoint attempts to locate the entry point structure in the AddrSpace
 // using the start and end bound as hints for its location.
-func findEntryPoint(rs io.ReadSeeker, start, end int) (int, error) {
-	// Begin searching at the start bound.
-	if _, err := rs.Seek(int64(start), io.SeekStart); err != nil {
-		return 0, err
-	}
-
+func findEntryPoint(as AddrSpace, start, end int) (int, error) {
 	// Iterate one "paragraph" of memory at a time until we either find the entry point
 	// or reach the end bound.
-	const paragraph = 16
 	b := make([]byte, paragraph)
 
 	var (
@@ -90,7 +139,7 @@ func findEntryPoint(rs io.ReadSeeker, start, end int) (int, error) {
 	)
 
 	for addr = start; addr < end; addr += paragraph {
-		if _, err := io.ReadFull(rs, b); err != nil {
+		if _, err := as.ReadAt(b, int64(addr)); err != nil {
 			return 0, err
 		}
 
@@ -102,24 +151,69 @@ func findEntryPoint(rs io.ReadSeeker, start, end int) (int, error) {
 	}
 
 	if !found {
-		return 0, errors.New("no SMBIOS entry point found in memory")
+		return 0, ErrEntryPointNotFound
 	}
 
 	// Return the exact memory location of the entry point.
 	return addr, nil
 }
 
-// devMemStream reads the SMBIOS entry point and structure stream from
-// the UNIX-like system /dev/mem device.
+// ErrEntryPointNotFound is returned when no SMBIOS entry point is found in
+// memory.
+var ErrEntryPointNotFound = errors.New("no SMBIOS entry point found in memory")
+
+// ErrDevMemDisabled is returned when SMBIOS data can only be read from
+// /dev/mem, but /dev/mem access is disabled.
+var ErrDevMemDisabled = errors.New("SMBIOS /dev/mem access is disabled")
+
+// WithoutDevMem guarantees that Stream never opens /dev/mem, for deployments
+// in which reading physical memory is not permitted.  SMBIOS data is read only
+// from operating system-provided files and APIs, such as Linux sysfs, and
+// Stream returns ErrDevMemDisabled if no such source exists.  Verification
+// using WithVerification is skipped.
 //
-// This is UNIX-like system specific, but since it doesn't employ any system
-// calls or OS-dependent constants, it remains in this file for simplicity.
-func devMemStream() (io.ReadCloser, EntryPoint, error) {
-	mem, err := os.Open(devMem)
+// /dev/mem access can also be disabled for Stream and Available throughout a
+// process by setting the GO_SMBIOS_NO_DEVMEM environment variable to a
+// non-empty value.
+func WithoutDevMem() StreamOption {
+	return func(c *streamConfig) {
+		c.noDevMem = true
+	}
+}
+
+// devMemDisabled reports whether /dev/mem access is disabled by the safe build
+// tag or the environment.
+func devMemDisabled() bool {
+	return safeBuild || os.Getenv(envNoDevMem) != ""
+}
+
+// checkDevMem checks whether a memory device such as /dev/mem can be opened
+// for reading, unless /dev/mem access is disabled.
+func checkDevMem(file string) SourceInfo {
+	if devMemDisabled() {
+		return SourceInfo{Source: file, Err: ErrDevMemDisabled}
+	}
+
+	return checkOpen(file)
+}
+
+// devMemStream reads the SMBIOS entry point and structure stream from a
+// UNIX-like system memory device such as /dev/mem, unless /dev/mem access is
+// disabled.
+func devMemStream(c *streamConfig, file string) (io.ReadCloser, EntryPoint, error) {
+	if safeBuild || c.noDevMem {
+		return nil, nil, ErrDevMemDisabled
+	}
+
+	mem, err := os.Open(file)
 	if err != nil {
 		return nil, nil, err
 	}
 	defer mem.Close()
 
-	return memoryStream(mem, startAddr, endAddr)
+	if c.preference == PreferFirst {
+		return memoryStream(mem, startAddr, endAddr)
+	}
+
+	return preferredStream(mem, c.preference)
 }
@@ -19,6 +19,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"math"
+	"os"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -157,6 +158,76 @@ func Test_memoryStream(t *testing.T) {
 	}
 }
 
+func TestStreamFromMemory(t *testing.T) {
+	table := []byte{
+		0x01, 0x05, 0x01, 0x00,
+		0x01,
+		's', 'e', 'r', 'i', 'a', 'l', 0x00,
+		0x00,
+
+		127, 0x04, 0x02, 0x00,
+		0x00,
+		0x00,
+	}
+
+	// The captured image stores the physical memory range starting at base
+	// after a file header.
+	const (
+		base   = 0xe0000
+		header = 0x200
+	)
+
+	ep := &EntryPoint32Bit{
+		Major:                 2,
+		Minor:                 8,
+		StructureTableAddress: base,
+	}
+	if err := ep.Recalculate(table); err != nil {
+		t.Fatalf("failed to recalculate entry point: %v", err)
+	}
+
+	epb, err := ep.MarshalBinary()
+	if err != nil {
+		t.Fatalf("failed to marshal entry point: %v", err)
+	}
+
+	b := make([]byte, header+endAddr+1-base)
+	copy(b[header:], table)
+	copy(b[header+startAddr-base:], epb)
+
+	translate := func(addr int64) (int64, error) {
+		if addr < base || addr > endAddr {
+			return 0, fmt.Errorf("address %#x not captured", addr)
+		}
+
+		return addr - base + header, nil
+	}
+
+	// Without translation, the entry point cannot be found.
+	if _, _, err := StreamFromMemory(bytes.NewReader(b), nil); err == nil {
+		t.Fatal("expected an error, but none occurred")
+	}
+
+	rc, _, err := StreamFromMemory(bytes.NewReader(b), translate)
+	if err != nil {
+		t.Fatalf("failed to open stream: %v", err)
+	}
+	defer rc.Close()
+
+	if !Detached(rc) {
+		t.Fatal("stream should be detached")
+	}
+
+	ss, err := NewDecoder(rc).Decode()
+	if err != nil {
+		t.Fatalf("failed to decode structures: %v", err)
+	}
+
+	if diff := cmp.Diff([]string{"serial"}, ss[0].Strings); diff != "" {
+		t.Fatalf("unexpected strings (-want +got):\n%s", diff)
+	}
+}
+
 // Memory addresses used to start and stop searching for entry points.
 const (
 	start = 0x0010
@@ -212,3 +283,63 @@ func marshal64(ep *EntryPoint64Bit) []byte {
 
 	return b
 }
+
+func TestWithoutDevMem(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     string
+		options []StreamOption
+		ok      bool
+	}{
+		{
+			name: "enabled",
+			ok:   true,
+		},
+		{
+			name:    "option",
+			options: []StreamOption{WithoutDevMem()},
+		},
+		{
+			name: "environment",
+			env:  "1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer os.Setenv(envNoDevMem, os.Getenv(envNoDevMem))
+			if err := os.Setenv(envNoDevMem, tt.env); err != nil {
+				t.Fatalf("failed to set environment: %v", err)
+			}
+
+			c := newStreamConfig(tt.options)
+			// The safe build tag always disables /dev/mem access.
+			if diff := cmp.Diff(!tt.ok || safeBuild, c.noDevMem); diff != "" {
+				t.Fatalf("unexpected /dev/mem configuration (-want +got):\n%s", diff)
+			}
+
+			if !c.noDevMem {
+				return
+			}
+
+			if _, _, err := devMemStream(c, "/dev/mem"); err != ErrDevMemDisabled {
+				t.Fatalf("expected /dev/mem disabled error, but got: %v", err)
+			}
+
+			if retryable(ErrDevMemDisabled) {
+				t.Fatal("expected /dev/mem disabled error not to be retryable")
+			}
+		})
+	}
+}
+
+func Test_checkDevMemDisabled(t *testing.T) {
+	defer os.Setenv(envNoDevMem, os.Getenv(envNoDevMem))
+	if err := os.Setenv(envNoDevMem, "1"); err != nil {
+		t.Fatalf("failed to set environment: %v", err)
+	}
+
+	if info := checkDevMem("/dev/mem"); info.Err != ErrDevMemDisabled || info.Source != "/dev/mem" {
+		t.Fatalf("expected /dev/mem to be disabled, but got: %+v", info)
+	}
+}
@@ -12,8 +12,6 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-//+build linux
-
 package smbios
 
 import (
@@ -21,27 +19,6 @@ import (
 	"os"
 )
 
-const (
-	// sysfs locations for SMBIOS information.
-	sysfsDMI        = "/sys/firmware/dmi/tables/DMI"
-	sysfsEntryPoint = "/sys/firmware/dmi/tables/smbios_entry_point"
-)
-
-// stream opens the SMBIOS entry point and an SMBIOS structure stream.
-func stream() (io.ReadCloser, EntryPoint, error) {
-	// First, check for the sysfs location present in modern kernels.
-	_, err := os.Stat(sysfsEntryPoint)
-	switch {
-	case err == nil:
-		return sysfsStream(sysfsEntryPoint, sysfsDMI)
-	case os.IsNotExist(err):
-		// Fall back to the standard UNIX-like system method.
-		return devMemStream()
-	default:
-		return nil, nil, err
-	}
-}
-
 // sysfsStream reads the SMBIOS entry point and structure stream from
 // two files; usually the modern sysfs locations.
 func sysfsStream(entryPoint, dmi string) (io.ReadCloser, EntryPoint, error) {
@@ -0,0 +1,173 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/internal/platform"
+)
+
+// A fakePlatform is a platform.Platform for tests.
+type fakePlatform struct {
+	sources []platform.Source
+	err     error
+	table   []byte
+
+	// reads counts calls to FirmwareTable.
+	reads int
+}
+
+func (p *fakePlatform) Sources() ([]platform.Source, error) { return p.sources, p.err }
+func (p *fakePlatform) BootID() (string, error)             { return "boot", nil }
+
+func (p *fakePlatform) FirmwareTable() ([]byte, binary.ByteOrder, error) {
+	p.reads++
+	if p.table == nil {
+		return nil, nil, errors.New("no firmware table")
+	}
+
+	return p.table, binary.LittleEndian, nil
+}
+
+func (p *fakePlatform) FirmwareTableSize() (int, error) {
+	if p.table == nil {
+		return 0, errors.New("no firmware table")
+	}
+
+	return len(p.table), nil
+}
+
+func Test_streamPlatform(t *testing.T) {
+	linux := filepath.Join("testdata", "fixtures", "linux")
+	sysfs := platform.Source{
+		Kind:       platform.Files,
+		Name:       filepath.Join(linux, "DMI"),
+		EntryPoint: filepath.Join(linux, "smbios_entry_point"),
+	}
+	missing := platform.Source{
+		Kind:       platform.Files,
+		Name:       filepath.Join(linux, "missing"),
+		EntryPoint: filepath.Join(linux, "missing_entry_point"),
+	}
+	mem := platform.Source{Kind: platform.Memory, Name: "/dev/mem"}
+
+	table, err := ioutil.ReadFile(filepath.Join("testdata", "fixtures", "windows", "RawSMBIOSData.bin"))
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		p      *fakePlatform
+		source string
+		err    error
+		ok     bool
+	}{
+		{
+			name: "unsupported",
+			p:    &fakePlatform{err: fmt.Errorf("test: %w", platform.ErrUnsupported)},
+			err:  ErrUnsupported,
+		},
+		{
+			name: "no sources",
+			p:    &fakePlatform{},
+			err:  ErrUnsupported,
+		},
+		{
+			name:   "files",
+			p:      &fakePlatform{sources: []platform.Source{sysfs, mem}},
+			source: sysfs.Name,
+			ok:     true,
+		},
+		{
+			name:   "files fall back",
+			p:      &fakePlatform{sources: []platform.Source{missing, sysfs}},
+			source: sysfs.Name,
+			ok:     true,
+		},
+		{
+			name:   "files missing",
+			p:      &fakePlatform{sources: []platform.Source{missing}},
+			source: missing.Name,
+		},
+		{
+			name:   "memory disabled",
+			p:      &fakePlatform{sources: []platform.Source{missing, mem}},
+			source: mem.Name,
+			err:    ErrDevMemDisabled,
+		},
+		{
+			name: "firmware table",
+			p: &fakePlatform{
+				sources: []platform.Source{{Kind: platform.FirmwareTable, Name: "GetSystemFirmwareTable"}},
+				table:   table,
+			},
+			source: "GetSystemFirmwareTable",
+			ok:     true,
+		},
+	}
+
+	// Ensure /dev/mem is never opened by Stream or Available.
+	defer os.Setenv(envNoDevMem, os.Getenv(envNoDevMem))
+	if err := os.Setenv(envNoDevMem, "1"); err != nil {
+		t.Fatalf("failed to set environment: %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newStreamConfig(nil)
+			c.platform = tt.p
+
+			rc, _, source, err := stream(c)
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+			if tt.err != nil && !errors.Is(err, tt.err) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err == nil {
+				_ = rc.Close()
+			}
+
+			if tt.ok && source != tt.source {
+				t.Fatalf("unexpected source: %q", source)
+			}
+
+			// Available must agree with stream, without reading the
+			// firmware table.
+			reads := tt.p.reads
+			info := available(tt.p)
+			if tt.p.reads != reads {
+				t.Fatal("available read the firmware table")
+			}
+			if tt.ok != (info.Err == nil) {
+				t.Fatalf("availability inconsistent with stream: %v", info.Err)
+			}
+			if info.Source != tt.source {
+				t.Fatalf("unexpected available source: %q", info.Source)
+			}
+		})
+	}
+}
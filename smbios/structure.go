@@ -14,6 +14,12 @@
 
 package smbios
 
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+)
+
 // A Header is a Structure's header.
 type Header struct {
 	Type   uint8
@@ -26,4 +32,90 @@ type Structure struct {
 	Header    Header
 	Formatted []byte
 	Strings   []string
+
+	// Provenance is set only when decoding with WithProvenance.
+	Provenance *Provenance `json:",omitempty"`
+
+	// Duplicates is the number of identical Structures which were collapsed
+	// into this Structure when decoding with WithOEMDeduplication.
+	Duplicates int `json:",omitempty"`
+}
+
+// Equal reports whether s and other have identical headers, formatted areas,
+// and strings.  Provenance and Duplicates are not compared, so Structures
+// decoded from different sources or with different options may be equal.  A
+// nil and an empty formatted area or string set are considered equal.
+func (s *Structure) Equal(other *Structure) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	if s.Header != other.Header || !bytes.Equal(s.Formatted, other.Formatted) {
+		return false
+	}
+
+	if len(s.Strings) != len(other.Strings) {
+		return false
+	}
+	for i := range s.Strings {
+		if s.Strings[i] != other.Strings[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Canonicalize returns a copy of s in a canonical form, so that Structures
+// acquired by methods which pad data differently can be compared using Equal
+// or deduplicated.  In the canonical form:
+//   - trailing spaces are removed from each string, and
+//   - an empty formatted area or string set is nil.
+//
+// The string numbers referenced by the formatted area are unchanged, so
+// strings which become empty are retained.
+func (s *Structure) Canonicalize() *Structure {
+	out := &Structure{
+		Header:     s.Header,
+		Formatted:  append([]byte(nil), s.Formatted...),
+		Duplicates: s.Duplicates,
+	}
+
+	if len(s.Strings) > 0 {
+		out.Strings = make([]string, 0, len(s.Strings))
+		for _, str := range s.Strings {
+			out.Strings = append(out.Strings, strings.TrimRight(str, " "))
+		}
+	}
+
+	if s.Provenance != nil {
+		p := *s.Provenance
+		out.Provenance = &p
+	}
+
+	return out
+}
+
+// appendStructure appends the binary encoding of s, as it would appear in an
+// SMBIOS structure table, to b.
+func appendStructure(b []byte, s *Structure) []byte {
+	var h [headerLen]byte
+	h[0] = s.Header.Type
+	h[1] = s.Header.Length
+	binary.LittleEndian.PutUint16(h[2:4], s.Header.Handle)
+
+	b = append(b, h[:]...)
+	b = append(b, s.Formatted...)
+
+	// A structure with no strings is terminated by two null bytes.
+	if len(s.Strings) == 0 {
+		return append(b, endStringSet...)
+	}
+
+	for _, str := range s.Strings {
+		b = append(b, str...)
+		b = append(b, null...)
+	}
+
+	return append(b, null...)
 }
@@ -0,0 +1,139 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_appendStructureRoundTrip(t *testing.T) {
+	b := []byte{
+		0x00, 0x05, 0x01, 0x00,
+		0xff,
+		0x00,
+		0x00,
+
+		0x01, 0x0c, 0x02, 0x00,
+		0xde, 0xad, 0xbe, 0xef, 0xde, 0xad, 0xbe, 0xef,
+		'd', 'e', 'a', 'd', 'b', 'e', 'e', 'f', 0x00,
+		0x00,
+
+		127, 0x06, 0x03, 0x00,
+		0x01, 0x02,
+		'a', 'b', 'c', 'd', 0x00,
+		'1', '2', '3', '4', 0x00,
+		0x00,
+	}
+
+	ss, err := NewDecoder(bytes.NewReader(b)).Decode()
+	if err != nil {
+		t.Fatalf("failed to decode structures: %v", err)
+	}
+
+	var out []byte
+	for _, s := range ss {
+		out = appendStructure(out, s)
+	}
+
+	if diff := cmp.Diff(b, out); diff != "" {
+		t.Fatalf("unexpected structure encoding (-want +got):\n%s", diff)
+	}
+}
+
+func TestStructureEqualCanonicalize(t *testing.T) {
+	// The same Structure as padded by two acquisition methods.
+	a := &Structure{
+		Header:     Header{Type: 1, Length: 4, Handle: 1},
+		Formatted:  []byte{},
+		Strings:    []string{"DigitalOcean   ", "Droplet"},
+		Provenance: &Provenance{Source: "a"},
+	}
+	b := &Structure{
+		Header:     Header{Type: 1, Length: 4, Handle: 1},
+		Strings:    []string{"DigitalOcean", "Droplet "},
+		Provenance: &Provenance{Source: "b"},
+	}
+
+	if a.Equal(b) {
+		t.Fatal("structures with differently padded strings should not be equal")
+	}
+
+	ca, cb := a.Canonicalize(), b.Canonicalize()
+	if !ca.Equal(cb) {
+		t.Fatalf("canonical structures should be equal:\n%#v\n%#v", ca, cb)
+	}
+
+	want := &Structure{
+		Header:     Header{Type: 1, Length: 4, Handle: 1},
+		Strings:    []string{"DigitalOcean", "Droplet"},
+		Provenance: &Provenance{Source: "a"},
+	}
+
+	if diff := cmp.Diff(want, ca); diff != "" {
+		t.Fatalf("unexpected canonical structure (-want +got):\n%s", diff)
+	}
+
+	// The original must not be modified.
+	if a.Strings[0] != "DigitalOcean   " {
+		t.Fatalf("original structure was modified: %q", a.Strings[0])
+	}
+
+	tests := []struct {
+		name string
+		a, b *Structure
+		ok   bool
+	}{
+		{
+			name: "both nil",
+			ok:   true,
+		},
+		{
+			name: "one nil",
+			a:    &Structure{},
+		},
+		{
+			name: "header",
+			a:    &Structure{Header: Header{Type: 1}},
+			b:    &Structure{Header: Header{Type: 2}},
+		},
+		{
+			name: "formatted",
+			a:    &Structure{Formatted: []byte{0x01}},
+			b:    &Structure{Formatted: []byte{0x02}},
+		},
+		{
+			name: "strings",
+			a:    &Structure{Strings: []string{"a"}},
+			b:    &Structure{Strings: []string{"a", "b"}},
+		},
+		{
+			name: "empty and nil",
+			a:    &Structure{Formatted: []byte{}, Strings: []string{}},
+			b:    &Structure{},
+			ok:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if ok := tt.a.Equal(tt.b); tt.ok != ok {
+				t.Fatalf("unexpected equality: %v", ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeAdditionalInformation is the structure type of Additional Information
+// (type 40).
+const TypeAdditionalInformation = 40
+
+// An AdditionalInformation is an Additional Information (type 40) structure,
+// which supplies additional information for fields of other structures.
+type AdditionalInformation struct {
+	Header smbios.Header
+
+	Entries []AdditionalInformationEntry
+}
+
+// An AdditionalInformationEntry supplies additional information for the
+// field at ReferencedOffset within the structure identified by
+// ReferencedHandle.
+type AdditionalInformationEntry struct {
+	ReferencedHandle uint16
+
+	// ReferencedOffset is the offset of the field within the referenced
+	// structure, including its header.
+	ReferencedOffset uint8
+
+	String string
+	Value  []byte
+}
+
+// ParseAdditionalInformation parses an AdditionalInformation from a
+// Structure.
+func ParseAdditionalInformation(s *smbios.Structure) (*AdditionalInformation, error) {
+	if err := checkStructure(s, TypeAdditionalInformation, "additional information", 0x05); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+
+	n := int(f.byte(0x04))
+	entries := make([]AdditionalInformationEntry, 0, n)
+
+	// Each entry begins with its own length, which includes the 5 bytes
+	// which precede its value.
+	off := 0x05
+	for i := 0; i < n; i++ {
+		if !f.has(off, 1) {
+			return nil, errShortList("additional information", "entries", n)
+		}
+
+		l := int(f.byte(off))
+		if l < 0x05 {
+			return nil, fmt.Errorf("SMBIOS additional information entry %d has invalid length %d", i, l)
+		}
+		if !f.has(off, l) {
+			return nil, errShortList("additional information", "entries", n)
+		}
+
+		start := off - headerLen
+		entries = append(entries, AdditionalInformationEntry{
+			ReferencedHandle: f.word(off + 0x01),
+			ReferencedOffset: f.byte(off + 0x03),
+			String:           f.str(off + 0x04),
+			Value:            append([]byte(nil), s.Formatted[start+0x05:start+l]...),
+		})
+
+		off += l
+	}
+
+	return &AdditionalInformation{
+		Header: s.Header,
+
+		Entries: entries,
+	}, nil
+}
+
+// An Annotation is an AdditionalInformationEntry resolved against the
+// structure it references.
+type Annotation struct {
+	// Structure is the referenced Structure.
+	Structure *smbios.Structure
+
+	// Offset is the offset of the referenced field within Structure,
+	// including its header, and Field holds its raw bytes.  Field is the
+	// same width as Value, or shorter if Structure ends first.
+	Offset uint8
+	Field  []byte
+
+	// String and Value are the additional information for the field.
+	String string
+	Value  []byte
+}
+
+// Resolve resolves e against the Structures in ss.  The referenced offset may
+// lie within the header of the referenced Structure.  If no Structure in ss
+// has the referenced handle, or the referenced offset lies beyond its
+// formatted area, Resolve returns false.
+func (e AdditionalInformationEntry) Resolve(ss []*smbios.Structure) (Annotation, bool) {
+	for _, s := range ss {
+		if s.Header.Handle != e.ReferencedHandle {
+			continue
+		}
+
+		// The referenced offset includes the header, so resolve it against
+		// the header and formatted area as they appear in the table.
+		b := make([]byte, headerLen, headerLen+len(s.Formatted))
+		b[0] = s.Header.Type
+		b[1] = s.Header.Length
+		binary.LittleEndian.PutUint16(b[2:4], s.Header.Handle)
+		b = append(b, s.Formatted...)
+
+		start := int(e.ReferencedOffset)
+		if start >= len(b) {
+			return Annotation{}, false
+		}
+
+		end := start + len(e.Value)
+		if len(e.Value) == 0 {
+			end = start + 1
+		}
+		if end > len(b) {
+			end = len(b)
+		}
+
+		return Annotation{
+			Structure: s,
+			Offset:    e.ReferencedOffset,
+			Field:     append([]byte(nil), b[start:end]...),
+			String:    e.String,
+			Value:     e.Value,
+		}, true
+	}
+
+	return Annotation{}, false
+}
+
+// Annotations resolves each entry of ai against the Structures in ss, in the
+// order the entries appear.  Entries which cannot be resolved are skipped.
+func (ai *AdditionalInformation) Annotations(ss []*smbios.Structure) []Annotation {
+	var out []Annotation
+	for _, e := range ai.Entries {
+		if a, ok := e.Resolve(ss); ok {
+			out = append(out, a)
+		}
+	}
+
+	return out
+}
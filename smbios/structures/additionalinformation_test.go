@@ -0,0 +1,177 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseAdditionalInformation(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		ai   *structures.AdditionalInformation
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s:    newBuilder(39, 0x05).structure(),
+		},
+		{
+			name: "too short",
+			s:    newBuilder(40, 0x04).structure(),
+		},
+		{
+			name: "short entries",
+			s:    newBuilder(40, 0x0b).byte(0x04, 1).byte(0x05, 0x07).structure(),
+		},
+		{
+			name: "invalid entry length",
+			s:    newBuilder(40, 0x0b).byte(0x04, 1).byte(0x05, 0x00).structure(),
+		},
+		{
+			name: "no entries",
+			s:    newBuilder(40, 0x05).structure(),
+			ai: &structures.AdditionalInformation{
+				Header:  header(40, 0x05),
+				Entries: []structures.AdditionalInformationEntry{},
+			},
+			ok: true,
+		},
+		{
+			name: "OK",
+			s: newBuilder(40, 0x12, "Slot label", "Bus").
+				byte(0x04, 2).
+				// Entry 1: a 1-byte value.
+				byte(0x05, 0x06).
+				word(0x06, 0x0900).
+				byte(0x08, 0x04).
+				byte(0x09, 1).
+				byte(0x0a, 0xaa).
+				// Entry 2: a 2-byte value and no string.
+				byte(0x0b, 0x07).
+				word(0x0c, 0x0901).
+				byte(0x0e, 0x0e).
+				byte(0x0f, 0).
+				word(0x10, 0xbbcc).
+				structure(),
+			ai: &structures.AdditionalInformation{
+				Header: header(40, 0x12),
+				Entries: []structures.AdditionalInformationEntry{
+					{
+						ReferencedHandle: 0x0900,
+						ReferencedOffset: 0x04,
+						String:           "Slot label",
+						Value:            []byte{0xaa},
+					},
+					{
+						ReferencedHandle: 0x0901,
+						ReferencedOffset: 0x0e,
+						Value:            []byte{0xcc, 0xbb},
+					},
+				},
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ai, err := structures.ParseAdditionalInformation(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.ai, ai); diff != "" {
+				t.Fatalf("unexpected additional information (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAdditionalInformationAnnotations(t *testing.T) {
+	slot := newBuilder(9, 0x11, "PCIe Slot 1").byte(0x04, 1).byte(0x0d, 0x02).word(0x0e, 0x0201).structure()
+	slot.Header.Handle = 0x0900
+
+	ai := &structures.AdditionalInformation{
+		Entries: []structures.AdditionalInformationEntry{
+			{
+				ReferencedHandle: 0x0900,
+				ReferencedOffset: 0x04,
+				String:           "Slot label",
+				Value:            []byte{0xaa},
+			},
+			{
+				// Only one byte of the referenced structure remains.
+				ReferencedHandle: 0x0900,
+				ReferencedOffset: 0x10,
+				Value:            []byte{0x01, 0x02, 0x03},
+			},
+			{
+				// Beyond the end of the referenced structure.
+				ReferencedHandle: 0x0900,
+				ReferencedOffset: 0x11,
+				Value:            []byte{0x01},
+			},
+			{
+				// Within the header of the referenced structure.
+				ReferencedHandle: 0x0900,
+				ReferencedOffset: 0x02,
+				Value:            []byte{0x01, 0x02},
+			},
+			{
+				// No such structure.
+				ReferencedHandle: 0x0901,
+				ReferencedOffset: 0x04,
+				Value:            []byte{0x01},
+			},
+		},
+	}
+
+	want := []structures.Annotation{
+		{
+			Structure: slot,
+			Offset:    0x04,
+			Field:     []byte{0x01},
+			String:    "Slot label",
+			Value:     []byte{0xaa},
+		},
+		{
+			Structure: slot,
+			Offset:    0x10,
+			Field:     []byte{0x00},
+			Value:     []byte{0x01, 0x02, 0x03},
+		},
+		{
+			Structure: slot,
+			Offset:    0x02,
+			Field:     []byte{0x00, 0x09},
+			Value:     []byte{0x01, 0x02},
+		},
+	}
+
+	got := ai.Annotations([]*smbios.Structure{slot})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected annotations (-want +got):\n%s", diff)
+	}
+}
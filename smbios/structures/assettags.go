@@ -0,0 +1,203 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"strings"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// placeholders are strings which firmware commonly reports in place of a real
+// serial number, asset tag, or part number, in lower case.
+var placeholders = map[string]bool{
+	"to be filled by o.e.m.":   true,
+	"default string":           true,
+	"not specified":            true,
+	"not available":            true,
+	"not applicable":           true,
+	"none":                     true,
+	"n/a":                      true,
+	"na":                       true,
+	"unknown":                  true,
+	"no asset tag":             true,
+	"no asset information":     true,
+	"asset-1234567890":         true,
+	"system serial number":     true,
+	"chassis serial number":    true,
+	"base board serial number": true,
+	"serial number":            true,
+	"part number":              true,
+	"asset tag":                true,
+	"0123456789":               true,
+	"1234567890":               true,
+	"123456789":                true,
+}
+
+// IsPlaceholder reports whether s is empty, blank, or a placeholder which
+// firmware commonly reports in place of a real serial number, asset tag, or
+// part number, such as "To Be Filled By O.E.M." or "00000000".
+func IsPlaceholder(s string) bool {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" || placeholders[s] {
+		return true
+	}
+
+	// Strings consisting of a single repeated zero or all-ones hexadecimal
+	// digit are commonly reported by memory modules without a serial number.
+	for _, c := range []string{"0", "f", "x", "."} {
+		if strings.Trim(s, c) == "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// normalize trims surrounding whitespace from s, returning the empty string
+// if s is a placeholder.
+func normalize(s string) string {
+	if IsPlaceholder(s) {
+		return ""
+	}
+
+	return strings.TrimSpace(s)
+}
+
+// Identifiers are the asset-management strings of a structure, normalized so
+// that placeholders and blank strings are empty.
+type Identifiers struct {
+	SerialNumber string `json:",omitempty"`
+	AssetTag     string `json:",omitempty"`
+	PartNumber   string `json:",omitempty"`
+}
+
+// Empty reports whether no identifiers are present.
+func (ids Identifiers) Empty() bool {
+	return ids == Identifiers{}
+}
+
+// newIdentifiers creates normalized Identifiers.
+func newIdentifiers(serial, asset, part string) Identifiers {
+	return Identifiers{
+		SerialNumber: normalize(serial),
+		AssetTag:     normalize(asset),
+		PartNumber:   normalize(part),
+	}
+}
+
+// Identifiers returns the normalized serial number of the system.
+func (si *SystemInformation) Identifiers() Identifiers {
+	return newIdentifiers(si.SerialNumber, "", "")
+}
+
+// Identifiers returns the normalized serial number and asset tag of the
+// baseboard.
+func (b *Baseboard) Identifiers() Identifiers {
+	return newIdentifiers(b.SerialNumber, b.AssetTag, "")
+}
+
+// Identifiers returns the normalized serial number and asset tag of the
+// chassis.
+func (c *Chassis) Identifiers() Identifiers {
+	return newIdentifiers(c.SerialNumber, c.AssetTag, "")
+}
+
+// Identifiers returns the normalized serial number, asset tag, and part
+// number of the processor.
+func (p *Processor) Identifiers() Identifiers {
+	return newIdentifiers(p.SerialNumber, p.AssetTag, p.PartNumber)
+}
+
+// Identifiers returns the normalized serial number, asset tag, and part
+// number of the memory device.
+func (md *MemoryDevice) Identifiers() Identifiers {
+	return newIdentifiers(md.SerialNumber, md.AssetTag, md.PartNumber)
+}
+
+// Identifiers returns the normalized serial number, asset tag, and model part
+// number of the power supply.
+func (psu *SystemPowerSupply) Identifiers() Identifiers {
+	return newIdentifiers(psu.SerialNumber, psu.AssetTag, psu.ModelPartNumber)
+}
+
+// An AssetTag is the normalized Identifiers of a single Structure.
+type AssetTag struct {
+	Header smbios.Header
+	Identifiers
+}
+
+// AssetTags returns the normalized Identifiers of every System Information,
+// Baseboard, Chassis, Processor, Memory Device, and System Power Supply
+// structure in ss, in table order.  Structures with no identifiers present
+// are omitted.
+func AssetTags(ss []*smbios.Structure) ([]AssetTag, error) {
+	var tags []AssetTag
+	for _, s := range ss {
+		ids, err := identifiers(s)
+		if err != nil {
+			return nil, err
+		}
+		if ids.Empty() {
+			continue
+		}
+
+		tags = append(tags, AssetTag{
+			Header:      s.Header,
+			Identifiers: ids,
+		})
+	}
+
+	return tags, nil
+}
+
+// AssetTags returns the normalized Identifiers of the Table's Structures, as
+// described by the AssetTags function.
+func (t *Table) AssetTags() ([]AssetTag, error) {
+	return AssetTags(t.Structures)
+}
+
+// identifiers parses s and returns its Identifiers, if its type defines any.
+func identifiers(s *smbios.Structure) (Identifiers, error) {
+	var (
+		v interface {
+			smbios.TypedStructure
+			Identifiers() Identifiers
+		}
+		err error
+	)
+
+	switch s.Header.Type {
+	case TypeSystemInformation:
+		v, err = ParseSystemInformation(s)
+	case TypeBaseboard:
+		v, err = ParseBaseboard(s)
+	case TypeChassis:
+		v, err = ParseChassis(s)
+	case TypeProcessor:
+		v, err = ParseProcessor(s)
+	case TypeMemoryDevice:
+		v, err = ParseMemoryDevice(s)
+	case TypeSystemPowerSupply:
+		v, err = ParseSystemPowerSupply(s)
+	default:
+		return Identifiers{}, nil
+	}
+	if err = processed(v, err); err != nil {
+		return Identifiers{}, err
+	}
+
+	return v.Identifiers(), nil
+}
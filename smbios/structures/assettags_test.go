@@ -0,0 +1,87 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIsPlaceholder(t *testing.T) {
+	tests := []struct {
+		s  string
+		ok bool
+	}{
+		{s: "", ok: true},
+		{s: "   ", ok: true},
+		{s: "To Be Filled By O.E.M.", ok: true},
+		{s: "Default string ", ok: true},
+		{s: "NOT SPECIFIED", ok: true},
+		{s: "00000000", ok: true},
+		{s: "FFFFFFFF", ok: true},
+		{s: "CZ2D1B0A7X"},
+		{s: "M393A4K40DB3-CWE"},
+		{s: "10000000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if got := structures.IsPlaceholder(tt.s); tt.ok != got {
+				t.Fatalf("unexpected placeholder result: want %v, got %v", tt.ok, got)
+			}
+		})
+	}
+}
+
+func TestAssetTags(t *testing.T) {
+	ss := []*smbios.Structure{
+		newBuilder(0, 0x12).structure(),
+		newBuilder(1, 0x08, "To Be Filled By O.E.M.").
+			byte(0x07, 1).
+			structure(),
+		newBuilder(2, 0x09, " CZ2D1B0A7X ", "Default string").
+			byte(0x07, 1).
+			byte(0x08, 2).
+			structure(),
+		newBuilder(17, 0x1b, "00000000", "NO ASSET TAG", "M393A4K40DB3-CWE").
+			byte(0x18, 1).
+			byte(0x19, 2).
+			byte(0x1a, 3).
+			structure(),
+	}
+
+	tags, err := structures.AssetTags(ss)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []structures.AssetTag{
+		{
+			Header:      header(2, 0x09),
+			Identifiers: structures.Identifiers{SerialNumber: "CZ2D1B0A7X"},
+		},
+		{
+			Header:      header(17, 0x1b),
+			Identifiers: structures.Identifiers{PartNumber: "M393A4K40DB3-CWE"},
+		},
+	}
+
+	if diff := cmp.Diff(want, tags); diff != "" {
+		t.Fatalf("unexpected asset tags (-want +got):\n%s", diff)
+	}
+}
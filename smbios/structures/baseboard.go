@@ -0,0 +1,186 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"fmt"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeBaseboard is the structure type of Baseboard Information (type 2).
+const TypeBaseboard = 2
+
+// A Baseboard is a Baseboard (or Module) Information (type 2) structure,
+// which describes a system board.
+//
+// All fields are defined as of SMBIOS 2.0, but firmware commonly omits the
+// trailing fields, which are left as their zero value.
+type Baseboard struct {
+	Header smbios.Header
+
+	Manufacturer           string
+	Product                string
+	Version                string
+	SerialNumber           string
+	AssetTag               string
+	FeatureFlags           BaseboardFeatures
+	LocationInChassis      string
+	ChassisHandle          uint16
+	BoardType              BaseboardType
+	ContainedObjectHandles []uint16
+}
+
+// ParseBaseboard parses a Baseboard from a Structure.
+func ParseBaseboard(s *smbios.Structure) (*Baseboard, error) {
+	if err := checkStructure(s, TypeBaseboard, "baseboard", 0x08); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+
+	bb := &Baseboard{
+		Header: s.Header,
+
+		Manufacturer:      f.str(0x04),
+		Product:           f.str(0x05),
+		Version:           f.str(0x06),
+		SerialNumber:      f.str(0x07),
+		AssetTag:          f.str(0x08),
+		FeatureFlags:      BaseboardFeatures(f.byte(0x09)),
+		LocationInChassis: f.str(0x0a),
+		ChassisHandle:     f.word(0x0b),
+		BoardType:         BaseboardType(f.byte(0x0d)),
+	}
+
+	// A list of contained object handles follows the count.
+	n := int(f.byte(0x0e))
+	for i := 0; i < n; i++ {
+		off := 0x0f + i*2
+		if !f.has(off, 2) {
+			return nil, errShortList("baseboard", "contained object handles", n)
+		}
+
+		bb.ContainedObjectHandles = append(bb.ContainedObjectHandles, f.word(off))
+	}
+
+	return bb, nil
+}
+
+// A BaseboardFeatures is a bitfield which describes the features of a
+// baseboard.
+type BaseboardFeatures uint8
+
+// Possible BaseboardFeatures bits.
+const (
+	BaseboardFeatureHostingBoard          BaseboardFeatures = 1 << 0
+	BaseboardFeatureRequiresDaughterBoard BaseboardFeatures = 1 << 1
+	BaseboardFeatureRemovable             BaseboardFeatures = 1 << 2
+	BaseboardFeatureReplaceable           BaseboardFeatures = 1 << 3
+	BaseboardFeatureHotSwappable          BaseboardFeatures = 1 << 4
+)
+
+// baseboardFeatureNames are the names of each BaseboardFeatures bit, indexed
+// by bit number, as given by dmidecode.
+var baseboardFeatureNames = []string{
+	"Board is a hosting board",
+	"Board requires at least one daughter board",
+	"Board is removable",
+	"Board is replaceable",
+	"Board is hot swappable",
+}
+
+// Names returns the names of each bit set in f, in bit order.  Reserved bits
+// are ignored.
+func (f BaseboardFeatures) Names() []string {
+	return bitNames(uint64(f), baseboardFeatureNames)
+}
+
+// String returns the names of each bit set in f separated by commas, or
+// "None" if no bits are set.
+func (f BaseboardFeatures) String() string {
+	return joinNames(f.Names(), ", ")
+}
+
+// MarshalJSON implements json.Marshaler, encoding f as an array of the names
+// of each bit set in f.
+func (f BaseboardFeatures) MarshalJSON() ([]byte, error) {
+	return marshalNames(f.Names())
+}
+
+// A BaseboardType is the type of a baseboard.
+type BaseboardType uint8
+
+// Possible BaseboardType values.
+const (
+	BaseboardTypeUnknown                BaseboardType = 0x01
+	BaseboardTypeOther                  BaseboardType = 0x02
+	BaseboardTypeServerBlade            BaseboardType = 0x03
+	BaseboardTypeConnectivitySwitch     BaseboardType = 0x04
+	BaseboardTypeSystemManagementModule BaseboardType = 0x05
+	BaseboardTypeProcessorModule        BaseboardType = 0x06
+	BaseboardTypeIOModule               BaseboardType = 0x07
+	BaseboardTypeMemoryModule           BaseboardType = 0x08
+	BaseboardTypeDaughterBoard          BaseboardType = 0x09
+	BaseboardTypeMotherboard            BaseboardType = 0x0a
+	BaseboardTypeProcessorMemoryModule  BaseboardType = 0x0b
+	BaseboardTypeProcessorIOModule      BaseboardType = 0x0c
+	BaseboardTypeInterconnectBoard      BaseboardType = 0x0d
+)
+
+// baseboardTypeNames are the names of each BaseboardType, as given in the
+// SMBIOS specification.
+var baseboardTypeNames = map[BaseboardType]string{
+	BaseboardTypeUnknown:                "Unknown",
+	BaseboardTypeOther:                  "Other",
+	BaseboardTypeServerBlade:            "Server Blade",
+	BaseboardTypeConnectivitySwitch:     "Connectivity Switch",
+	BaseboardTypeSystemManagementModule: "System Management Module",
+	BaseboardTypeProcessorModule:        "Processor Module",
+	BaseboardTypeIOModule:               "I/O Module",
+	BaseboardTypeMemoryModule:           "Memory Module",
+	BaseboardTypeDaughterBoard:          "Daughter board",
+	BaseboardTypeMotherboard:            "Motherboard (includes processor, memory, and I/O)",
+	BaseboardTypeProcessorMemoryModule:  "Processor/Memory Module",
+	BaseboardTypeProcessorIOModule:      "Processor/IO Module",
+	BaseboardTypeInterconnectBoard:      "Interconnect board",
+}
+
+// String returns the name of a BaseboardType as given in the SMBIOS
+// specification.
+func (t BaseboardType) String() string {
+	if s, ok := baseboardTypeNames[t]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("BaseboardType(%d)", uint8(t))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding t as its name.
+func (t BaseboardType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (t *BaseboardType) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "BaseboardType", func(v uint8) string { return BaseboardType(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*t = BaseboardType(v)
+	return nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseBaseboard(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		bb   *structures.Baseboard
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s:    newBuilder(3, 0x09).structure(),
+		},
+		{
+			name: "too short",
+			s:    newBuilder(2, 0x06).structure(),
+		},
+		{
+			name: "OK",
+			s: newBuilder(2, 0x09, "DigitalOcean", "Droplet", "1", "1234", "ASSET").
+				byte(0x04, 1).
+				byte(0x05, 2).
+				byte(0x06, 3).
+				byte(0x07, 4).
+				byte(0x08, 5).
+				structure(),
+			bb: &structures.Baseboard{
+				Header:       header(2, 0x09),
+				Manufacturer: "DigitalOcean",
+				Product:      "Droplet",
+				Version:      "1",
+				SerialNumber: "1234",
+				AssetTag:     "ASSET",
+			},
+			ok: true,
+		},
+		{
+			name: "short contained object handles",
+			s: newBuilder(2, 0x11).
+				byte(0x0e, 2).
+				structure(),
+		},
+		{
+			name: "OK, full",
+			s: newBuilder(2, 0x13, "DigitalOcean", "Droplet", "1", "1234", "ASSET", "Slot 3").
+				byte(0x04, 1).
+				byte(0x05, 2).
+				byte(0x06, 3).
+				byte(0x07, 4).
+				byte(0x08, 5).
+				byte(0x09, 0x09).
+				byte(0x0a, 6).
+				word(0x0b, 0x0300).
+				byte(0x0d, 0x03).
+				byte(0x0e, 2).
+				word(0x0f, 0x0400).
+				word(0x11, 0x0401).
+				structure(),
+			bb: &structures.Baseboard{
+				Header:                 header(2, 0x13),
+				Manufacturer:           "DigitalOcean",
+				Product:                "Droplet",
+				Version:                "1",
+				SerialNumber:           "1234",
+				AssetTag:               "ASSET",
+				FeatureFlags:           structures.BaseboardFeatureHostingBoard | structures.BaseboardFeatureReplaceable,
+				LocationInChassis:      "Slot 3",
+				ChassisHandle:          0x0300,
+				BoardType:              structures.BaseboardTypeServerBlade,
+				ContainedObjectHandles: []uint16{0x0400, 0x0401},
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bb, err := structures.ParseBaseboard(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.bb, bb); diff != "" {
+				t.Fatalf("unexpected baseboard (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBaseboardStrings(t *testing.T) {
+	f := structures.BaseboardFeatureHostingBoard | structures.BaseboardFeatureHotSwappable
+	if diff := cmp.Diff("Board is a hosting board, Board is hot swappable", f.String()); diff != "" {
+		t.Fatalf("unexpected features (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff("None", structures.BaseboardFeatures(0).String()); diff != "" {
+		t.Fatalf("unexpected features (-want +got):\n%s", diff)
+	}
+
+	b, err := json.Marshal(structures.BaseboardFeatures(0))
+	if err != nil {
+		t.Fatalf("failed to marshal features: %v", err)
+	}
+	if diff := cmp.Diff("[]", string(b)); diff != "" {
+		t.Fatalf("unexpected features JSON (-want +got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff("Server Blade", structures.BaseboardTypeServerBlade.String()); diff != "" {
+		t.Fatalf("unexpected board type (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff("BaseboardType(255)", structures.BaseboardType(0xff).String()); diff != "" {
+		t.Fatalf("unexpected board type (-want +got):\n%s", diff)
+	}
+}
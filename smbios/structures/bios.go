@@ -0,0 +1,220 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeBIOSInformation is the structure type of BIOS Information (type 0).
+const TypeBIOSInformation = 0
+
+// A BIOSInformation is a BIOS Information (type 0) structure, which describes
+// the system's BIOS or UEFI firmware.
+type BIOSInformation struct {
+	Header smbios.Header
+
+	// SMBIOS 2.0+.
+	Vendor                 string
+	Version                string
+	StartingAddressSegment uint16
+	ReleaseDate            string
+	ROMSize                uint8
+	Characteristics        uint64
+
+	// SMBIOS 2.4+.
+	CharacteristicsExtension1              uint8
+	CharacteristicsExtension2              uint8
+	SystemBIOSMajorRelease                 uint8
+	SystemBIOSMinorRelease                 uint8
+	EmbeddedControllerFirmwareMajorRelease uint8
+	EmbeddedControllerFirmwareMinorRelease uint8
+
+	// SMBIOS 3.1+.
+	ExtendedROMSize uint16
+}
+
+// ParseBIOSInformation parses a BIOSInformation from a Structure.
+func ParseBIOSInformation(s *smbios.Structure) (*BIOSInformation, error) {
+	// The SMBIOS 2.0 structure ends after the characteristics field.
+	if err := checkStructure(s, TypeBIOSInformation, "BIOS information", 0x12); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+
+	return &BIOSInformation{
+		Header: s.Header,
+
+		Vendor:                 f.str(0x04),
+		Version:                f.str(0x05),
+		StartingAddressSegment: f.word(0x06),
+		ReleaseDate:            f.str(0x08),
+		ROMSize:                f.byte(0x09),
+		Characteristics:        f.qword(0x0a),
+
+		CharacteristicsExtension1:              f.byte(0x12),
+		CharacteristicsExtension2:              f.byte(0x13),
+		SystemBIOSMajorRelease:                 f.byte(0x14),
+		SystemBIOSMinorRelease:                 f.byte(0x15),
+		EmbeddedControllerFirmwareMajorRelease: f.byte(0x16),
+		EmbeddedControllerFirmwareMinorRelease: f.byte(0x17),
+
+		ExtendedROMSize: f.word(0x18),
+	}, nil
+}
+
+// ROMSizeBytes returns the size of the physical device containing the BIOS
+// in bytes, consulting the SMBIOS 3.1+ extended ROM size field when required.
+// If the size is unknown, ROMSizeBytes returns false.
+func (bi *BIOSInformation) ROMSizeBytes() (uint64, bool) {
+	if bi.ROMSize != 0xff {
+		// The size is specified in 64 KiB units, minus one.
+		return (uint64(bi.ROMSize) + 1) << 16, true
+	}
+
+	// Bits 15:14 specify the unit of the extended size in bits 13:0.
+	size := uint64(bi.ExtendedROMSize & 0x3fff)
+	switch bi.ExtendedROMSize >> 14 {
+	case 0:
+		return size << 20, size != 0
+	case 1:
+		return size << 30, size != 0
+	default:
+		return 0, false
+	}
+}
+
+// SystemBIOSRelease returns the major and minor release of the system BIOS.
+// If the release is not reported, SystemBIOSRelease returns false.
+func (bi *BIOSInformation) SystemBIOSRelease() (major, minor int, ok bool) {
+	return release(bi.Header, bi.SystemBIOSMajorRelease, bi.SystemBIOSMinorRelease)
+}
+
+// EmbeddedControllerFirmwareRelease returns the major and minor release of
+// the embedded controller firmware.  If the release is not reported,
+// EmbeddedControllerFirmwareRelease returns false.
+func (bi *BIOSInformation) EmbeddedControllerFirmwareRelease() (major, minor int, ok bool) {
+	return release(bi.Header, bi.EmbeddedControllerFirmwareMajorRelease, bi.EmbeddedControllerFirmwareMinorRelease)
+}
+
+// release interprets a pair of SMBIOS 2.4+ release fields, which contain
+// 0xff if the release is not reported.
+func release(h smbios.Header, major, minor uint8) (int, int, bool) {
+	if int(h.Length) < 0x18 || major == 0xff && minor == 0xff {
+		return 0, 0, false
+	}
+
+	return int(major), int(minor), true
+}
+
+// BIOS characteristics which are commonly inspected.  Use
+// CharacteristicNames to obtain the names of all characteristics.
+const (
+	// BIOSCharacteristicsNotSupported is set in Characteristics if the
+	// BIOS does not report its characteristics.  Package smbios defines
+	// the same bit for CheckStructures, and must be kept in agreement.
+	BIOSCharacteristicsNotSupported = 1 << 3
+
+	// BIOSExtension2UEFI is set in CharacteristicsExtension2 if UEFI
+	// is supported.
+	BIOSExtension2UEFI = 1 << 3
+
+	// BIOSExtension2VirtualMachine is set in CharacteristicsExtension2
+	// if the system is a virtual machine.
+	BIOSExtension2VirtualMachine = 1 << 4
+)
+
+// UEFI reports whether the BIOS reports that it supports UEFI.
+func (bi *BIOSInformation) UEFI() bool {
+	return bi.CharacteristicsExtension2&BIOSExtension2UEFI != 0
+}
+
+// VirtualMachine reports whether the BIOS reports that the system is a
+// virtual machine.
+func (bi *BIOSInformation) VirtualMachine() bool {
+	return bi.CharacteristicsExtension2&BIOSExtension2VirtualMachine != 0
+}
+
+// biosCharacteristicNames are the names of the bits of the BIOS
+// characteristics field, indexed by bit number.  Bits 0 through 2 are
+// reserved or unknown, and bits 32 through 63 are reserved for BIOS and
+// system vendors.
+var biosCharacteristicNames = []string{
+	"", "", "",
+	"BIOS characteristics not supported",
+	"ISA is supported",
+	"MCA is supported",
+	"EISA is supported",
+	"PCI is supported",
+	"PC Card (PCMCIA) is supported",
+	"PNP is supported",
+	"APM is supported",
+	"BIOS is upgradeable",
+	"BIOS shadowing is allowed",
+	"VLB is supported",
+	"ESCD support is available",
+	"Boot from CD is supported",
+	"Selectable boot is supported",
+	"BIOS ROM is socketed",
+	"Boot from PC Card (PCMCIA) is supported",
+	"EDD is supported",
+	"Japanese floppy for NEC 9800 1.2 MB is supported (int 13h)",
+	"Japanese floppy for Toshiba 1.2 MB is supported (int 13h)",
+	"5.25\"/360 kB floppy services are supported (int 13h)",
+	"5.25\"/1.2 MB floppy services are supported (int 13h)",
+	"3.5\"/720 kB floppy services are supported (int 13h)",
+	"3.5\"/2.88 MB floppy services are supported (int 13h)",
+	"Print screen service is supported (int 5h)",
+	"8042 keyboard services are supported (int 9h)",
+	"Serial services are supported (int 14h)",
+	"Printer services are supported (int 17h)",
+	"CGA/mono video services are supported (int 10h)",
+	"NEC PC-98",
+}
+
+// biosCharacteristicExtension1Names and biosCharacteristicExtension2Names
+// are the names of the bits of the BIOS characteristics extension bytes.
+var (
+	biosCharacteristicExtension1Names = []string{
+		"ACPI is supported",
+		"USB legacy is supported",
+		"AGP is supported",
+		"I2O boot is supported",
+		"LS-120 boot is supported",
+		"ATAPI Zip drive boot is supported",
+		"IEEE 1394 boot is supported",
+		"Smart battery is supported",
+	}
+
+	biosCharacteristicExtension2Names = []string{
+		"BIOS boot specification is supported",
+		"Function key-initiated network boot is supported",
+		"Targeted content distribution is supported",
+		"UEFI is supported",
+		"System is a virtual machine",
+		"Manufacturing mode is supported",
+		"Manufacturing mode is enabled",
+	}
+)
+
+// CharacteristicNames returns the names of the characteristics which are set
+// in Characteristics and both characteristics extension bytes, in bit order.
+// Reserved and vendor-specific bits are omitted.
+func (bi *BIOSInformation) CharacteristicNames() []string {
+	names := bitNames(bi.Characteristics, biosCharacteristicNames)
+	names = append(names, bitNames(uint64(bi.CharacteristicsExtension1), biosCharacteristicExtension1Names)...)
+	return append(names, bitNames(uint64(bi.CharacteristicsExtension2), biosCharacteristicExtension2Names)...)
+}
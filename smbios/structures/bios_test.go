@@ -0,0 +1,219 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseBIOSInformation(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		bi   *structures.BIOSInformation
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s:    newBuilder(1, 0x12).structure(),
+		},
+		{
+			name: "too short",
+			s:    newBuilder(0, 0x0a).structure(),
+		},
+		{
+			name: "OK",
+			s: newBuilder(0, 0x12, "DigitalOcean", "20171212", "12/12/2017").
+				byte(0x04, 1).
+				byte(0x05, 2).
+				byte(0x08, 3).
+				structure(),
+			bi: &structures.BIOSInformation{
+				Header:      header(0, 0x12),
+				Vendor:      "DigitalOcean",
+				Version:     "20171212",
+				ReleaseDate: "12/12/2017",
+			},
+			ok: true,
+		},
+		{
+			name: "OK, 3.1",
+			s: newBuilder(0, 0x1a, "DigitalOcean", "20171212", "12/12/2017").
+				byte(0x04, 1).
+				byte(0x05, 2).
+				word(0x06, 0xe800).
+				byte(0x08, 3).
+				byte(0x09, 0xff).
+				qword(0x0a, 0x08).
+				byte(0x12, 0x03).
+				byte(0x13, 0x18).
+				byte(0x14, 2).
+				byte(0x15, 9).
+				byte(0x16, 0xff).
+				byte(0x17, 0xff).
+				word(0x18, 32).
+				structure(),
+			bi: &structures.BIOSInformation{
+				Header:                                 header(0, 0x1a),
+				Vendor:                                 "DigitalOcean",
+				Version:                                "20171212",
+				StartingAddressSegment:                 0xe800,
+				ReleaseDate:                            "12/12/2017",
+				ROMSize:                                0xff,
+				Characteristics:                        0x08,
+				CharacteristicsExtension1:              0x03,
+				CharacteristicsExtension2:              0x18,
+				SystemBIOSMajorRelease:                 2,
+				SystemBIOSMinorRelease:                 9,
+				EmbeddedControllerFirmwareMajorRelease: 0xff,
+				EmbeddedControllerFirmwareMinorRelease: 0xff,
+				ExtendedROMSize:                        32,
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bi, err := structures.ParseBIOSInformation(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.bi, bi); diff != "" {
+				t.Fatalf("unexpected BIOS information (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBIOSInformationROMSizeBytes(t *testing.T) {
+	tests := []struct {
+		name string
+		bi   *structures.BIOSInformation
+		size uint64
+		ok   bool
+	}{
+		{
+			name: "64 KiB units",
+			bi:   &structures.BIOSInformation{ROMSize: 0x0f},
+			size: 1 << 20,
+			ok:   true,
+		},
+		{
+			name: "extended, no size",
+			bi:   &structures.BIOSInformation{ROMSize: 0xff},
+		},
+		{
+			name: "extended, megabytes",
+			bi:   &structures.BIOSInformation{ROMSize: 0xff, ExtendedROMSize: 32},
+			size: 32 << 20,
+			ok:   true,
+		},
+		{
+			name: "extended, gigabytes",
+			bi:   &structures.BIOSInformation{ROMSize: 0xff, ExtendedROMSize: 1<<14 | 2},
+			size: 2 << 30,
+			ok:   true,
+		},
+		{
+			name: "extended, reserved unit",
+			bi:   &structures.BIOSInformation{ROMSize: 0xff, ExtendedROMSize: 2<<14 | 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			size, ok := tt.bi.ROMSizeBytes()
+
+			if diff := cmp.Diff(tt.ok, ok); diff != "" {
+				t.Fatalf("unexpected size presence (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.size, size); diff != "" {
+				t.Fatalf("unexpected size (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBIOSInformationRelease(t *testing.T) {
+	bi := &structures.BIOSInformation{
+		Header:                                 header(0, 0x18),
+		SystemBIOSMajorRelease:                 2,
+		SystemBIOSMinorRelease:                 9,
+		EmbeddedControllerFirmwareMajorRelease: 0xff,
+		EmbeddedControllerFirmwareMinorRelease: 0xff,
+	}
+
+	if major, minor, ok := bi.SystemBIOSRelease(); !ok || major != 2 || minor != 9 {
+		t.Fatalf("unexpected system BIOS release: %d.%d, %v", major, minor, ok)
+	}
+	if _, _, ok := bi.EmbeddedControllerFirmwareRelease(); ok {
+		t.Fatal("expected no embedded controller firmware release")
+	}
+
+	// Structures prior to SMBIOS 2.4 do not report releases.
+	bi.Header = header(0, 0x13)
+	if _, _, ok := bi.SystemBIOSRelease(); ok {
+		t.Fatal("expected no system BIOS release")
+	}
+}
+
+func TestBIOSInformationCharacteristics(t *testing.T) {
+	bi := &structures.BIOSInformation{
+		Characteristics:           1<<7 | 1<<11 | 1<<32,
+		CharacteristicsExtension1: 0x01,
+		CharacteristicsExtension2: structures.BIOSExtension2UEFI | structures.BIOSExtension2VirtualMachine,
+	}
+
+	want := []string{
+		"PCI is supported",
+		"BIOS is upgradeable",
+		"ACPI is supported",
+		"UEFI is supported",
+		"System is a virtual machine",
+	}
+
+	if diff := cmp.Diff(want, bi.CharacteristicNames()); diff != "" {
+		t.Fatalf("unexpected characteristics (-want +got):\n%s", diff)
+	}
+
+	if !bi.UEFI() || !bi.VirtualMachine() {
+		t.Fatal("expected UEFI virtual machine")
+	}
+}
+
+func TestBIOSCharacteristicsNotSupportedCheck(t *testing.T) {
+	// smbios.CheckStructures has its own definition of the bit, which must
+	// agree with structures.BIOSCharacteristicsNotSupported.
+	for bit := uint(0); bit < 64; bit++ {
+		ss := []*smbios.Structure{
+			newBuilder(0, 0x12).qword(0x0a, 1<<bit).structure(),
+		}
+
+		want := uint64(1)<<bit == structures.BIOSCharacteristicsNotSupported
+		if got := smbios.CheckStructures(ss) == smbios.ErrBIOSCharacteristicsNotSupported; want != got {
+			t.Fatalf("unexpected check result for characteristics bit %d: want not supported %v, got %v", bit, want, got)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"fmt"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeBIOSLanguageInformation is the structure type of BIOS Language
+// Information (type 13).
+const TypeBIOSLanguageInformation = 13
+
+// A BIOSLanguageInformation is a BIOS Language Information (type 13)
+// structure, which describes the languages the BIOS can display.
+type BIOSLanguageInformation struct {
+	Header smbios.Header
+
+	InstallableLanguages []string
+	Flags                uint8
+	CurrentLanguage      string
+}
+
+// ParseBIOSLanguageInformation parses a BIOSLanguageInformation from a
+// Structure.
+func ParseBIOSLanguageInformation(s *smbios.Structure) (*BIOSLanguageInformation, error) {
+	if err := checkStructure(s, TypeBIOSLanguageInformation, "BIOS language information", 0x16); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+
+	// Each installable language is a string, and the count must not exceed
+	// the number of strings present.
+	n := int(f.byte(0x04))
+	if l := len(s.Strings); n > l {
+		return nil, fmt.Errorf("SMBIOS BIOS language information structure has %d installable languages, but only %d strings", n, l)
+	}
+
+	langs := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		langs = append(langs, s.Strings[i])
+	}
+
+	return &BIOSLanguageInformation{
+		Header: s.Header,
+
+		InstallableLanguages: langs,
+		Flags:                f.byte(0x05),
+		CurrentLanguage:      f.str(0x15),
+	}, nil
+}
+
+// Abbreviated reports whether the language strings use the abbreviated
+// format, such as "enUS", rather than the long format, such as
+// "en|US|iso8859-1".
+func (bl *BIOSLanguageInformation) Abbreviated() bool {
+	return bl.Flags&0x01 != 0
+}
+
+// ActiveBIOSLanguage returns the current language of the first
+// BIOSLanguageInformation structure in a list of Structures.  If there is no
+// such structure or it does not specify a current language,
+// ActiveBIOSLanguage returns false.
+func ActiveBIOSLanguage(ss []*smbios.Structure) (string, bool, error) {
+	for _, s := range ss {
+		if s.Header.Type != TypeBIOSLanguageInformation {
+			continue
+		}
+
+		bl, err := ParseBIOSLanguageInformation(s)
+		if err = processed(bl, err); err != nil {
+			return "", false, err
+		}
+
+		return bl.CurrentLanguage, bl.CurrentLanguage != "", nil
+	}
+
+	return "", false, nil
+}
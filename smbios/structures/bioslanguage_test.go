@@ -0,0 +1,137 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseBIOSLanguageInformation(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		bl   *structures.BIOSLanguageInformation
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s:    newBuilder(12, 0x16).structure(),
+		},
+		{
+			name: "too short",
+			s:    newBuilder(13, 0x15).structure(),
+		},
+		{
+			name: "too few strings",
+			s: newBuilder(13, 0x16, "enUS").
+				byte(0x04, 2).
+				structure(),
+		},
+		{
+			name: "OK",
+			s: newBuilder(13, 0x16, "enUS", "frFR", "jaJP").
+				byte(0x04, 3).
+				byte(0x05, 0x01).
+				byte(0x15, 2).
+				structure(),
+			bl: &structures.BIOSLanguageInformation{
+				Header:               header(13, 0x16),
+				InstallableLanguages: []string{"enUS", "frFR", "jaJP"},
+				Flags:                0x01,
+				CurrentLanguage:      "frFR",
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bl, err := structures.ParseBIOSLanguageInformation(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.bl, bl); diff != "" {
+				t.Fatalf("unexpected BIOS language information (-want +got):\n%s", diff)
+			}
+
+			if bl != nil && !bl.Abbreviated() {
+				t.Fatal("expected abbreviated language format")
+			}
+		})
+	}
+}
+
+func TestActiveBIOSLanguage(t *testing.T) {
+	tests := []struct {
+		name string
+		ss   []*smbios.Structure
+		lang string
+		ok   bool
+	}{
+		{
+			name: "no structure",
+			ss:   []*smbios.Structure{newBuilder(0, 0x12).structure()},
+		},
+		{
+			name: "no current language",
+			ss: []*smbios.Structure{
+				newBuilder(13, 0x16, "en|US|iso8859-1").
+					byte(0x04, 1).
+					structure(),
+			},
+		},
+		{
+			name: "OK",
+			ss: []*smbios.Structure{
+				newBuilder(13, 0x16, "en|US|iso8859-1").
+					byte(0x04, 1).
+					byte(0x15, 1).
+					structure(),
+			},
+			lang: "en|US|iso8859-1",
+			ok:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lang, ok, err := structures.ActiveBIOSLanguage(tt.ss)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.lang, lang); diff != "" {
+				t.Fatalf("unexpected language (-want +got):\n%s", diff)
+			}
+			if tt.ok != ok {
+				t.Fatalf("unexpected ok: %v", ok)
+			}
+		})
+	}
+
+	bad := []*smbios.Structure{newBuilder(13, 0x14).structure()}
+	if _, _, err := structures.ActiveBIOSLanguage(bad); err == nil {
+		t.Fatal("expected an error, but none occurred")
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeBootIntegrityServices is the structure type of a Boot Integrity Services
+// (BIS) Entry Point (type 31).
+const TypeBootIntegrityServices = 31
+
+// A BootIntegrityServices is a Boot Integrity Services (BIS) Entry Point
+// (type 31) structure, which locates the BIS entry points used by
+// pre-boot firmware to verify boot images.
+type BootIntegrityServices struct {
+	Header smbios.Header
+
+	Checksum uint8
+
+	// ChecksumValid reports whether all bytes of the structure's formatted
+	// area, including its header, sum to zero.
+	ChecksumValid bool
+
+	// Entry16 is a real-mode segment:offset pointer, and Entry32 is a
+	// 32-bit physical address.  Either may be zero if not provided.
+	Entry16 uint32
+	Entry32 uint32
+}
+
+// ParseBootIntegrityServices parses a BootIntegrityServices from a Structure.
+func ParseBootIntegrityServices(s *smbios.Structure) (*BootIntegrityServices, error) {
+	if err := checkStructure(s, TypeBootIntegrityServices, "boot integrity services", 0x10); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+
+	return &BootIntegrityServices{
+		Header: s.Header,
+
+		Checksum:      f.byte(0x04),
+		ChecksumValid: structureChecksum(s) == 0,
+		Entry16:       f.dword(0x08),
+		Entry32:       f.dword(0x0c),
+	}, nil
+}
+
+// Entry16Address returns the physical address of the 16-bit BIS entry point
+// from its segment:offset pointer.  It returns false if the pointer is zero.
+func (b *BootIntegrityServices) Entry16Address() (uint32, bool) {
+	if b.Entry16 == 0 {
+		return 0, false
+	}
+
+	seg, off := b.Entry16>>16, b.Entry16&0xffff
+	return seg<<4 + off, true
+}
+
+// structureChecksum returns the 8-bit sum of the bytes of s's header and
+// formatted area.
+func structureChecksum(s *smbios.Structure) uint8 {
+	sum := s.Header.Type + s.Header.Length + uint8(s.Header.Handle) + uint8(s.Header.Handle>>8)
+	for _, b := range s.Formatted {
+		sum += b
+	}
+
+	return sum
+}
@@ -0,0 +1,101 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseBootIntegrityServices(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		bis  *structures.BootIntegrityServices
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s:    newBuilder(30, 0x1c).structure(),
+		},
+		{
+			name: "too short",
+			s:    newBuilder(31, 0x0f).structure(),
+		},
+		{
+			name: "bad checksum",
+			s: newBuilder(31, 0x1c).
+				dword(0x0c, 0x000fe000).
+				structure(),
+			bis: &structures.BootIntegrityServices{
+				Header:  header(31, 0x1c),
+				Entry32: 0x000fe000,
+			},
+			ok: true,
+		},
+		{
+			name: "OK",
+			s: newBuilder(31, 0x1c).
+				byte(0x04, 0x9f).
+				dword(0x08, 0xf0001234).
+				dword(0x0c, 0x000fe000).
+				structure(),
+			bis: &structures.BootIntegrityServices{
+				Header:        header(31, 0x1c),
+				Checksum:      0x9f,
+				ChecksumValid: true,
+				Entry16:       0xf0001234,
+				Entry32:       0x000fe000,
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bis, err := structures.ParseBootIntegrityServices(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.bis, bis); diff != "" {
+				t.Fatalf("unexpected boot integrity services (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBootIntegrityServicesEntry16Address(t *testing.T) {
+	bis := &structures.BootIntegrityServices{Entry16: 0xf0001234}
+	addr, ok := bis.Entry16Address()
+	if !ok {
+		t.Fatal("expected a 16-bit entry point address")
+	}
+	if diff := cmp.Diff(uint32(0xf1234), addr); diff != "" {
+		t.Fatalf("unexpected address (-want +got):\n%s", diff)
+	}
+
+	bis.Entry16 = 0
+	if _, ok := bis.Entry16Address(); ok {
+		t.Fatal("expected no 16-bit entry point address")
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"encoding/binary"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// A builder constructs Structures for tests using the field offsets given in
+// the SMBIOS specification.
+type builder struct {
+	s *smbios.Structure
+}
+
+// newBuilder creates a builder for a Structure of the specified type, total
+// length, and strings.
+func newBuilder(typ uint8, length int, strs ...string) *builder {
+	return &builder{
+		s: &smbios.Structure{
+			Header: smbios.Header{
+				Type:   typ,
+				Length: uint8(length),
+				Handle: 0x0100,
+			},
+			Formatted: make([]byte, length-4),
+			Strings:   strs,
+		},
+	}
+}
+
+func (b *builder) byte(off int, v uint8) *builder {
+	b.s.Formatted[off-4] = v
+	return b
+}
+
+func (b *builder) word(off int, v uint16) *builder {
+	binary.LittleEndian.PutUint16(b.s.Formatted[off-4:], v)
+	return b
+}
+
+func (b *builder) dword(off int, v uint32) *builder {
+	binary.LittleEndian.PutUint32(b.s.Formatted[off-4:], v)
+	return b
+}
+
+func (b *builder) qword(off int, v uint64) *builder {
+	binary.LittleEndian.PutUint64(b.s.Formatted[off-4:], v)
+	return b
+}
+
+func (b *builder) bytes(off int, v []byte) *builder {
+	copy(b.s.Formatted[off-4:], v)
+	return b
+}
+
+func (b *builder) structure() *smbios.Structure {
+	return b.s
+}
+
+// header returns the Header of a Structure created by newBuilder.
+func header(typ uint8, length int) smbios.Header {
+	return smbios.Header{
+		Type:   typ,
+		Length: uint8(length),
+		Handle: 0x0100,
+	}
+}
@@ -0,0 +1,460 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"fmt"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeCacheInformation is the structure type of Cache Information (type 7).
+const TypeCacheInformation = 7
+
+// A CacheInformation is a Cache Information (type 7) structure, which
+// describes a processor or system cache.
+type CacheInformation struct {
+	Header smbios.Header
+
+	// SMBIOS 2.0+.
+	SocketDesignation string
+	Configuration     uint16
+	MaximumCacheSize  uint16
+	InstalledSize     uint16
+	SupportedSRAMType SRAMTypes
+	CurrentSRAMType   SRAMTypes
+
+	// SMBIOS 2.1+.
+	CacheSpeed          uint8
+	ErrorCorrectionType CacheErrorCorrectionType
+	SystemCacheType     SystemCacheType
+	Associativity       CacheAssociativity
+
+	// SMBIOS 3.1+.
+	MaximumCacheSize2   uint32
+	InstalledCacheSize2 uint32
+}
+
+// ParseCacheInformation parses a CacheInformation from a Structure.
+func ParseCacheInformation(s *smbios.Structure) (*CacheInformation, error) {
+	// The SMBIOS 2.0 structure ends after the current SRAM type field.
+	if err := checkStructure(s, TypeCacheInformation, "cache information", 0x0f); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+
+	return &CacheInformation{
+		Header: s.Header,
+
+		SocketDesignation: f.str(0x04),
+		Configuration:     f.word(0x05),
+		MaximumCacheSize:  f.word(0x07),
+		InstalledSize:     f.word(0x09),
+		SupportedSRAMType: SRAMTypes(f.word(0x0b)),
+		CurrentSRAMType:   SRAMTypes(f.word(0x0d)),
+
+		CacheSpeed:          f.byte(0x0f),
+		ErrorCorrectionType: CacheErrorCorrectionType(f.byte(0x10)),
+		SystemCacheType:     SystemCacheType(f.byte(0x11)),
+		Associativity:       CacheAssociativity(f.byte(0x12)),
+
+		MaximumCacheSize2:   f.dword(0x13),
+		InstalledCacheSize2: f.dword(0x17),
+	}, nil
+}
+
+// Caches parses all CacheInformation structures from a list of
+// Structures, ignoring Structures of other types.
+func Caches(ss []*smbios.Structure) ([]*CacheInformation, error) {
+	var cs []*CacheInformation
+	for _, s := range ss {
+		if s.Header.Type != TypeCacheInformation {
+			continue
+		}
+
+		c, err := ParseCacheInformation(s)
+		if err = processed(c, err); err != nil {
+			return nil, err
+		}
+
+		cs = append(cs, c)
+	}
+
+	return cs, nil
+}
+
+// Level returns the cache level, such as 1 for an L1 cache.
+func (c *CacheInformation) Level() int {
+	return int(c.Configuration&0x07) + 1
+}
+
+// Socketed reports whether the cache is socketed.
+func (c *CacheInformation) Socketed() bool {
+	return c.Configuration&(1<<3) != 0
+}
+
+// Location returns the location of the cache relative to the processor.
+func (c *CacheInformation) Location() CacheLocation {
+	return CacheLocation((c.Configuration >> 5) & 0x03)
+}
+
+// Enabled reports whether the cache is enabled at boot time.
+func (c *CacheInformation) Enabled() bool {
+	return c.Configuration&(1<<7) != 0
+}
+
+// OperationalMode returns the write policy of the cache.
+func (c *CacheInformation) OperationalMode() CacheOperationalMode {
+	return CacheOperationalMode((c.Configuration >> 8) & 0x03)
+}
+
+// MaximumSizeBytes returns the maximum size of cache which can be installed
+// in bytes, using the SMBIOS 3.1+ extended size if needed.
+func (c *CacheInformation) MaximumSizeBytes() uint64 {
+	return cacheSize(c.MaximumCacheSize, c.MaximumCacheSize2)
+}
+
+// InstalledSizeBytes returns the size of the installed cache in bytes, using
+// the SMBIOS 3.1+ extended size if needed.  A size of 0 indicates that no
+// cache is installed.
+func (c *CacheInformation) InstalledSizeBytes() uint64 {
+	return cacheSize(c.InstalledSize, c.InstalledCacheSize2)
+}
+
+// cacheSize computes a cache size in bytes from its 16-bit and SMBIOS 3.1+
+// 32-bit fields.
+func cacheSize(size uint16, size2 uint32) uint64 {
+	// Caches of 2047 MiB or larger set the 16-bit field to 0xffff and
+	// specify their size in the 32-bit field.  In both fields, the most
+	// significant bit selects 64 KiB rather than 1 KiB granularity.
+	if size == 0xffff {
+		n := uint64(size2 & 0x7fffffff)
+		if size2&(1<<31) != 0 {
+			return n * (64 << 10)
+		}
+
+		return n * (1 << 10)
+	}
+
+	n := uint64(size & 0x7fff)
+	if size&(1<<15) != 0 {
+		return n * (64 << 10)
+	}
+
+	return n * (1 << 10)
+}
+
+// A CacheLocation is the location of a cache relative to the processor.
+type CacheLocation uint8
+
+// Possible CacheLocation values.
+const (
+	CacheLocationInternal CacheLocation = 0x00
+	CacheLocationExternal CacheLocation = 0x01
+	CacheLocationReserved CacheLocation = 0x02
+	CacheLocationUnknown  CacheLocation = 0x03
+)
+
+// String returns the name of a CacheLocation as given in the SMBIOS
+// specification.
+func (l CacheLocation) String() string {
+	switch l {
+	case CacheLocationInternal:
+		return "Internal"
+	case CacheLocationExternal:
+		return "External"
+	case CacheLocationReserved:
+		return "Reserved"
+	case CacheLocationUnknown:
+		return "Unknown"
+	default:
+		return fmt.Sprintf("CacheLocation(%d)", uint8(l))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding l as its name.
+func (l CacheLocation) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (l *CacheLocation) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "CacheLocation", func(v uint8) string { return CacheLocation(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*l = CacheLocation(v)
+	return nil
+}
+
+// A CacheOperationalMode is the write policy of a cache.
+type CacheOperationalMode uint8
+
+// Possible CacheOperationalMode values.
+const (
+	CacheOperationalModeWriteThrough CacheOperationalMode = 0x00
+	CacheOperationalModeWriteBack    CacheOperationalMode = 0x01
+	CacheOperationalModeVaries       CacheOperationalMode = 0x02
+	CacheOperationalModeUnknown      CacheOperationalMode = 0x03
+)
+
+// String returns the name of a CacheOperationalMode as given in the SMBIOS
+// specification.
+func (m CacheOperationalMode) String() string {
+	switch m {
+	case CacheOperationalModeWriteThrough:
+		return "Write Through"
+	case CacheOperationalModeWriteBack:
+		return "Write Back"
+	case CacheOperationalModeVaries:
+		return "Varies With Memory Address"
+	case CacheOperationalModeUnknown:
+		return "Unknown"
+	default:
+		return fmt.Sprintf("CacheOperationalMode(%d)", uint8(m))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding m as its name.
+func (m CacheOperationalMode) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (m *CacheOperationalMode) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "CacheOperationalMode", func(v uint8) string { return CacheOperationalMode(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*m = CacheOperationalMode(v)
+	return nil
+}
+
+// SRAMTypes is a bitfield which describes the SRAM types of a cache.
+type SRAMTypes uint16
+
+// Possible SRAMTypes bits.
+const (
+	SRAMTypeOther         SRAMTypes = 1 << 0
+	SRAMTypeUnknown       SRAMTypes = 1 << 1
+	SRAMTypeNonBurst      SRAMTypes = 1 << 2
+	SRAMTypeBurst         SRAMTypes = 1 << 3
+	SRAMTypePipelineBurst SRAMTypes = 1 << 4
+	SRAMTypeSynchronous   SRAMTypes = 1 << 5
+	SRAMTypeAsynchronous  SRAMTypes = 1 << 6
+)
+
+// sramTypeNames are the names of each SRAMTypes bit, indexed by bit number,
+// as given in the SMBIOS specification.
+var sramTypeNames = []string{
+	"Other",
+	"Unknown",
+	"Non-Burst",
+	"Burst",
+	"Pipeline Burst",
+	"Synchronous",
+	"Asynchronous",
+}
+
+// Names returns the names of each bit set in t, in bit order.  Reserved bits
+// are ignored.
+func (t SRAMTypes) Names() []string {
+	return bitNames(uint64(t), sramTypeNames)
+}
+
+// String returns the names of each bit set in t separated by commas, or
+// "None" if no bits are set.
+func (t SRAMTypes) String() string {
+	return joinNames(t.Names(), ", ")
+}
+
+// MarshalJSON implements json.Marshaler, encoding t as an array of the names
+// of each bit set in t.
+func (t SRAMTypes) MarshalJSON() ([]byte, error) {
+	return marshalNames(t.Names())
+}
+
+// A CacheErrorCorrectionType is the error correction scheme supported by a
+// cache.
+type CacheErrorCorrectionType uint8
+
+// Possible CacheErrorCorrectionType values.
+const (
+	CacheErrorCorrectionOther        CacheErrorCorrectionType = 0x01
+	CacheErrorCorrectionUnknown      CacheErrorCorrectionType = 0x02
+	CacheErrorCorrectionNone         CacheErrorCorrectionType = 0x03
+	CacheErrorCorrectionParity       CacheErrorCorrectionType = 0x04
+	CacheErrorCorrectionSingleBitECC CacheErrorCorrectionType = 0x05
+	CacheErrorCorrectionMultiBitECC  CacheErrorCorrectionType = 0x06
+)
+
+// cacheErrorCorrectionTypeNames are the names of each
+// CacheErrorCorrectionType, as given in the SMBIOS specification.
+var cacheErrorCorrectionTypeNames = map[CacheErrorCorrectionType]string{
+	CacheErrorCorrectionOther:        "Other",
+	CacheErrorCorrectionUnknown:      "Unknown",
+	CacheErrorCorrectionNone:         "None",
+	CacheErrorCorrectionParity:       "Parity",
+	CacheErrorCorrectionSingleBitECC: "Single-bit ECC",
+	CacheErrorCorrectionMultiBitECC:  "Multi-bit ECC",
+}
+
+// String returns the name of a CacheErrorCorrectionType as given in the
+// SMBIOS specification.
+func (t CacheErrorCorrectionType) String() string {
+	if s, ok := cacheErrorCorrectionTypeNames[t]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("CacheErrorCorrectionType(%d)", uint8(t))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding t as its name.
+func (t CacheErrorCorrectionType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (t *CacheErrorCorrectionType) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "CacheErrorCorrectionType", func(v uint8) string { return CacheErrorCorrectionType(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*t = CacheErrorCorrectionType(v)
+	return nil
+}
+
+// A SystemCacheType is the logical type of a cache.
+type SystemCacheType uint8
+
+// Possible SystemCacheType values.
+const (
+	SystemCacheTypeOther       SystemCacheType = 0x01
+	SystemCacheTypeUnknown     SystemCacheType = 0x02
+	SystemCacheTypeInstruction SystemCacheType = 0x03
+	SystemCacheTypeData        SystemCacheType = 0x04
+	SystemCacheTypeUnified     SystemCacheType = 0x05
+)
+
+// systemCacheTypeNames are the names of each SystemCacheType, as given in the
+// SMBIOS specification.
+var systemCacheTypeNames = map[SystemCacheType]string{
+	SystemCacheTypeOther:       "Other",
+	SystemCacheTypeUnknown:     "Unknown",
+	SystemCacheTypeInstruction: "Instruction",
+	SystemCacheTypeData:        "Data",
+	SystemCacheTypeUnified:     "Unified",
+}
+
+// String returns the name of a SystemCacheType as given in the SMBIOS
+// specification.
+func (t SystemCacheType) String() string {
+	if s, ok := systemCacheTypeNames[t]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("SystemCacheType(%d)", uint8(t))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding t as its name.
+func (t SystemCacheType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (t *SystemCacheType) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "SystemCacheType", func(v uint8) string { return SystemCacheType(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*t = SystemCacheType(v)
+	return nil
+}
+
+// A CacheAssociativity is the associativity of a cache.
+type CacheAssociativity uint8
+
+// Possible CacheAssociativity values.
+const (
+	CacheAssociativityOther            CacheAssociativity = 0x01
+	CacheAssociativityUnknown          CacheAssociativity = 0x02
+	CacheAssociativityDirectMapped     CacheAssociativity = 0x03
+	CacheAssociativity2Way             CacheAssociativity = 0x04
+	CacheAssociativity4Way             CacheAssociativity = 0x05
+	CacheAssociativityFullyAssociative CacheAssociativity = 0x06
+	CacheAssociativity8Way             CacheAssociativity = 0x07
+	CacheAssociativity16Way            CacheAssociativity = 0x08
+	CacheAssociativity12Way            CacheAssociativity = 0x09
+	CacheAssociativity24Way            CacheAssociativity = 0x0a
+	CacheAssociativity32Way            CacheAssociativity = 0x0b
+	CacheAssociativity48Way            CacheAssociativity = 0x0c
+	CacheAssociativity64Way            CacheAssociativity = 0x0d
+	CacheAssociativity20Way            CacheAssociativity = 0x0e
+)
+
+// cacheAssociativityNames are the names of each CacheAssociativity, as given
+// in the SMBIOS specification.
+var cacheAssociativityNames = map[CacheAssociativity]string{
+	CacheAssociativityOther:            "Other",
+	CacheAssociativityUnknown:          "Unknown",
+	CacheAssociativityDirectMapped:     "Direct Mapped",
+	CacheAssociativity2Way:             "2-way Set-associative",
+	CacheAssociativity4Way:             "4-way Set-associative",
+	CacheAssociativityFullyAssociative: "Fully Associative",
+	CacheAssociativity8Way:             "8-way Set-associative",
+	CacheAssociativity16Way:            "16-way Set-associative",
+	CacheAssociativity12Way:            "12-way Set-associative",
+	CacheAssociativity24Way:            "24-way Set-associative",
+	CacheAssociativity32Way:            "32-way Set-associative",
+	CacheAssociativity48Way:            "48-way Set-associative",
+	CacheAssociativity64Way:            "64-way Set-associative",
+	CacheAssociativity20Way:            "20-way Set-associative",
+}
+
+// String returns the name of a CacheAssociativity as given in the SMBIOS
+// specification.
+func (a CacheAssociativity) String() string {
+	if s, ok := cacheAssociativityNames[a]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("CacheAssociativity(%d)", uint8(a))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding a as its name.
+func (a CacheAssociativity) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (a *CacheAssociativity) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "CacheAssociativity", func(v uint8) string { return CacheAssociativity(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*a = CacheAssociativity(v)
+	return nil
+}
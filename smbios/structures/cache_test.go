@@ -0,0 +1,223 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseCacheInformation(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		c    *structures.CacheInformation
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s:    newBuilder(6, 0x0f).structure(),
+		},
+		{
+			name: "too short",
+			s:    newBuilder(7, 0x0e).structure(),
+		},
+		{
+			name: "OK, 2.0",
+			s: newBuilder(7, 0x0f, "L1 Cache").
+				byte(0x04, 1).
+				word(0x05, 0x0180).
+				word(0x07, 0x0020).
+				word(0x09, 0x0020).
+				word(0x0b, 0x0020).
+				word(0x0d, 0x0020).
+				structure(),
+			c: &structures.CacheInformation{
+				Header:            header(7, 0x0f),
+				SocketDesignation: "L1 Cache",
+				Configuration:     0x0180,
+				MaximumCacheSize:  0x0020,
+				InstalledSize:     0x0020,
+				SupportedSRAMType: structures.SRAMTypeSynchronous,
+				CurrentSRAMType:   structures.SRAMTypeSynchronous,
+			},
+			ok: true,
+		},
+		{
+			name: "OK, 3.1",
+			s: newBuilder(7, 0x1b, "L3 Cache").
+				byte(0x04, 1).
+				word(0x05, 0x01a2).
+				word(0x07, 0xffff).
+				word(0x09, 0xffff).
+				word(0x0b, 0x0002).
+				word(0x0d, 0x0002).
+				byte(0x0f, 0).
+				byte(0x10, 0x05).
+				byte(0x11, 0x05).
+				byte(0x12, 0x0e).
+				dword(0x13, 0x80008000).
+				dword(0x17, 0x80008000).
+				structure(),
+			c: &structures.CacheInformation{
+				Header:              header(7, 0x1b),
+				SocketDesignation:   "L3 Cache",
+				Configuration:       0x01a2,
+				MaximumCacheSize:    0xffff,
+				InstalledSize:       0xffff,
+				SupportedSRAMType:   structures.SRAMTypeUnknown,
+				CurrentSRAMType:     structures.SRAMTypeUnknown,
+				ErrorCorrectionType: structures.CacheErrorCorrectionSingleBitECC,
+				SystemCacheType:     structures.SystemCacheTypeUnified,
+				Associativity:       structures.CacheAssociativity20Way,
+				MaximumCacheSize2:   0x80008000,
+				InstalledCacheSize2: 0x80008000,
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := structures.ParseCacheInformation(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.c, c); diff != "" {
+				t.Fatalf("unexpected cache information (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCacheInformationConfiguration(t *testing.T) {
+	tests := []struct {
+		name     string
+		c        *structures.CacheInformation
+		level    int
+		socketed bool
+		location structures.CacheLocation
+		enabled  bool
+		mode     structures.CacheOperationalMode
+		max      uint64
+		size     uint64
+	}{
+		{
+			name: "L1, write back",
+			c: &structures.CacheInformation{
+				Configuration:    0x0180,
+				MaximumCacheSize: 0x0020,
+				InstalledSize:    0x0020,
+			},
+			level:    1,
+			location: structures.CacheLocationInternal,
+			enabled:  true,
+			mode:     structures.CacheOperationalModeWriteBack,
+			max:      32 << 10,
+			size:     32 << 10,
+		},
+		{
+			name: "L2, socketed, external, disabled",
+			c: &structures.CacheInformation{
+				Configuration:    0x032b,
+				MaximumCacheSize: 0x8010,
+			},
+			level:    4,
+			socketed: true,
+			location: structures.CacheLocationExternal,
+			mode:     structures.CacheOperationalModeUnknown,
+			max:      1 << 20,
+		},
+		{
+			name: "L3, extended size",
+			c: &structures.CacheInformation{
+				Configuration:       0x01a2,
+				MaximumCacheSize:    0xffff,
+				InstalledSize:       0xffff,
+				MaximumCacheSize2:   0x80010000,
+				InstalledCacheSize2: 0x00400000,
+			},
+			level:    3,
+			location: structures.CacheLocationExternal,
+			enabled:  true,
+			mode:     structures.CacheOperationalModeWriteBack,
+			max:      4 << 30,
+			size:     4 << 30,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			type config struct {
+				Level    int
+				Socketed bool
+				Location structures.CacheLocation
+				Enabled  bool
+				Mode     structures.CacheOperationalMode
+				Max      uint64
+				Size     uint64
+			}
+
+			want := config{tt.level, tt.socketed, tt.location, tt.enabled, tt.mode, tt.max, tt.size}
+			got := config{
+				Level:    tt.c.Level(),
+				Socketed: tt.c.Socketed(),
+				Location: tt.c.Location(),
+				Enabled:  tt.c.Enabled(),
+				Mode:     tt.c.OperationalMode(),
+				Max:      tt.c.MaximumSizeBytes(),
+				Size:     tt.c.InstalledSizeBytes(),
+			}
+
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Fatalf("unexpected configuration (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCacheInformationStrings(t *testing.T) {
+	got := []string{
+		structures.CacheLocationExternal.String(),
+		structures.CacheOperationalModeVaries.String(),
+		(structures.SRAMTypeBurst | structures.SRAMTypeSynchronous).String(),
+		structures.CacheErrorCorrectionParity.String(),
+		structures.SystemCacheTypeData.String(),
+		structures.CacheAssociativity16Way.String(),
+		structures.CacheAssociativity(0x20).String(),
+	}
+
+	want := []string{
+		"External",
+		"Varies With Memory Address",
+		"Burst, Synchronous",
+		"Parity",
+		"Data",
+		"16-way Set-associative",
+		"CacheAssociativity(32)",
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected strings (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// ProcessorCaches are the caches of a processor socket, resolved from the
+// cache handles of its Processor structure.
+type ProcessorCaches struct {
+	Socket    string
+	Processor *Processor
+
+	// Each cache is nil if the processor does not reference a Cache
+	// Information (type 7) structure for that level.
+	L1, L2, L3 *CacheInformation
+}
+
+// CacheHierarchy resolves the L1, L2, and L3 cache handles of each
+// Processor (type 4) structure in ss to the matching Cache Information
+// (type 7) structures, in the order the processors appear in ss.
+//
+// Processors do not distinguish instruction and data caches, so an L1 cache
+// may describe only one of the two caches reported by tools such as lscpu.
+func CacheHierarchy(ss []*smbios.Structure) ([]ProcessorCaches, error) {
+	caches := make(map[uint16]*smbios.Structure)
+	for _, s := range ss {
+		if s.Header.Type == TypeCacheInformation {
+			caches[s.Header.Handle] = s
+		}
+	}
+
+	// lookup parses the cache referred to by handle, or returns nil if no
+	// such cache exists.  0xffff indicates no cache.
+	lookup := func(handle uint16) (*CacheInformation, error) {
+		s, ok := caches[handle]
+		if !ok || handle == 0xffff {
+			return nil, nil
+		}
+
+		c, err := ParseCacheInformation(s)
+		if err = processed(c, err); err != nil {
+			return nil, err
+		}
+
+		return c, nil
+	}
+
+	var out []ProcessorCaches
+	for _, s := range ss {
+		if s.Header.Type != TypeProcessor {
+			continue
+		}
+
+		p, err := ParseProcessor(s)
+		if err = processed(p, err); err != nil {
+			return nil, err
+		}
+
+		pc := ProcessorCaches{
+			Socket:    p.SocketDesignation,
+			Processor: p,
+		}
+
+		// SMBIOS 2.0 processors do not reference their caches.
+		if !(fields{s: s}).has(0x1a, 6) {
+			out = append(out, pc)
+			continue
+		}
+
+		for _, c := range []struct {
+			handle uint16
+			ci     **CacheInformation
+		}{
+			{handle: p.L1CacheHandle, ci: &pc.L1},
+			{handle: p.L2CacheHandle, ci: &pc.L2},
+			{handle: p.L3CacheHandle, ci: &pc.L3},
+		} {
+			if *c.ci, err = lookup(c.handle); err != nil {
+				return nil, err
+			}
+		}
+
+		out = append(out, pc)
+	}
+
+	return out, nil
+}
+
+// CacheHierarchy resolves the caches of each processor socket in the Table.
+func (t *Table) CacheHierarchy() ([]ProcessorCaches, error) {
+	return CacheHierarchy(t.Structures)
+}
@@ -0,0 +1,123 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCacheHierarchy(t *testing.T) {
+	cache := func(handle uint16, level int, kb uint16, typ structures.SystemCacheType) *smbios.Structure {
+		s := newBuilder(7, 0x13).
+			word(0x05, uint16(level-1)|1<<7).
+			word(0x09, kb).
+			byte(0x11, uint8(typ)).
+			structure()
+		s.Header.Handle = handle
+		return s
+	}
+
+	processor := func(socket string, l1, l2, l3 uint16) *smbios.Structure {
+		return newBuilder(4, 0x20, socket).
+			byte(0x04, 1).
+			word(0x1a, l1).
+			word(0x1c, l2).
+			word(0x1e, l3).
+			structure()
+	}
+
+	ss := []*smbios.Structure{
+		cache(0x0700, 1, 64, structures.SystemCacheTypeData),
+		cache(0x0701, 2, 1024, structures.SystemCacheTypeUnified),
+		cache(0x0702, 3, 16384, structures.SystemCacheTypeUnified),
+		processor("CPU0", 0x0700, 0x0701, 0x0702),
+		// No L3 cache, and an L2 handle which refers to no cache.
+		processor("CPU1", 0x0700, 0x0800, 0xffff),
+		// SMBIOS 2.0 processor.
+		newBuilder(4, 0x1a, "CPU2").byte(0x04, 1).structure(),
+	}
+
+	got, err := structures.CacheHierarchy(ss)
+	if err != nil {
+		t.Fatalf("failed to resolve cache hierarchy: %v", err)
+	}
+
+	type level struct {
+		Level int
+		Bytes uint64
+		Type  structures.SystemCacheType
+	}
+
+	type socket struct {
+		Socket     string
+		L1, L2, L3 *level
+	}
+
+	conv := func(c *structures.CacheInformation) *level {
+		if c == nil {
+			return nil
+		}
+
+		return &level{
+			Level: c.Level(),
+			Bytes: c.InstalledSizeBytes(),
+			Type:  c.SystemCacheType,
+		}
+	}
+
+	var sockets []socket
+	for _, pc := range got {
+		sockets = append(sockets, socket{
+			Socket: pc.Socket,
+			L1:     conv(pc.L1),
+			L2:     conv(pc.L2),
+			L3:     conv(pc.L3),
+		})
+	}
+
+	var (
+		l1 = &level{Level: 1, Bytes: 64 << 10, Type: structures.SystemCacheTypeData}
+		l2 = &level{Level: 2, Bytes: 1024 << 10, Type: structures.SystemCacheTypeUnified}
+		l3 = &level{Level: 3, Bytes: 16384 << 10, Type: structures.SystemCacheTypeUnified}
+	)
+
+	want := []socket{
+		{Socket: "CPU0", L1: l1, L2: l2, L3: l3},
+		{Socket: "CPU1", L1: l1},
+		{Socket: "CPU2"},
+	}
+
+	if diff := cmp.Diff(want, sockets); diff != "" {
+		t.Fatalf("unexpected cache hierarchy (-want +got):\n%s", diff)
+	}
+}
+
+func TestCacheHierarchyBadCache(t *testing.T) {
+	c := newBuilder(7, 0x0e).structure()
+	c.Header.Handle = 0x0700
+
+	ss := []*smbios.Structure{
+		c,
+		newBuilder(4, 0x20).word(0x1a, 0x0700).structure(),
+	}
+
+	if _, err := structures.CacheHierarchy(ss); err == nil {
+		t.Fatal("expected an error, but none occurred")
+	}
+}
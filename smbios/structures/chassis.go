@@ -0,0 +1,438 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"fmt"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeChassis is the structure type of System Enclosure or Chassis (type 3).
+const TypeChassis = 3
+
+// A Chassis is a System Enclosure or Chassis (type 3) structure, which
+// describes a system's enclosure.
+type Chassis struct {
+	Header smbios.Header
+
+	// SMBIOS 2.0+.
+	Manufacturer string
+	Type         ChassisType
+	Lock         bool
+	Version      string
+	SerialNumber string
+	AssetTag     string
+
+	// SMBIOS 2.1+.
+	BootUpState      ChassisState
+	PowerSupplyState ChassisState
+	ThermalState     ChassisState
+	SecurityStatus   ChassisSecurityStatus
+
+	// SMBIOS 2.3+.
+	OEMDefined         uint32
+	Height             uint8
+	NumberOfPowerCords uint8
+	ContainedElements  []ContainedElement
+
+	// SMBIOS 2.7+.
+	SKUNumber string
+}
+
+// A ContainedElement describes a type of element which may be installed
+// within a Chassis, such as the nodes of a multi-system chassis.
+type ContainedElement struct {
+	// If StructureType is true, Type is an SMBIOS structure type.
+	// Otherwise, Type is a baseboard type, as reported by a Baseboard
+	// (type 2) structure.
+	StructureType bool
+	Type          uint8
+
+	// Minimum and Maximum are the number of elements of this type which
+	// may be installed in the chassis.
+	Minimum uint8
+	Maximum uint8
+}
+
+// ParseChassis parses a Chassis from a Structure.
+func ParseChassis(s *smbios.Structure) (*Chassis, error) {
+	// The SMBIOS 2.0 structure ends after the asset tag field.
+	if err := checkStructure(s, TypeChassis, "chassis", 0x09); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+
+	c := &Chassis{
+		Header: s.Header,
+
+		Manufacturer: f.str(0x04),
+		// Bit 7 indicates the presence of a chassis lock.
+		Type:         ChassisType(f.byte(0x05) & 0x7f),
+		Lock:         f.byte(0x05)&0x80 != 0,
+		Version:      f.str(0x06),
+		SerialNumber: f.str(0x07),
+		AssetTag:     f.str(0x08),
+
+		BootUpState:      ChassisState(f.byte(0x09)),
+		PowerSupplyState: ChassisState(f.byte(0x0a)),
+		ThermalState:     ChassisState(f.byte(0x0b)),
+		SecurityStatus:   ChassisSecurityStatus(f.byte(0x0c)),
+
+		OEMDefined:         f.dword(0x0d),
+		Height:             f.byte(0x11),
+		NumberOfPowerCords: f.byte(0x12),
+	}
+
+	// A list of contained element records follows the count and the length
+	// of each record.  Records may be longer than the three bytes defined
+	// by the specification.
+	n, m := int(f.byte(0x13)), int(f.byte(0x14))
+	if n > 0 && m < 3 {
+		return nil, fmt.Errorf("expected SMBIOS chassis contained element record length of at least 3, but got: %d", m)
+	}
+
+	for i := 0; i < n; i++ {
+		off := 0x15 + i*m
+		if !f.has(off, m) {
+			return nil, errShortList("chassis", "contained elements", n)
+		}
+
+		// Bit 7 selects whether the type is an SMBIOS structure type or a
+		// baseboard type.
+		typ := f.byte(off)
+		c.ContainedElements = append(c.ContainedElements, ContainedElement{
+			StructureType: typ&0x80 != 0,
+			Type:          typ & 0x7f,
+			Minimum:       f.byte(off + 1),
+			Maximum:       f.byte(off + 2),
+		})
+	}
+
+	// The SKU number follows the contained element records.
+	c.SKUNumber = f.str(0x15 + n*m)
+
+	return c, nil
+}
+
+// A ChassisState is the boot-up, power supply, or thermal state of a Chassis.
+type ChassisState uint8
+
+// Possible ChassisState values.
+const (
+	ChassisStateOther          ChassisState = 0x01
+	ChassisStateUnknown        ChassisState = 0x02
+	ChassisStateSafe           ChassisState = 0x03
+	ChassisStateWarning        ChassisState = 0x04
+	ChassisStateCritical       ChassisState = 0x05
+	ChassisStateNonRecoverable ChassisState = 0x06
+)
+
+// chassisStateNames are the names of each ChassisState, as given in the
+// SMBIOS specification.
+var chassisStateNames = map[ChassisState]string{
+	ChassisStateOther:          "Other",
+	ChassisStateUnknown:        "Unknown",
+	ChassisStateSafe:           "Safe",
+	ChassisStateWarning:        "Warning",
+	ChassisStateCritical:       "Critical",
+	ChassisStateNonRecoverable: "Non-recoverable",
+}
+
+// String returns the name of a ChassisState as given in the SMBIOS
+// specification.
+func (s ChassisState) String() string {
+	if n, ok := chassisStateNames[s]; ok {
+		return n
+	}
+
+	return fmt.Sprintf("ChassisState(%d)", uint8(s))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding s as its name.
+func (s ChassisState) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (s *ChassisState) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "ChassisState", func(v uint8) string { return ChassisState(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*s = ChassisState(v)
+	return nil
+}
+
+// A ChassisSecurityStatus is the physical security status of a Chassis.
+type ChassisSecurityStatus uint8
+
+// Possible ChassisSecurityStatus values.
+const (
+	ChassisSecurityStatusOther                      ChassisSecurityStatus = 0x01
+	ChassisSecurityStatusUnknown                    ChassisSecurityStatus = 0x02
+	ChassisSecurityStatusNone                       ChassisSecurityStatus = 0x03
+	ChassisSecurityStatusExternalInterfaceLockedOut ChassisSecurityStatus = 0x04
+	ChassisSecurityStatusExternalInterfaceEnabled   ChassisSecurityStatus = 0x05
+)
+
+// chassisSecurityStatusNames are the names of each ChassisSecurityStatus, as
+// given in the SMBIOS specification.
+var chassisSecurityStatusNames = map[ChassisSecurityStatus]string{
+	ChassisSecurityStatusOther:                      "Other",
+	ChassisSecurityStatusUnknown:                    "Unknown",
+	ChassisSecurityStatusNone:                       "None",
+	ChassisSecurityStatusExternalInterfaceLockedOut: "External Interface Locked Out",
+	ChassisSecurityStatusExternalInterfaceEnabled:   "External Interface Enabled",
+}
+
+// String returns the name of a ChassisSecurityStatus as given in the SMBIOS
+// specification.
+func (s ChassisSecurityStatus) String() string {
+	if n, ok := chassisSecurityStatusNames[s]; ok {
+		return n
+	}
+
+	return fmt.Sprintf("ChassisSecurityStatus(%d)", uint8(s))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding s as its name.
+func (s ChassisSecurityStatus) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (s *ChassisSecurityStatus) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "ChassisSecurityStatus", func(v uint8) string { return ChassisSecurityStatus(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*s = ChassisSecurityStatus(v)
+	return nil
+}
+
+// A ChassisType is the type of a Chassis.
+type ChassisType uint8
+
+// Possible ChassisType values.
+const (
+	ChassisTypeOther               ChassisType = 0x01
+	ChassisTypeUnknown             ChassisType = 0x02
+	ChassisTypeDesktop             ChassisType = 0x03
+	ChassisTypeLowProfileDesktop   ChassisType = 0x04
+	ChassisTypePizzaBox            ChassisType = 0x05
+	ChassisTypeMiniTower           ChassisType = 0x06
+	ChassisTypeTower               ChassisType = 0x07
+	ChassisTypePortable            ChassisType = 0x08
+	ChassisTypeLaptop              ChassisType = 0x09
+	ChassisTypeNotebook            ChassisType = 0x0a
+	ChassisTypeHandHeld            ChassisType = 0x0b
+	ChassisTypeDockingStation      ChassisType = 0x0c
+	ChassisTypeAllInOne            ChassisType = 0x0d
+	ChassisTypeSubNotebook         ChassisType = 0x0e
+	ChassisTypeSpaceSaving         ChassisType = 0x0f
+	ChassisTypeLunchBox            ChassisType = 0x10
+	ChassisTypeMainServerChassis   ChassisType = 0x11
+	ChassisTypeExpansionChassis    ChassisType = 0x12
+	ChassisTypeSubChassis          ChassisType = 0x13
+	ChassisTypeBusExpansionChassis ChassisType = 0x14
+	ChassisTypePeripheralChassis   ChassisType = 0x15
+	ChassisTypeRAIDChassis         ChassisType = 0x16
+	ChassisTypeRackMountChassis    ChassisType = 0x17
+	ChassisTypeSealedCasePC        ChassisType = 0x18
+	ChassisTypeMultiSystemChassis  ChassisType = 0x19
+	ChassisTypeCompactPCI          ChassisType = 0x1a
+	ChassisTypeAdvancedTCA         ChassisType = 0x1b
+	ChassisTypeBlade               ChassisType = 0x1c
+	ChassisTypeBladeEnclosure      ChassisType = 0x1d
+	ChassisTypeTablet              ChassisType = 0x1e
+	ChassisTypeConvertible         ChassisType = 0x1f
+	ChassisTypeDetachable          ChassisType = 0x20
+	ChassisTypeIoTGateway          ChassisType = 0x21
+	ChassisTypeEmbeddedPC          ChassisType = 0x22
+	ChassisTypeMiniPC              ChassisType = 0x23
+	ChassisTypeStickPC             ChassisType = 0x24
+)
+
+// chassisTypeNames are the names of each ChassisType, as given in the SMBIOS
+// specification.
+var chassisTypeNames = map[ChassisType]string{
+	ChassisTypeOther:               "Other",
+	ChassisTypeUnknown:             "Unknown",
+	ChassisTypeDesktop:             "Desktop",
+	ChassisTypeLowProfileDesktop:   "Low Profile Desktop",
+	ChassisTypePizzaBox:            "Pizza Box",
+	ChassisTypeMiniTower:           "Mini Tower",
+	ChassisTypeTower:               "Tower",
+	ChassisTypePortable:            "Portable",
+	ChassisTypeLaptop:              "Laptop",
+	ChassisTypeNotebook:            "Notebook",
+	ChassisTypeHandHeld:            "Hand Held",
+	ChassisTypeDockingStation:      "Docking Station",
+	ChassisTypeAllInOne:            "All in One",
+	ChassisTypeSubNotebook:         "Sub Notebook",
+	ChassisTypeSpaceSaving:         "Space-saving",
+	ChassisTypeLunchBox:            "Lunch Box",
+	ChassisTypeMainServerChassis:   "Main Server Chassis",
+	ChassisTypeExpansionChassis:    "Expansion Chassis",
+	ChassisTypeSubChassis:          "SubChassis",
+	ChassisTypeBusExpansionChassis: "Bus Expansion Chassis",
+	ChassisTypePeripheralChassis:   "Peripheral Chassis",
+	ChassisTypeRAIDChassis:         "RAID Chassis",
+	ChassisTypeRackMountChassis:    "Rack Mount Chassis",
+	ChassisTypeSealedCasePC:        "Sealed-case PC",
+	ChassisTypeMultiSystemChassis:  "Multi-system chassis",
+	ChassisTypeCompactPCI:          "Compact PCI",
+	ChassisTypeAdvancedTCA:         "Advanced TCA",
+	ChassisTypeBlade:               "Blade",
+	ChassisTypeBladeEnclosure:      "Blade Enclosure",
+	ChassisTypeTablet:              "Tablet",
+	ChassisTypeConvertible:         "Convertible",
+	ChassisTypeDetachable:          "Detachable",
+	ChassisTypeIoTGateway:          "IoT Gateway",
+	ChassisTypeEmbeddedPC:          "Embedded PC",
+	ChassisTypeMiniPC:              "Mini PC",
+	ChassisTypeStickPC:             "Stick PC",
+}
+
+// String returns the name of a ChassisType as given in the SMBIOS
+// specification.
+func (t ChassisType) String() string {
+	if n, ok := chassisTypeNames[t]; ok {
+		return n
+	}
+
+	return fmt.Sprintf("ChassisType(%d)", uint8(t))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding t as its name.
+func (t ChassisType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (t *ChassisType) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "ChassisType", func(v uint8) string { return ChassisType(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*t = ChassisType(v)
+	return nil
+}
+
+// An EnclosureKind classifies a Chassis by its role in a multi-chassis
+// system.
+type EnclosureKind int
+
+// Possible EnclosureKind values.
+const (
+	// EnclosureOther is a chassis with no particular multi-chassis role,
+	// such as a desktop or tower.
+	EnclosureOther EnclosureKind = iota
+
+	// EnclosureNode is a chassis for a single node which is installed in a
+	// shared enclosure, such as a blade.
+	EnclosureNode
+
+	// EnclosureMultiNode is a shared enclosure which houses multiple nodes,
+	// such as a blade enclosure or multi-system chassis.
+	EnclosureMultiNode
+
+	// EnclosureRack is a chassis which is mounted directly in a rack.
+	EnclosureRack
+)
+
+// String returns the name of an EnclosureKind.
+func (k EnclosureKind) String() string {
+	switch k {
+	case EnclosureOther:
+		return "Other"
+	case EnclosureNode:
+		return "Node"
+	case EnclosureMultiNode:
+		return "Multi-Node"
+	case EnclosureRack:
+		return "Rack"
+	default:
+		return fmt.Sprintf("EnclosureKind(%d)", int(k))
+	}
+}
+
+// EnclosureKind classifies the chassis by its role in a multi-chassis
+// system, based on its type.
+func (c *Chassis) EnclosureKind() EnclosureKind {
+	switch c.Type {
+	case ChassisTypeBlade:
+		return EnclosureNode
+	case ChassisTypeMultiSystemChassis, ChassisTypeBladeEnclosure:
+		return EnclosureMultiNode
+	case ChassisTypeRackMountChassis:
+		return EnclosureRack
+	default:
+		return EnclosureOther
+	}
+}
+
+// RackUnits returns the height of the chassis in rack units (U), for
+// mapping a chassis to its rack elevation.  If the height is unspecified,
+// RackUnits returns false.
+func (c *Chassis) RackUnits() (int, bool) {
+	return int(c.Height), c.Height != 0
+}
+
+// Contained expands the chassis' contained elements into the matching
+// Structures from ss, in the order they appear in ss.  Baseboard elements are
+// matched using the board type of each Baseboard (type 2) structure.
+func (c *Chassis) Contained(ss []*smbios.Structure) []*smbios.Structure {
+	var out []*smbios.Structure
+	for _, s := range ss {
+		for _, e := range c.ContainedElements {
+			if e.matches(s) {
+				out = append(out, s)
+				break
+			}
+		}
+	}
+
+	return out
+}
+
+// BaseboardType returns the baseboard type of e.  If e describes an SMBIOS
+// structure type rather than a baseboard type, BaseboardType returns false.
+func (e ContainedElement) BaseboardType() (BaseboardType, bool) {
+	return BaseboardType(e.Type), !e.StructureType
+}
+
+// matches reports whether s is an element of type e.
+func (e ContainedElement) matches(s *smbios.Structure) bool {
+	bt, ok := e.BaseboardType()
+	if !ok {
+		return s.Header.Type == e.Type
+	}
+
+	if s.Header.Type != TypeBaseboard {
+		return false
+	}
+
+	bb, err := ParseBaseboard(s)
+	return err == nil && bb.BoardType == bt
+}
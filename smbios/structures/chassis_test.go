@@ -0,0 +1,241 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseChassis(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		c    *structures.Chassis
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s:    newBuilder(2, 0x09).structure(),
+		},
+		{
+			name: "too short",
+			s:    newBuilder(3, 0x08).structure(),
+		},
+		{
+			name: "OK, lock",
+			s: newBuilder(3, 0x09, "DigitalOcean", "1", "1234", "ASSET").
+				byte(0x04, 1).
+				byte(0x05, 0x80|0x17).
+				byte(0x06, 2).
+				byte(0x07, 3).
+				byte(0x08, 4).
+				structure(),
+			c: &structures.Chassis{
+				Header:       header(3, 0x09),
+				Manufacturer: "DigitalOcean",
+				Type:         0x17,
+				Lock:         true,
+				Version:      "1",
+				SerialNumber: "1234",
+				AssetTag:     "ASSET",
+			},
+			ok: true,
+		},
+		{
+			name: "OK, 2.7",
+			s: newBuilder(3, 0x19, "SKU").
+				byte(0x05, 0x17).
+				byte(0x09, 0x03).
+				byte(0x0a, 0x04).
+				byte(0x0b, 0x05).
+				byte(0x0c, 0x04).
+				dword(0x0d, 0xdeadbeef).
+				byte(0x11, 1).
+				byte(0x12, 2).
+				byte(0x13, 1).
+				byte(0x14, 3).
+				bytes(0x15, []byte{0x80 | 0x04, 1, 2}).
+				byte(0x18, 1).
+				structure(),
+			c: &structures.Chassis{
+				Header:             header(3, 0x19),
+				Type:               0x17,
+				BootUpState:        structures.ChassisStateSafe,
+				PowerSupplyState:   structures.ChassisStateWarning,
+				ThermalState:       structures.ChassisStateCritical,
+				SecurityStatus:     structures.ChassisSecurityStatusExternalInterfaceLockedOut,
+				OEMDefined:         0xdeadbeef,
+				Height:             1,
+				NumberOfPowerCords: 2,
+				ContainedElements: []structures.ContainedElement{{
+					StructureType: true,
+					Type:          4,
+					Minimum:       1,
+					Maximum:       2,
+				}},
+				SKUNumber: "SKU",
+			},
+			ok: true,
+		},
+		{
+			name: "bad contained element record length",
+			s: newBuilder(3, 0x17).
+				byte(0x13, 1).
+				byte(0x14, 2).
+				structure(),
+		},
+		{
+			name: "short contained elements",
+			s: newBuilder(3, 0x18).
+				byte(0x13, 2).
+				byte(0x14, 3).
+				structure(),
+		},
+		{
+			name: "OK, 2.3",
+			s: newBuilder(3, 0x1b).
+				byte(0x05, 0x19).
+				byte(0x11, 2).
+				byte(0x12, 4).
+				byte(0x13, 2).
+				byte(0x14, 3).
+				bytes(0x15, []byte{0x0a, 1, 4}).
+				bytes(0x18, []byte{0x80 | 0x04, 2, 8}).
+				structure(),
+			c: &structures.Chassis{
+				Header:             header(3, 0x1b),
+				Type:               0x19,
+				Height:             2,
+				NumberOfPowerCords: 4,
+				ContainedElements: []structures.ContainedElement{
+					{
+						Type:    0x0a,
+						Minimum: 1,
+						Maximum: 4,
+					},
+					{
+						StructureType: true,
+						Type:          4,
+						Minimum:       2,
+						Maximum:       8,
+					},
+				},
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := structures.ParseChassis(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.c, c); diff != "" {
+				t.Fatalf("unexpected chassis (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestChassisEnclosureKind(t *testing.T) {
+	tests := []struct {
+		typ  structures.ChassisType
+		kind structures.EnclosureKind
+		s    string
+	}{
+		{typ: 0x03, kind: structures.EnclosureOther, s: "Other"},
+		{typ: structures.ChassisTypeBlade, kind: structures.EnclosureNode, s: "Node"},
+		{typ: structures.ChassisTypeMultiSystemChassis, kind: structures.EnclosureMultiNode, s: "Multi-Node"},
+		{typ: structures.ChassisTypeBladeEnclosure, kind: structures.EnclosureMultiNode, s: "Multi-Node"},
+		{typ: structures.ChassisTypeRackMountChassis, kind: structures.EnclosureRack, s: "Rack"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			kind := (&structures.Chassis{Type: tt.typ}).EnclosureKind()
+			if diff := cmp.Diff(tt.kind, kind); diff != "" {
+				t.Fatalf("unexpected enclosure kind (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.s, kind.String()); diff != "" {
+				t.Fatalf("unexpected enclosure kind string (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestChassisStateString(t *testing.T) {
+	tests := []struct {
+		s    fmt.Stringer
+		want string
+	}{
+		{s: structures.ChassisStateSafe, want: "Safe"},
+		{s: structures.ChassisStateNonRecoverable, want: "Non-recoverable"},
+		{s: structures.ChassisState(0x10), want: "ChassisState(16)"},
+		{s: structures.ChassisSecurityStatusNone, want: "None"},
+		{s: structures.ChassisSecurityStatus(0x10), want: "ChassisSecurityStatus(16)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, tt.s.String()); diff != "" {
+				t.Fatalf("unexpected string (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestChassisRackUnits(t *testing.T) {
+	if _, ok := (&structures.Chassis{}).RackUnits(); ok {
+		t.Fatal("expected unspecified height")
+	}
+
+	u, ok := (&structures.Chassis{Height: 2}).RackUnits()
+	if !ok || u != 2 {
+		t.Fatalf("unexpected rack units: %d, %v", u, ok)
+	}
+}
+
+func TestChassisContained(t *testing.T) {
+	// A server blade and a switch, identified by baseboard type, and a
+	// processor.
+	blade := newBuilder(2, 0x0f).byte(0x0d, 0x03).structure()
+	sw := newBuilder(2, 0x0f).byte(0x0d, 0x04).structure()
+	short := newBuilder(2, 0x08).structure()
+	cpu := newBuilder(4, 0x1a).structure()
+	mem := newBuilder(17, 0x15).structure()
+
+	c := &structures.Chassis{
+		ContainedElements: []structures.ContainedElement{
+			{Type: uint8(structures.BaseboardTypeServerBlade)},
+			{StructureType: true, Type: 4},
+		},
+	}
+
+	got := c.Contained([]*smbios.Structure{blade, sw, short, cpu, mem})
+	if diff := cmp.Diff([]*smbios.Structure{blade, cpu}, got); diff != "" {
+		t.Fatalf("unexpected contained structures (-want +got):\n%s", diff)
+	}
+}
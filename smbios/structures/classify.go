@@ -0,0 +1,220 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// A MachineClass is a vendor-neutral category of machine, for grouping
+// systems by their form factor.
+type MachineClass int
+
+// Possible MachineClass values.
+const (
+	// MachineUnknown is a machine which could not be classified.
+	MachineUnknown MachineClass = iota
+
+	// MachineRackServer is a server which is mounted in a rack, or a
+	// server whose form factor is not reported.
+	MachineRackServer
+
+	// MachineBlade is a blade server or blade enclosure.
+	MachineBlade
+
+	// MachineLaptop is a portable machine, such as a laptop or tablet.
+	MachineLaptop
+
+	// MachineDesktop is a desktop or tower machine.
+	MachineDesktop
+
+	// MachineVirtual is a virtual machine.
+	MachineVirtual
+
+	// MachineEmbedded is an embedded or industrial machine, such as an IoT
+	// gateway or CompactPCI board.
+	MachineEmbedded
+)
+
+// String returns the name of a MachineClass.
+func (c MachineClass) String() string {
+	switch c {
+	case MachineUnknown:
+		return "Unknown"
+	case MachineRackServer:
+		return "Rack Server"
+	case MachineBlade:
+		return "Blade"
+	case MachineLaptop:
+		return "Laptop"
+	case MachineDesktop:
+		return "Desktop"
+	case MachineVirtual:
+		return "Virtual Machine"
+	case MachineEmbedded:
+		return "Embedded"
+	default:
+		return fmt.Sprintf("MachineClass(%d)", int(c))
+	}
+}
+
+// chassisClasses are the MachineClasses of each chassis type.  Chassis types
+// which are not listed, such as expansion chassis, do not identify a class.
+var chassisClasses = map[ChassisType]MachineClass{
+	ChassisTypeDesktop:            MachineDesktop,
+	ChassisTypeLowProfileDesktop:  MachineDesktop,
+	ChassisTypePizzaBox:           MachineDesktop,
+	ChassisTypeMiniTower:          MachineDesktop,
+	ChassisTypeTower:              MachineDesktop,
+	ChassisTypeAllInOne:           MachineDesktop,
+	ChassisTypeSpaceSaving:        MachineDesktop,
+	ChassisTypeLunchBox:           MachineDesktop,
+	ChassisTypeSealedCasePC:       MachineDesktop,
+	ChassisTypeMiniPC:             MachineDesktop,
+	ChassisTypePortable:           MachineLaptop,
+	ChassisTypeLaptop:             MachineLaptop,
+	ChassisTypeNotebook:           MachineLaptop,
+	ChassisTypeHandHeld:           MachineLaptop,
+	ChassisTypeSubNotebook:        MachineLaptop,
+	ChassisTypeTablet:             MachineLaptop,
+	ChassisTypeConvertible:        MachineLaptop,
+	ChassisTypeDetachable:         MachineLaptop,
+	ChassisTypeMainServerChassis:  MachineRackServer,
+	ChassisTypeRackMountChassis:   MachineRackServer,
+	ChassisTypeMultiSystemChassis: MachineRackServer,
+	ChassisTypeBlade:              MachineBlade,
+	ChassisTypeBladeEnclosure:     MachineBlade,
+	ChassisTypeCompactPCI:         MachineEmbedded,
+	ChassisTypeAdvancedTCA:        MachineEmbedded,
+	ChassisTypeIoTGateway:         MachineEmbedded,
+	ChassisTypeEmbeddedPC:         MachineEmbedded,
+	ChassisTypeStickPC:            MachineEmbedded,
+}
+
+// virtualProducts are substrings of the system manufacturer, product name,
+// or family which identify common hypervisors whose firmware does not set
+// the BIOS virtual machine characteristic.
+var virtualProducts = []string{
+	"QEMU",
+	"KVM",
+	"VMware",
+	"VirtualBox",
+	"innotek",
+	"Xen",
+	"Bochs",
+	"Parallels",
+	"Virtual Machine",
+	"Google Compute Engine",
+	"Droplet",
+}
+
+// serverFamilies are substrings of the system family which identify server
+// product lines.
+var serverFamilies = []string{
+	"Server",
+	"PowerEdge",
+	"ProLiant",
+	"ThinkSystem",
+}
+
+// serverProcessors are substrings of the processor version which identify
+// server processors.
+var serverProcessors = []string{
+	"Xeon",
+	"EPYC",
+	"Opteron",
+	"ThunderX",
+	"Ampere",
+}
+
+// Classify classifies the machine described by ss into a MachineClass.
+//
+// A machine is classified as virtual if its BIOS reports that it is a
+// virtual machine or its system identifies a common hypervisor.  Otherwise,
+// it is classified by the type of its first Chassis (type 3) structure, or
+// by its baseboard type if it reports a server blade.  If the chassis type
+// does not identify a class, a machine whose system family or first
+// processor identifies a server is classified as MachineRackServer.
+func Classify(ss []*smbios.Structure) (MachineClass, error) {
+	si, err := NewSystemInfo(ss)
+	if err != nil {
+		return MachineUnknown, err
+	}
+
+	if si.BIOS != nil && si.BIOS.VirtualMachine() {
+		return MachineVirtual, nil
+	}
+
+	if sys := si.System; sys != nil &&
+		containsAny(virtualProducts, sys.Manufacturer, sys.ProductName, sys.Family) {
+		return MachineVirtual, nil
+	}
+
+	if si.Baseboard != nil && si.Baseboard.BoardType == BaseboardTypeServerBlade {
+		return MachineBlade, nil
+	}
+
+	if si.Chassis != nil {
+		if c, ok := chassisClasses[si.Chassis.Type]; ok {
+			return c, nil
+		}
+	}
+
+	if si.System != nil && containsAny(serverFamilies, si.System.Family) {
+		return MachineRackServer, nil
+	}
+
+	for _, s := range ss {
+		if s.Header.Type != TypeProcessor {
+			continue
+		}
+
+		p, err := ParseProcessor(s)
+		if err = processed(p, err); err != nil {
+			return MachineUnknown, err
+		}
+
+		if containsAny(serverProcessors, p.ProcessorVersion) {
+			return MachineRackServer, nil
+		}
+
+		// Only the first processor is considered.
+		break
+	}
+
+	return MachineUnknown, nil
+}
+
+// Classify classifies the machine described by the Table's Structures into
+// a MachineClass.
+func (t *Table) Classify() (MachineClass, error) {
+	return Classify(t.Structures)
+}
+
+// containsAny reports whether any of strs contains any of substrs.
+func containsAny(substrs []string, strs ...string) bool {
+	for _, s := range strs {
+		for _, sub := range substrs {
+			if strings.Contains(s, sub) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
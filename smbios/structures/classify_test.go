@@ -0,0 +1,177 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestClassify(t *testing.T) {
+	var (
+		vmBIOS = newBuilder(0, 0x14).byte(0x13, 1<<4).structure()
+
+		system = func(manufacturer, product, family string) *smbios.Structure {
+			return newBuilder(1, 0x1b, manufacturer, product, family).
+				byte(0x04, 1).byte(0x05, 2).byte(0x1a, 3).structure()
+		}
+
+		chassis = func(typ structures.ChassisType) *smbios.Structure {
+			// The lock bit is ignored.
+			return newBuilder(3, 0x09).byte(0x05, 0x80|uint8(typ)).structure()
+		}
+
+		processor = func(version string) *smbios.Structure {
+			return newBuilder(4, 0x1a, version).byte(0x10, 1).structure()
+		}
+	)
+
+	tests := []struct {
+		name string
+		ss   []*smbios.Structure
+		c    structures.MachineClass
+		ok   bool
+	}{
+		{
+			name: "bad chassis",
+			ss:   []*smbios.Structure{newBuilder(3, 0x08).structure()},
+		},
+		{
+			name: "empty",
+			ok:   true,
+		},
+		{
+			name: "BIOS virtual machine",
+			ss:   []*smbios.Structure{vmBIOS, chassis(structures.ChassisTypeRackMountChassis)},
+			c:    structures.MachineVirtual,
+			ok:   true,
+		},
+		{
+			name: "QEMU",
+			ss: []*smbios.Structure{
+				system("QEMU", "Standard PC (Q35 + ICH9, 2009)", ""),
+				chassis(structures.ChassisTypeOther),
+			},
+			c:  structures.MachineVirtual,
+			ok: true,
+		},
+		{
+			name: "rack mount",
+			ss:   []*smbios.Structure{chassis(structures.ChassisTypeRackMountChassis)},
+			c:    structures.MachineRackServer,
+			ok:   true,
+		},
+		{
+			name: "blade chassis",
+			ss:   []*smbios.Structure{chassis(structures.ChassisTypeBlade)},
+			c:    structures.MachineBlade,
+			ok:   true,
+		},
+		{
+			name: "blade baseboard",
+			ss: []*smbios.Structure{
+				newBuilder(2, 0x0e).byte(0x0d, uint8(structures.BaseboardTypeServerBlade)).structure(),
+				chassis(structures.ChassisTypeOther),
+			},
+			c:  structures.MachineBlade,
+			ok: true,
+		},
+		{
+			name: "notebook",
+			ss:   []*smbios.Structure{chassis(structures.ChassisTypeNotebook)},
+			c:    structures.MachineLaptop,
+			ok:   true,
+		},
+		{
+			name: "tower",
+			ss: []*smbios.Structure{
+				chassis(structures.ChassisTypeTower),
+				processor("Intel(R) Xeon(R) CPU E5-2650 v4 @ 2.20GHz"),
+			},
+			c:  structures.MachineDesktop,
+			ok: true,
+		},
+		{
+			name: "IoT gateway",
+			ss:   []*smbios.Structure{chassis(structures.ChassisTypeIoTGateway)},
+			c:    structures.MachineEmbedded,
+			ok:   true,
+		},
+		{
+			name: "server family",
+			ss: []*smbios.Structure{
+				system("Dell Inc.", "PowerEdge R640", "PowerEdge"),
+				chassis(structures.ChassisTypeUnknown),
+			},
+			c:  structures.MachineRackServer,
+			ok: true,
+		},
+		{
+			name: "server processor",
+			ss: []*smbios.Structure{
+				chassis(structures.ChassisTypeOther),
+				processor("AMD EPYC 7402P 24-Core Processor"),
+				processor("Unknown"),
+			},
+			c:  structures.MachineRackServer,
+			ok: true,
+		},
+		{
+			name: "first processor only",
+			ss: []*smbios.Structure{
+				processor("Unknown"),
+				processor("AMD EPYC 7402P 24-Core Processor"),
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := structures.Classify(tt.ss)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.c, c); diff != "" {
+				t.Fatalf("unexpected machine class (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMachineClassString(t *testing.T) {
+	var got []string
+	for _, c := range []structures.MachineClass{
+		structures.MachineUnknown,
+		structures.MachineRackServer,
+		structures.MachineVirtual,
+		10,
+	} {
+		got = append(got, c.String())
+	}
+
+	want := []string{"Unknown", "Rack Server", "Virtual Machine", "MachineClass(10)"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected strings (-want +got):\n%s", diff)
+	}
+}
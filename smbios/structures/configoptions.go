@@ -0,0 +1,61 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"fmt"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeSystemConfigurationOptions is the structure type of System
+// Configuration Options (type 12).
+const TypeSystemConfigurationOptions = 12
+
+// SystemConfigurationOptions is a System Configuration Options (type 12)
+// structure, which describes the settings of system board jumpers and
+// switches.
+type SystemConfigurationOptions struct {
+	Header smbios.Header
+
+	Options []string
+}
+
+// ParseSystemConfigurationOptions parses a SystemConfigurationOptions from a
+// Structure.
+func ParseSystemConfigurationOptions(s *smbios.Structure) (*SystemConfigurationOptions, error) {
+	if err := checkStructure(s, TypeSystemConfigurationOptions, "system configuration options", 0x05); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+
+	// Each option is a string, and the count must not exceed the number of
+	// strings present.
+	n := int(f.byte(0x04))
+	if l := len(s.Strings); n > l {
+		return nil, fmt.Errorf("SMBIOS system configuration options structure has %d options, but only %d strings", n, l)
+	}
+
+	opts := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		opts = append(opts, s.Strings[i])
+	}
+
+	return &SystemConfigurationOptions{
+		Header:  s.Header,
+		Options: opts,
+	}, nil
+}
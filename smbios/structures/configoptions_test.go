@@ -0,0 +1,78 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseSystemConfigurationOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		sco  *structures.SystemConfigurationOptions
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s:    newBuilder(11, 0x05).structure(),
+		},
+		{
+			name: "too short",
+			s:    newBuilder(12, 0x04).structure(),
+		},
+		{
+			name: "too few strings",
+			s: newBuilder(12, 0x05, "JP1: CMOS clear").
+				byte(0x04, 2).
+				structure(),
+		},
+		{
+			name: "OK",
+			s: newBuilder(12, 0x05, "JP1: CMOS clear", "JP2: Password clear").
+				byte(0x04, 2).
+				structure(),
+			sco: &structures.SystemConfigurationOptions{
+				Header: header(12, 0x05),
+				Options: []string{
+					"JP1: CMOS clear",
+					"JP2: Password clear",
+				},
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sco, err := structures.ParseSystemConfigurationOptions(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.sco, sco); diff != "" {
+				t.Fatalf("unexpected system configuration options (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
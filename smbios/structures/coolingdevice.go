@@ -0,0 +1,172 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"fmt"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeCoolingDevice is the structure type of a Cooling Device (type 27).
+const TypeCoolingDevice = 27
+
+// A CoolingDevice is a Cooling Device (type 27) structure, which describes a
+// cooling device such as a fan within a system.
+type CoolingDevice struct {
+	Header smbios.Header
+
+	// SMBIOS 2.2+.
+	TemperatureProbeHandle uint16
+	DeviceType             CoolingDeviceType
+	Status                 ProbeStatus
+	CoolingUnitGroup       uint8
+	OEMDefined             uint32
+
+	// Present only if the structure is long enough, and 0x8000 if unknown.
+	NominalSpeed uint16
+
+	// SMBIOS 2.7+.
+	Description string
+}
+
+// ParseCoolingDevice parses a CoolingDevice from a Structure.
+func ParseCoolingDevice(s *smbios.Structure) (*CoolingDevice, error) {
+	// The structure ends after the OEM-defined field, and may be followed by
+	// the nominal speed field.
+	if err := checkStructure(s, TypeCoolingDevice, "cooling device", 0x0c); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+	ts := f.byte(0x06)
+
+	speed := uint16(probeUnknown)
+	if f.has(0x0c, 2) {
+		speed = f.word(0x0c)
+	}
+
+	return &CoolingDevice{
+		Header: s.Header,
+
+		TemperatureProbeHandle: f.word(0x04),
+		DeviceType:             CoolingDeviceType(ts & 0x1f),
+		Status:                 ProbeStatus(ts >> 5),
+		CoolingUnitGroup:       f.byte(0x07),
+		OEMDefined:             f.dword(0x08),
+		NominalSpeed:           speed,
+
+		Description: f.str(0x0e),
+	}, nil
+}
+
+// CoolingDevices parses all CoolingDevices from a list of Structures,
+// ignoring Structures of other types.
+func CoolingDevices(ss []*smbios.Structure) ([]*CoolingDevice, error) {
+	var cds []*CoolingDevice
+	for _, s := range ss {
+		if s.Header.Type != TypeCoolingDevice {
+			continue
+		}
+
+		cd, err := ParseCoolingDevice(s)
+		if err = processed(cd, err); err != nil {
+			return nil, err
+		}
+
+		cds = append(cds, cd)
+	}
+
+	return cds, nil
+}
+
+// NominalRPM returns the nominal speed of the cooling device in revolutions
+// per minute.  If the speed is unknown, not present, or the device is not a
+// rotating device, NominalRPM returns false.
+func (cd *CoolingDevice) NominalRPM() (int, bool) {
+	return probeUnsigned(cd.NominalSpeed)
+}
+
+// HasTemperatureProbe reports whether the cooling device is associated with a
+// temperature probe, identified by TemperatureProbeHandle.
+func (cd *CoolingDevice) HasTemperatureProbe() bool {
+	return cd.TemperatureProbeHandle != 0xffff
+}
+
+// InGroup reports whether the cooling device is part of a redundant cooling
+// unit, identified by CoolingUnitGroup.
+func (cd *CoolingDevice) InGroup() bool {
+	return cd.CoolingUnitGroup != 0
+}
+
+// A CoolingDeviceType is the type of a cooling device.
+type CoolingDeviceType uint8
+
+// Possible CoolingDeviceType values.
+const (
+	CoolingDeviceTypeOther                   CoolingDeviceType = 0x01
+	CoolingDeviceTypeUnknown                 CoolingDeviceType = 0x02
+	CoolingDeviceTypeFan                     CoolingDeviceType = 0x03
+	CoolingDeviceTypeCentrifugalBlower       CoolingDeviceType = 0x04
+	CoolingDeviceTypeChipFan                 CoolingDeviceType = 0x05
+	CoolingDeviceTypeCabinetFan              CoolingDeviceType = 0x06
+	CoolingDeviceTypePowerSupplyFan          CoolingDeviceType = 0x07
+	CoolingDeviceTypeHeatPipe                CoolingDeviceType = 0x08
+	CoolingDeviceTypeIntegratedRefrigeration CoolingDeviceType = 0x09
+	CoolingDeviceTypeActiveCooling           CoolingDeviceType = 0x10
+	CoolingDeviceTypePassiveCooling          CoolingDeviceType = 0x11
+)
+
+// coolingDeviceTypeNames are the names of each CoolingDeviceType, as given by
+// dmidecode.
+var coolingDeviceTypeNames = map[CoolingDeviceType]string{
+	CoolingDeviceTypeOther:                   "Other",
+	CoolingDeviceTypeUnknown:                 "Unknown",
+	CoolingDeviceTypeFan:                     "Fan",
+	CoolingDeviceTypeCentrifugalBlower:       "Centrifugal Blower",
+	CoolingDeviceTypeChipFan:                 "Chip Fan",
+	CoolingDeviceTypeCabinetFan:              "Cabinet Fan",
+	CoolingDeviceTypePowerSupplyFan:          "Power Supply Fan",
+	CoolingDeviceTypeHeatPipe:                "Heat Pipe",
+	CoolingDeviceTypeIntegratedRefrigeration: "Integrated Refrigeration",
+	CoolingDeviceTypeActiveCooling:           "Active Cooling",
+	CoolingDeviceTypePassiveCooling:          "Passive Cooling",
+}
+
+// String returns the name of a CoolingDeviceType as given by dmidecode.
+func (t CoolingDeviceType) String() string {
+	if s, ok := coolingDeviceTypeNames[t]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("CoolingDeviceType(%d)", uint8(t))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding t as its name.
+func (t CoolingDeviceType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (t *CoolingDeviceType) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "CoolingDeviceType", func(v uint8) string { return CoolingDeviceType(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*t = CoolingDeviceType(v)
+	return nil
+}
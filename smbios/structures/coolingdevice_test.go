@@ -0,0 +1,106 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseCoolingDevice(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		cd   *structures.CoolingDevice
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s:    newBuilder(26, 0x0f).structure(),
+		},
+		{
+			name: "too short",
+			s:    newBuilder(27, 0x0b).structure(),
+		},
+		{
+			name: "SMBIOS 2.2 without nominal speed",
+			s: newBuilder(27, 0x0c).
+				word(0x04, 0xffff).
+				// OK, chip fan.
+				byte(0x06, 0x03<<5|0x05).
+				structure(),
+			cd: &structures.CoolingDevice{
+				Header:                 header(27, 0x0c),
+				TemperatureProbeHandle: 0xffff,
+				DeviceType:             structures.CoolingDeviceTypeChipFan,
+				Status:                 structures.ProbeStatusOK,
+				NominalSpeed:           0x8000,
+			},
+			ok: true,
+		},
+		{
+			name: "SMBIOS 2.7",
+			s: newBuilder(27, 0x0f, "Fan 1").
+				word(0x04, 0x1c00).
+				// Non-critical, cabinet fan.
+				byte(0x06, 0x04<<5|0x06).
+				byte(0x07, 1).
+				dword(0x08, 0xdeadbeef).
+				word(0x0c, 12000).
+				byte(0x0e, 1).
+				structure(),
+			cd: &structures.CoolingDevice{
+				Header:                 header(27, 0x0f),
+				TemperatureProbeHandle: 0x1c00,
+				DeviceType:             structures.CoolingDeviceTypeCabinetFan,
+				Status:                 structures.ProbeStatusNonCritical,
+				CoolingUnitGroup:       1,
+				OEMDefined:             0xdeadbeef,
+				NominalSpeed:           12000,
+				Description:            "Fan 1",
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cd, err := structures.ParseCoolingDevice(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.cd, cd); diff != "" {
+				t.Fatalf("unexpected cooling device (-want +got):\n%s", diff)
+			}
+
+			if !tt.ok {
+				return
+			}
+
+			rpm, ok := cd.NominalRPM()
+			if ok != (cd.NominalSpeed != 0x8000) || (ok && rpm != int(cd.NominalSpeed)) {
+				t.Fatalf("unexpected nominal speed: %d, %v", rpm, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package structures provides typed parsers for common SMBIOS structures.
+//
+// Each parser accepts an *smbios.Structure decoded by an smbios.Decoder and
+// returns a type which exposes the structure's fields by name.  Fields which
+// were added in later versions of the SMBIOS specification are populated only
+// when the structure is long enough to contain them, and are otherwise left
+// as their zero value.
+//
+// Unless otherwise noted, methods which interpret fields report sizes in
+// bytes, memory speeds in megatransfers per second (MT/s), and processor
+// speeds in megahertz (MHz).  String methods produce the same output
+// regardless of the system locale.
+//
+// In JSON and other text encodings, fields with enumerated types, such as
+// ChassisType, are encoded as the names returned by their String methods, and
+// bit fields with named types as arrays of the names of each bit set, so that
+// the output can be read without consulting the specification.  Values which
+// have no name, such as OEM-specific values, are encoded in a form which
+// includes their number, so no value is lost.  Fields declared with integer
+// types, such as Processor.ProcessorFamily, are encoded as numbers.
+package structures
@@ -0,0 +1,76 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeElectricalCurrentProbe is the structure type of an Electrical Current
+// Probe (type 29).
+const TypeElectricalCurrentProbe = 29
+
+// An ElectricalCurrentProbe is an Electrical Current Probe (type 29) structure,
+// which describes an electrical current probe within a system.
+//
+// The reading fields of the embedded Probe are raw values which are 0x8000 if
+// unknown.  Use the accessor methods to interpret them.
+type ElectricalCurrentProbe struct {
+	Probe
+}
+
+// ParseElectricalCurrentProbe parses an ElectricalCurrentProbe from a
+// Structure.
+func ParseElectricalCurrentProbe(s *smbios.Structure) (*ElectricalCurrentProbe, error) {
+	p, err := parseProbe(s, TypeElectricalCurrentProbe, "electrical current probe")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ElectricalCurrentProbe{Probe: p}, nil
+}
+
+// MaximumMilliamps returns the maximum current readable by the probe in
+// milliamps.  If the value is unknown, MaximumMilliamps returns false.
+func (cp *ElectricalCurrentProbe) MaximumMilliamps() (int, bool) {
+	return probeSigned(cp.MaximumValue)
+}
+
+// MinimumMilliamps returns the minimum current readable by the probe in
+// milliamps.  If the value is unknown, MinimumMilliamps returns false.
+func (cp *ElectricalCurrentProbe) MinimumMilliamps() (int, bool) {
+	return probeSigned(cp.MinimumValue)
+}
+
+// NominalMilliamps returns the nominal current of the probe in milliamps.
+// If the value is unknown or not present, NominalMilliamps returns false.
+func (cp *ElectricalCurrentProbe) NominalMilliamps() (int, bool) {
+	return probeSigned(cp.NominalValue)
+}
+
+// ResolutionMicroamps returns the resolution of the probe's reading in
+// microamps.  If the value is unknown, ResolutionMicroamps returns false.
+func (cp *ElectricalCurrentProbe) ResolutionMicroamps() (int, bool) {
+	// The resolution field is specified in tenths of milliamps.
+	v, ok := probeUnsigned(cp.Resolution)
+	return v * 100, ok
+}
+
+// ToleranceMilliamps returns the tolerance of the probe's reading in plus or
+// minus milliamps.  If the value is unknown, ToleranceMilliamps returns
+// false.
+func (cp *ElectricalCurrentProbe) ToleranceMilliamps() (int, bool) {
+	return probeUnsigned(cp.Tolerance)
+}
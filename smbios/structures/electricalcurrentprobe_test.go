@@ -0,0 +1,67 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestElectricalCurrentProbeReadings(t *testing.T) {
+	cp := &structures.ElectricalCurrentProbe{
+		Probe: structures.Probe{
+			MaximumValue: 8000,
+			Resolution:   10,
+			Tolerance:    50,
+			Accuracy:     0x8000,
+			NominalValue: 0x8000,
+		},
+	}
+
+	type reading struct {
+		V  int
+		OK bool
+	}
+
+	var got []reading
+	for _, fn := range []func() (int, bool){
+		cp.MaximumMilliamps,
+		cp.MinimumMilliamps,
+		cp.NominalMilliamps,
+		cp.ResolutionMicroamps,
+		cp.ToleranceMilliamps,
+	} {
+		v, ok := fn()
+		got = append(got, reading{V: v, OK: ok})
+	}
+
+	want := []reading{
+		{V: 8000, OK: true},
+		{V: 0, OK: true},
+		{},
+		{V: 1000, OK: true},
+		{V: 50, OK: true},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected readings (-want +got):\n%s", diff)
+	}
+
+	if _, ok := cp.AccuracyPercent(); ok {
+		t.Fatal("expected unknown accuracy")
+	}
+}
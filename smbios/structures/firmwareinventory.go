@@ -0,0 +1,81 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeFirmwareInventory is the structure type of Firmware Inventory
+// Information (type 45).
+const TypeFirmwareInventory = 45
+
+// A FirmwareInventory is a Firmware Inventory Information (type 45)
+// structure, which describes a firmware component of the system.  It was
+// added in SMBIOS 3.5.
+type FirmwareInventory struct {
+	Header smbios.Header
+
+	ComponentName          string
+	Version                string
+	VersionFormat          uint8
+	ID                     string
+	IDFormat               uint8
+	ReleaseDate            string
+	Manufacturer           string
+	LowestSupportedVersion string
+	ImageSize              uint64
+	Characteristics        uint16
+	State                  uint8
+	AssociatedComponents   []uint16
+}
+
+// ParseFirmwareInventory parses a FirmwareInventory from a Structure.
+func ParseFirmwareInventory(s *smbios.Structure) (*FirmwareInventory, error) {
+	if err := checkStructure(s, TypeFirmwareInventory, "firmware inventory", 0x18); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+
+	fi := &FirmwareInventory{
+		Header: s.Header,
+
+		ComponentName:          f.str(0x04),
+		Version:                f.str(0x05),
+		VersionFormat:          f.byte(0x06),
+		ID:                     f.str(0x07),
+		IDFormat:               f.byte(0x08),
+		ReleaseDate:            f.str(0x09),
+		Manufacturer:           f.str(0x0a),
+		LowestSupportedVersion: f.str(0x0b),
+		ImageSize:              f.qword(0x0c),
+		Characteristics:        f.word(0x14),
+		State:                  f.byte(0x16),
+	}
+
+	// A list of associated component handles follows the count.
+	n := int(f.byte(0x17))
+	for i := 0; i < n; i++ {
+		off := 0x18 + i*2
+		if !f.has(off, 2) {
+			return nil, errShortList("firmware inventory", "associated component handles", n)
+		}
+
+		fi.AssociatedComponents = append(fi.AssociatedComponents, f.word(off))
+	}
+
+	return fi, nil
+}
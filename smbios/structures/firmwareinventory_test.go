@@ -0,0 +1,98 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseFirmwareInventory(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		fi   *structures.FirmwareInventory
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s:    newBuilder(44, 0x18).structure(),
+		},
+		{
+			name: "too short",
+			s:    newBuilder(45, 0x17).structure(),
+		},
+		{
+			name: "short handle list",
+			s: newBuilder(45, 0x1a).
+				byte(0x17, 2).
+				structure(),
+		},
+		{
+			name: "OK",
+			s: newBuilder(45, 0x1c, "BMC", "1.2.3", "abc", "2022-01-01", "DigitalOcean", "1.0.0").
+				byte(0x04, 1).
+				byte(0x05, 2).
+				byte(0x06, 0x01).
+				byte(0x07, 3).
+				byte(0x08, 0x00).
+				byte(0x09, 4).
+				byte(0x0a, 5).
+				byte(0x0b, 6).
+				qword(0x0c, 1<<20).
+				word(0x14, 0x0003).
+				byte(0x16, 0x03).
+				byte(0x17, 2).
+				word(0x18, 0x0010).
+				word(0x1a, 0x0011).
+				structure(),
+			fi: &structures.FirmwareInventory{
+				Header:                 header(45, 0x1c),
+				ComponentName:          "BMC",
+				Version:                "1.2.3",
+				VersionFormat:          0x01,
+				ID:                     "abc",
+				ReleaseDate:            "2022-01-01",
+				Manufacturer:           "DigitalOcean",
+				LowestSupportedVersion: "1.0.0",
+				ImageSize:              1 << 20,
+				Characteristics:        0x0003,
+				State:                  0x03,
+				AssociatedComponents:   []uint16{0x0010, 0x0011},
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fi, err := structures.ParseFirmwareInventory(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.fi, fi); diff != "" {
+				t.Fatalf("unexpected firmware inventory (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
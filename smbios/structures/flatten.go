@@ -0,0 +1,144 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// flattenKey returns the Flatten key for a dmidecode keyword, such as
+// "system.serial_number" for "system-serial-number".
+func flattenKey(keyword string) string {
+	k := strings.Replace(keyword, "-", ".", 1)
+	return strings.Replace(k, "-", "_", -1)
+}
+
+// Flatten returns the Table's identifying and inventory values as a map of
+// dotted keys to values, such as "system.uuid" or
+// "memory.device.0.size_bytes", suitable for configuration management facts
+// systems.
+//
+// Keys are lower case and stable across releases.  Repeated structures such
+// as processors and memory devices are numbered from 0 in table order, and
+// always have a "handle" key, so that no number is missing even when all of
+// a structure's other values are absent.
+// Values are formatted as strings with surrounding whitespace removed, and
+// numbers are formatted in decimal without units.  Values which are absent
+// or unknown are omitted, so callers should not assume that any key is
+// present.
+func (t *Table) Flatten() (map[string]string, error) {
+	si, err := NewSystemInfo(t.Structures)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]string)
+	set := func(key, v string) {
+		if v = strings.TrimSpace(v); v != "" {
+			m[key] = v
+		}
+	}
+	setUint := func(key string, v uint64, ok bool) {
+		if ok {
+			m[key] = strconv.FormatUint(v, 10)
+		}
+	}
+
+	for _, f := range smbios.QuickFields() {
+		// The UUID is formatted below, and processors are numbered.
+		if f.UUID || f.Type == TypeProcessor {
+			continue
+		}
+
+		v, _ := si.field(f.Type, f.Offset)
+		set(flattenKey(f.Keyword), v)
+	}
+	if si.HasUUID() {
+		set("system.uuid", si.UUID().String())
+	}
+
+	var n int
+	for _, s := range t.Structures {
+		if s.Header.Type != TypeProcessor {
+			continue
+		}
+
+		p, err := ParseProcessor(s)
+		if err = processed(p, err); err != nil {
+			return nil, err
+		}
+
+		prefix := "processor." + strconv.Itoa(n) + "."
+		n++
+
+		setUint(prefix+"handle", uint64(p.Header.Handle), true)
+		set(prefix+"socket", p.SocketDesignation)
+		set(prefix+"manufacturer", p.ProcessorManufacturer)
+		set(prefix+"version", p.ProcessorVersion)
+		setUint(prefix+"core_count", uint64(p.Cores()), p.Cores() > 0)
+		setUint(prefix+"thread_count", uint64(p.Threads()), p.Threads() > 0)
+
+		speed, ok := p.EffectiveMaxSpeed()
+		setUint(prefix+"max_speed_mhz", uint64(speed), ok)
+	}
+
+	mds, err := t.MemoryDevices()
+	if err != nil {
+		return nil, err
+	}
+
+	var total uint64
+	for i, md := range mds {
+		prefix := "memory.device." + strconv.Itoa(i) + "."
+
+		setUint(prefix+"handle", uint64(md.Header.Handle), true)
+		set(prefix+"locator", md.DeviceLocator)
+		set(prefix+"bank_locator", md.BankLocator)
+		set(prefix+"manufacturer", md.Manufacturer)
+		set(prefix+"serial_number", md.SerialNumber)
+		set(prefix+"part_number", md.PartNumber)
+
+		size, ok := md.SizeBytes()
+		setUint(prefix+"size_bytes", size, ok)
+
+		// Exclude memory used as a cache, which is not addressable.
+		volatile, persistent := md.Capacity()
+		total += volatile + persistent
+
+		speed, ok := md.EffectiveSpeed()
+		setUint(prefix+"speed_mts", uint64(speed), ok)
+	}
+	if len(mds) > 0 {
+		setUint("memory.total_bytes", total, true)
+	}
+
+	return m, nil
+}
+
+// FlattenKeys returns the keys of a map produced by Flatten in sorted order,
+// for deterministic output.
+func FlattenKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,103 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTableFlatten(t *testing.T) {
+	tbl := structures.NewTable(&smbios.Table{
+		Structures: []*smbios.Structure{
+			newBuilder(0, 0x12, "Acme", "1.2.3 ", "01/02/2024").
+				byte(0x04, 1).
+				byte(0x05, 2).
+				byte(0x08, 3).
+				structure(),
+			newBuilder(1, 0x1b, "Acme", "Server").
+				byte(0x04, 1).
+				byte(0x05, 2).
+				bytes(0x08, []byte{
+					0x33, 0x22, 0x11, 0x00, 0x55, 0x44, 0x77, 0x66,
+					0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff,
+				}).
+				structure(),
+			newBuilder(4, 0x2a, "CPU0", "Intel(R) Corporation").
+				byte(0x04, 1).
+				byte(0x07, 2).
+				word(0x14, 3800).
+				byte(0x23, 32).
+				byte(0x25, 64).
+				structure(),
+			newBuilder(17, 0x1b, "DIMM A1", "Samsung", "M393A4K40DB3-CWE").
+				word(0x0c, 16384).
+				byte(0x10, 1).
+				word(0x15, 3200).
+				byte(0x17, 2).
+				byte(0x1a, 3).
+				structure(),
+			// Empty slot.
+			newBuilder(17, 0x1b, "DIMM A2").
+				byte(0x10, 1).
+				structure(),
+			// Empty slot with no values at all.
+			newBuilder(17, 0x1b).structure(),
+		},
+	})
+
+	m, err := tbl.Flatten()
+	if err != nil {
+		t.Fatalf("failed to flatten: %v", err)
+	}
+
+	// These keys are part of the package's API and must remain stable.
+	want := map[string]string{
+		"bios.vendor":                  "Acme",
+		"bios.version":                 "1.2.3",
+		"bios.release_date":            "01/02/2024",
+		"system.manufacturer":          "Acme",
+		"system.product_name":          "Server",
+		"system.uuid":                  "00112233-4455-6677-8899-aabbccddeeff",
+		"processor.0.handle":           "256",
+		"processor.0.socket":           "CPU0",
+		"processor.0.manufacturer":     "Intel(R) Corporation",
+		"processor.0.core_count":       "32",
+		"processor.0.thread_count":     "64",
+		"processor.0.max_speed_mhz":    "3800",
+		"memory.device.0.handle":       "256",
+		"memory.device.0.locator":      "DIMM A1",
+		"memory.device.0.manufacturer": "Samsung",
+		"memory.device.0.part_number":  "M393A4K40DB3-CWE",
+		"memory.device.0.size_bytes":   "17179869184",
+		"memory.device.0.speed_mts":    "3200",
+		"memory.device.1.handle":       "256",
+		"memory.device.1.locator":      "DIMM A2",
+		"memory.device.2.handle":       "256",
+		"memory.total_bytes":           "17179869184",
+	}
+
+	if diff := cmp.Diff(want, m); diff != "" {
+		t.Fatalf("unexpected flattened values (-want +got):\n%s", diff)
+	}
+
+	keys := structures.FlattenKeys(m)
+	if diff := cmp.Diff("bios.release_date", keys[0]); diff != "" {
+		t.Fatalf("unexpected first key (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"fmt"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeGroupAssociations is the structure type of Group Associations (type 14).
+const TypeGroupAssociations = 14
+
+// GroupAssociations is a Group Associations (type 14) structure, which
+// describes a logical grouping of other structures, such as the processors
+// and caches of a multi-processor system.
+type GroupAssociations struct {
+	Header smbios.Header
+
+	GroupName string
+	Items     []GroupItem
+}
+
+// A GroupItem is a member of a group described by GroupAssociations.
+type GroupItem struct {
+	Type   uint8
+	Handle uint16
+}
+
+// ParseGroupAssociations parses a GroupAssociations from a Structure.
+func ParseGroupAssociations(s *smbios.Structure) (*GroupAssociations, error) {
+	if err := checkStructure(s, TypeGroupAssociations, "group associations", 0x05); err != nil {
+		return nil, err
+	}
+
+	// Each item is a type byte followed by a handle, and the number of
+	// items is determined by the structure's length.
+	l := len(s.Formatted) + headerLen - 0x05
+	if l%3 != 0 {
+		return nil, fmt.Errorf("SMBIOS group associations structure has %d bytes of items, expected a multiple of 3", l)
+	}
+
+	f := fields{s: s}
+
+	n := l / 3
+	items := make([]GroupItem, 0, n)
+	for i := 0; i < n; i++ {
+		off := 0x05 + 3*i
+		items = append(items, GroupItem{
+			Type:   f.byte(off),
+			Handle: f.word(off + 1),
+		})
+	}
+
+	return &GroupAssociations{
+		Header: s.Header,
+
+		GroupName: f.str(0x04),
+		Items:     items,
+	}, nil
+}
+
+// Members returns the Structures in ss which are members of the group, in the
+// order the group lists them.  Items which do not refer to a Structure in ss
+// of the expected type are skipped.
+func (g *GroupAssociations) Members(ss []*smbios.Structure) []*smbios.Structure {
+	byHandle := make(map[uint16]*smbios.Structure, len(ss))
+	for _, s := range ss {
+		byHandle[s.Header.Handle] = s
+	}
+
+	var out []*smbios.Structure
+	for _, it := range g.Items {
+		s, ok := byHandle[it.Handle]
+		if !ok || s.Header.Type != it.Type {
+			continue
+		}
+
+		out = append(out, s)
+	}
+
+	return out
+}
@@ -0,0 +1,103 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseGroupAssociations(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		g    *structures.GroupAssociations
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s:    newBuilder(13, 0x05).structure(),
+		},
+		{
+			name: "too short",
+			s:    newBuilder(14, 0x04).structure(),
+		},
+		{
+			name: "partial item",
+			s:    newBuilder(14, 0x07).structure(),
+		},
+		{
+			name: "OK",
+			s: newBuilder(14, 0x0b, "Cpu Module").
+				byte(0x04, 1).
+				byte(0x05, 4).
+				word(0x06, 0x0400).
+				byte(0x08, 7).
+				word(0x09, 0x0700).
+				structure(),
+			g: &structures.GroupAssociations{
+				Header:    header(14, 0x0b),
+				GroupName: "Cpu Module",
+				Items: []structures.GroupItem{
+					{Type: 4, Handle: 0x0400},
+					{Type: 7, Handle: 0x0700},
+				},
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := structures.ParseGroupAssociations(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.g, g); diff != "" {
+				t.Fatalf("unexpected group associations (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGroupAssociationsMembers(t *testing.T) {
+	cpu := &smbios.Structure{Header: smbios.Header{Type: 4, Handle: 0x0400}}
+	cache := &smbios.Structure{Header: smbios.Header{Type: 7, Handle: 0x0700}}
+	other := &smbios.Structure{Header: smbios.Header{Type: 1, Handle: 0x0100}}
+
+	g := &structures.GroupAssociations{
+		Items: []structures.GroupItem{
+			{Type: 7, Handle: 0x0700},
+			{Type: 4, Handle: 0x0400},
+			// Type does not match.
+			{Type: 4, Handle: 0x0100},
+			// Handle does not exist.
+			{Type: 4, Handle: 0x0401},
+		},
+	}
+
+	got := g.Members([]*smbios.Structure{cpu, cache, other})
+	if diff := cmp.Diff([]*smbios.Structure{cache, cpu}, got); diff != "" {
+		t.Fatalf("unexpected members (-want +got):\n%s", diff)
+	}
+}
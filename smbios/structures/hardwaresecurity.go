@@ -0,0 +1,101 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"fmt"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeHardwareSecurity is the structure type of Hardware Security (type 24).
+const TypeHardwareSecurity = 24
+
+// A HardwareSecurity is a Hardware Security (type 24) structure, which
+// describes the system-wide hardware security settings.
+type HardwareSecurity struct {
+	Header smbios.Header
+
+	// Decoded from the hardware security settings field.
+	PowerOnPassword       HardwareSecurityStatus
+	KeyboardPassword      HardwareSecurityStatus
+	AdministratorPassword HardwareSecurityStatus
+	FrontPanelReset       HardwareSecurityStatus
+}
+
+// ParseHardwareSecurity parses a HardwareSecurity from a Structure.
+func ParseHardwareSecurity(s *smbios.Structure) (*HardwareSecurity, error) {
+	if err := checkStructure(s, TypeHardwareSecurity, "hardware security", 0x05); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+	settings := f.byte(0x04)
+
+	return &HardwareSecurity{
+		Header: s.Header,
+
+		PowerOnPassword:       HardwareSecurityStatus((settings >> 6) & 0x03),
+		KeyboardPassword:      HardwareSecurityStatus((settings >> 4) & 0x03),
+		AdministratorPassword: HardwareSecurityStatus((settings >> 2) & 0x03),
+		FrontPanelReset:       HardwareSecurityStatus(settings & 0x03),
+	}, nil
+}
+
+// A HardwareSecurityStatus is the status of a HardwareSecurity setting.
+type HardwareSecurityStatus uint8
+
+// Possible HardwareSecurityStatus values.
+const (
+	HardwareSecurityDisabled       HardwareSecurityStatus = 0x00
+	HardwareSecurityEnabled        HardwareSecurityStatus = 0x01
+	HardwareSecurityNotImplemented HardwareSecurityStatus = 0x02
+	HardwareSecurityUnknown        HardwareSecurityStatus = 0x03
+)
+
+// hardwareSecurityStatusNames are the names of each HardwareSecurityStatus,
+// as given by dmidecode.
+var hardwareSecurityStatusNames = map[HardwareSecurityStatus]string{
+	HardwareSecurityDisabled:       "Disabled",
+	HardwareSecurityEnabled:        "Enabled",
+	HardwareSecurityNotImplemented: "Not Implemented",
+	HardwareSecurityUnknown:        "Unknown",
+}
+
+// String returns the name of a HardwareSecurityStatus as given by dmidecode.
+func (st HardwareSecurityStatus) String() string {
+	if s, ok := hardwareSecurityStatusNames[st]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("HardwareSecurityStatus(%d)", uint8(st))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding st as its name.
+func (st HardwareSecurityStatus) MarshalText() ([]byte, error) {
+	return []byte(st.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (st *HardwareSecurityStatus) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "HardwareSecurityStatus", func(v uint8) string { return HardwareSecurityStatus(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*st = HardwareSecurityStatus(v)
+	return nil
+}
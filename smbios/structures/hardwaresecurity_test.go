@@ -0,0 +1,72 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseHardwareSecurity(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		hs   *structures.HardwareSecurity
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s:    newBuilder(23, 0x05).structure(),
+		},
+		{
+			name: "too short",
+			s:    newBuilder(24, 0x04).structure(),
+		},
+		{
+			name: "OK",
+			// Power-on enabled, keyboard not implemented, administrator
+			// disabled, front panel reset unknown.
+			s: newBuilder(24, 0x05).byte(0x04, 0x01<<6|0x02<<4|0x00<<2|0x03).structure(),
+			hs: &structures.HardwareSecurity{
+				Header:                header(24, 0x05),
+				PowerOnPassword:       structures.HardwareSecurityEnabled,
+				KeyboardPassword:      structures.HardwareSecurityNotImplemented,
+				AdministratorPassword: structures.HardwareSecurityDisabled,
+				FrontPanelReset:       structures.HardwareSecurityUnknown,
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hs, err := structures.ParseHardwareSecurity(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.hs, hs); diff != "" {
+				t.Fatalf("unexpected hardware security (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
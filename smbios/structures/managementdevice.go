@@ -0,0 +1,169 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"fmt"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeManagementDevice is the structure type of a Management Device
+// (type 34).
+const TypeManagementDevice = 34
+
+// A ManagementDevice is a Management Device (type 34) structure, which
+// describes a device used to monitor system hardware, such as a temperature
+// or voltage sensor chip.
+type ManagementDevice struct {
+	Header smbios.Header
+
+	Description string
+	DeviceType  ManagementDeviceType
+	Address     uint32
+	AddressType ManagementDeviceAddressType
+}
+
+// ParseManagementDevice parses a ManagementDevice from a Structure.
+func ParseManagementDevice(s *smbios.Structure) (*ManagementDevice, error) {
+	if err := checkStructure(s, TypeManagementDevice, "management device", 0x0b); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+
+	return &ManagementDevice{
+		Header: s.Header,
+
+		Description: f.str(0x04),
+		DeviceType:  ManagementDeviceType(f.byte(0x05)),
+		Address:     f.dword(0x06),
+		AddressType: ManagementDeviceAddressType(f.byte(0x0a)),
+	}, nil
+}
+
+// A ManagementDeviceType is the type of a ManagementDevice.
+type ManagementDeviceType uint8
+
+// Possible ManagementDeviceType values.
+const (
+	ManagementDeviceTypeOther    ManagementDeviceType = 0x01
+	ManagementDeviceTypeUnknown  ManagementDeviceType = 0x02
+	ManagementDeviceTypeLM75     ManagementDeviceType = 0x03
+	ManagementDeviceTypeLM78     ManagementDeviceType = 0x04
+	ManagementDeviceTypeLM79     ManagementDeviceType = 0x05
+	ManagementDeviceTypeLM80     ManagementDeviceType = 0x06
+	ManagementDeviceTypeLM81     ManagementDeviceType = 0x07
+	ManagementDeviceTypeADM9240  ManagementDeviceType = 0x08
+	ManagementDeviceTypeDS1780   ManagementDeviceType = 0x09
+	ManagementDeviceTypeMAX1617  ManagementDeviceType = 0x0a
+	ManagementDeviceTypeGL518SM  ManagementDeviceType = 0x0b
+	ManagementDeviceTypeW83781D  ManagementDeviceType = 0x0c
+	ManagementDeviceTypeHT82H791 ManagementDeviceType = 0x0d
+)
+
+// managementDeviceTypeNames are the names of each ManagementDeviceType, as
+// given by dmidecode.
+var managementDeviceTypeNames = map[ManagementDeviceType]string{
+	ManagementDeviceTypeOther:    "Other",
+	ManagementDeviceTypeUnknown:  "Unknown",
+	ManagementDeviceTypeLM75:     "LM75",
+	ManagementDeviceTypeLM78:     "LM78",
+	ManagementDeviceTypeLM79:     "LM79",
+	ManagementDeviceTypeLM80:     "LM80",
+	ManagementDeviceTypeLM81:     "LM81",
+	ManagementDeviceTypeADM9240:  "ADM9240",
+	ManagementDeviceTypeDS1780:   "DS1780",
+	ManagementDeviceTypeMAX1617:  "MAX1617",
+	ManagementDeviceTypeGL518SM:  "GL518SM",
+	ManagementDeviceTypeW83781D:  "W83781D",
+	ManagementDeviceTypeHT82H791: "HT82H791",
+}
+
+// String returns the name of a ManagementDeviceType as given by dmidecode.
+func (t ManagementDeviceType) String() string {
+	if s, ok := managementDeviceTypeNames[t]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("ManagementDeviceType(%d)", uint8(t))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding t as its name.
+func (t ManagementDeviceType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (t *ManagementDeviceType) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "ManagementDeviceType", func(v uint8) string { return ManagementDeviceType(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*t = ManagementDeviceType(v)
+	return nil
+}
+
+// A ManagementDeviceAddressType is the type of a ManagementDevice's
+// address.
+type ManagementDeviceAddressType uint8
+
+// Possible ManagementDeviceAddressType values.
+const (
+	ManagementDeviceAddressOther   ManagementDeviceAddressType = 0x01
+	ManagementDeviceAddressUnknown ManagementDeviceAddressType = 0x02
+	ManagementDeviceAddressIOPort  ManagementDeviceAddressType = 0x03
+	ManagementDeviceAddressMemory  ManagementDeviceAddressType = 0x04
+	ManagementDeviceAddressSMBus   ManagementDeviceAddressType = 0x05
+)
+
+// managementDeviceAddressTypeNames are the names of each
+// ManagementDeviceAddressType, as given by dmidecode.
+var managementDeviceAddressTypeNames = map[ManagementDeviceAddressType]string{
+	ManagementDeviceAddressOther:   "Other",
+	ManagementDeviceAddressUnknown: "Unknown",
+	ManagementDeviceAddressIOPort:  "I/O Port",
+	ManagementDeviceAddressMemory:  "Memory",
+	ManagementDeviceAddressSMBus:   "SMBus",
+}
+
+// String returns the name of a ManagementDeviceAddressType as given by
+// dmidecode.
+func (t ManagementDeviceAddressType) String() string {
+	if s, ok := managementDeviceAddressTypeNames[t]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("ManagementDeviceAddressType(%d)", uint8(t))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding t as its name.
+func (t ManagementDeviceAddressType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (t *ManagementDeviceAddressType) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "ManagementDeviceAddressType", func(v uint8) string { return ManagementDeviceAddressType(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*t = ManagementDeviceAddressType(v)
+	return nil
+}
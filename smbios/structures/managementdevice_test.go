@@ -0,0 +1,95 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseManagementDevice(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		md   *structures.ManagementDevice
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s:    newBuilder(33, 0x0b).structure(),
+		},
+		{
+			name: "too short",
+			s:    newBuilder(34, 0x0a).structure(),
+		},
+		{
+			name: "OK",
+			s: newBuilder(34, 0x0b, "LM78-1").
+				byte(0x04, 1).
+				byte(0x05, 0x04).
+				dword(0x06, 0x00000290).
+				byte(0x0a, 0x03).
+				structure(),
+			md: &structures.ManagementDevice{
+				Header:      header(34, 0x0b),
+				Description: "LM78-1",
+				DeviceType:  structures.ManagementDeviceTypeLM78,
+				Address:     0x290,
+				AddressType: structures.ManagementDeviceAddressIOPort,
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md, err := structures.ParseManagementDevice(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.md, md); diff != "" {
+				t.Fatalf("unexpected management device (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestManagementDeviceStrings(t *testing.T) {
+	got := []string{
+		structures.ManagementDeviceTypeADM9240.String(),
+		structures.ManagementDeviceType(0xff).String(),
+		structures.ManagementDeviceAddressSMBus.String(),
+		structures.ManagementDeviceAddressType(0xff).String(),
+	}
+
+	want := []string{
+		"ADM9240",
+		"ManagementDeviceType(255)",
+		"SMBus",
+		"ManagementDeviceAddressType(255)",
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected strings (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeMemoryArrayMappedAddress is the structure type of a Memory Array Mapped
+// Address (type 19).
+const TypeMemoryArrayMappedAddress = 19
+
+// A MemoryArrayMappedAddress is a Memory Array Mapped Address (type 19)
+// structure, which describes the physical address range mapped to a
+// PhysicalMemoryArray.
+type MemoryArrayMappedAddress struct {
+	Header smbios.Header
+
+	// SMBIOS 2.1+.
+	StartingAddress   uint32
+	EndingAddress     uint32
+	MemoryArrayHandle uint16
+	PartitionWidth    uint8
+
+	// SMBIOS 2.7+.
+	ExtendedStartingAddress uint64
+	ExtendedEndingAddress   uint64
+}
+
+// ParseMemoryArrayMappedAddress parses a MemoryArrayMappedAddress from a
+// Structure.
+func ParseMemoryArrayMappedAddress(s *smbios.Structure) (*MemoryArrayMappedAddress, error) {
+	// The SMBIOS 2.1 structure ends after the partition width field.
+	if err := checkStructure(s, TypeMemoryArrayMappedAddress, "memory array mapped address", 0x0f); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+
+	return &MemoryArrayMappedAddress{
+		Header: s.Header,
+
+		StartingAddress:   f.dword(0x04),
+		EndingAddress:     f.dword(0x08),
+		MemoryArrayHandle: f.word(0x0c),
+		PartitionWidth:    f.byte(0x0e),
+
+		ExtendedStartingAddress: f.qword(0x0f),
+		ExtendedEndingAddress:   f.qword(0x17),
+	}, nil
+}
+
+// MemoryArrayMappedAddresses parses all MemoryArrayMappedAddresses from a
+// list of Structures, ignoring Structures of other types.
+func MemoryArrayMappedAddresses(ss []*smbios.Structure) ([]*MemoryArrayMappedAddress, error) {
+	var mas []*MemoryArrayMappedAddress
+	for _, s := range ss {
+		if s.Header.Type != TypeMemoryArrayMappedAddress {
+			continue
+		}
+
+		ma, err := ParseMemoryArrayMappedAddress(s)
+		if err = processed(ma, err); err != nil {
+			return nil, err
+		}
+
+		mas = append(mas, ma)
+	}
+
+	return mas, nil
+}
+
+// AddressRange returns the inclusive range of physical byte addresses mapped
+// to the memory array, consulting the SMBIOS 2.7+ extended address fields
+// when required.  If the range is unknown or invalid, AddressRange returns
+// false.
+func (ma *MemoryArrayMappedAddress) AddressRange() (start, end uint64, ok bool) {
+	return addressRange(
+		ma.StartingAddress, ma.EndingAddress,
+		ma.ExtendedStartingAddress, ma.ExtendedEndingAddress,
+	)
+}
+
+// SizeBytes returns the number of bytes mapped to the memory array.  If the
+// address range is unknown, SizeBytes returns false.
+func (ma *MemoryArrayMappedAddress) SizeBytes() (uint64, bool) {
+	start, end, ok := ma.AddressRange()
+	if !ok {
+		return 0, false
+	}
+
+	return end - start + 1, true
+}
+
+// Contains reports whether the physical byte address addr is mapped to the
+// memory array.
+func (ma *MemoryArrayMappedAddress) Contains(addr uint64) bool {
+	start, end, ok := ma.AddressRange()
+	return ok && addr >= start && addr <= end
+}
+
+// addressRange interprets the starting and ending address fields of a mapped
+// address structure and their extended counterparts as an inclusive range of
+// byte addresses.
+func addressRange(start32, end32 uint32, start64, end64 uint64) (start, end uint64, ok bool) {
+	switch {
+	case start32 == 0xffffffff:
+		// The extended fields are specified in bytes.
+		start, end = start64, end64
+		if start == 0 && end == 0 {
+			return 0, 0, false
+		}
+	case start32 == 0 && end32 == 0:
+		return 0, 0, false
+	default:
+		// The address fields are specified in kilobytes, and the ending
+		// address refers to the last kilobyte of the range.
+		start = uint64(start32) << 10
+		end = (uint64(end32)+1)<<10 - 1
+	}
+
+	if end < start {
+		return 0, 0, false
+	}
+
+	return start, end, true
+}
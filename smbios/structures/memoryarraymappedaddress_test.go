@@ -0,0 +1,159 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseMemoryArrayMappedAddress(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		ma   *structures.MemoryArrayMappedAddress
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s:    newBuilder(20, 0x0f).structure(),
+		},
+		{
+			name: "too short",
+			s:    newBuilder(19, 0x0e).structure(),
+		},
+		{
+			name: "SMBIOS 2.1",
+			s: newBuilder(19, 0x0f).
+				dword(0x04, 0x00000000).
+				dword(0x08, 0x007fffff).
+				word(0x0c, 0x1000).
+				byte(0x0e, 0x02).
+				structure(),
+			ma: &structures.MemoryArrayMappedAddress{
+				Header:            header(19, 0x0f),
+				EndingAddress:     0x007fffff,
+				MemoryArrayHandle: 0x1000,
+				PartitionWidth:    2,
+			},
+			ok: true,
+		},
+		{
+			name: "SMBIOS 2.7",
+			s: newBuilder(19, 0x1f).
+				dword(0x04, 0xffffffff).
+				dword(0x08, 0xffffffff).
+				word(0x0c, 0x1000).
+				byte(0x0e, 0x01).
+				qword(0x0f, 0x0000010000000000).
+				qword(0x17, 0x000001ffffffffff).
+				structure(),
+			ma: &structures.MemoryArrayMappedAddress{
+				Header:                  header(19, 0x1f),
+				StartingAddress:         0xffffffff,
+				EndingAddress:           0xffffffff,
+				MemoryArrayHandle:       0x1000,
+				PartitionWidth:          1,
+				ExtendedStartingAddress: 0x0000010000000000,
+				ExtendedEndingAddress:   0x000001ffffffffff,
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ma, err := structures.ParseMemoryArrayMappedAddress(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.ma, ma); diff != "" {
+				t.Fatalf("unexpected memory array mapped address (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMemoryArrayMappedAddressRange(t *testing.T) {
+	tests := []struct {
+		name       string
+		ma         *structures.MemoryArrayMappedAddress
+		start, end uint64
+		ok         bool
+	}{
+		{
+			name: "unknown",
+			ma:   &structures.MemoryArrayMappedAddress{},
+		},
+		{
+			name: "extended unknown",
+			ma:   &structures.MemoryArrayMappedAddress{StartingAddress: 0xffffffff},
+		},
+		{
+			name: "reversed",
+			ma: &structures.MemoryArrayMappedAddress{
+				StartingAddress: 0x00100000,
+				EndingAddress:   0x000fffff,
+			},
+		},
+		{
+			name: "kilobytes",
+			ma:   &structures.MemoryArrayMappedAddress{EndingAddress: 0x007fffff},
+			end:  8<<30 - 1,
+			ok:   true,
+		},
+		{
+			name: "extended",
+			ma: &structures.MemoryArrayMappedAddress{
+				StartingAddress:         0xffffffff,
+				ExtendedStartingAddress: 1 << 40,
+				ExtendedEndingAddress:   2<<40 - 1,
+			},
+			start: 1 << 40,
+			end:   2<<40 - 1,
+			ok:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, ok := tt.ma.AddressRange()
+
+			if diff := cmp.Diff(tt.ok, ok); diff != "" {
+				t.Fatalf("unexpected range presence (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff([]uint64{tt.start, tt.end}, []uint64{start, end}); diff != "" {
+				t.Fatalf("unexpected range (-want +got):\n%s", diff)
+			}
+
+			size, _ := tt.ma.SizeBytes()
+			if tt.ok && size != tt.end-tt.start+1 {
+				t.Fatalf("unexpected size: %d", size)
+			}
+
+			if tt.ok && (!tt.ma.Contains(tt.end) || tt.ma.Contains(tt.end+1)) {
+				t.Fatal("unexpected containment at the end of the range")
+			}
+		})
+	}
+}
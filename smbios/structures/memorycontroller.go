@@ -0,0 +1,383 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"fmt"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeMemoryController is the structure type of Memory Controller
+// Information (type 5).
+const TypeMemoryController = 5
+
+// A MemoryController is a Memory Controller Information (type 5) structure,
+// which describes a memory controller and the memory modules it supports.
+//
+// This structure is obsolete as of SMBIOS 2.1, but is still reported by many
+// older systems.
+type MemoryController struct {
+	Header smbios.Header
+
+	// SMBIOS 2.0+.
+	ErrorDetectingMethod        ErrorDetectingMethod
+	ErrorCorrectingCapabilities ErrorCorrectingCapabilities
+	SupportedInterleave         MemoryInterleave
+	CurrentInterleave           MemoryInterleave
+	MaximumMemoryModuleSize     uint8
+	SupportedSpeeds             MemorySpeeds
+	SupportedMemoryTypes        MemoryModuleTypes
+	MemoryModuleVoltage         MemoryModuleVoltages
+	MemoryModuleHandles         []uint16
+
+	// SMBIOS 2.1+.
+	EnabledErrorCorrectingCapabilities ErrorCorrectingCapabilities
+}
+
+// ParseMemoryController parses a MemoryController from a Structure.
+func ParseMemoryController(s *smbios.Structure) (*MemoryController, error) {
+	// The structure ends after the number of associated memory slots and
+	// its list of memory module handles.
+	if err := checkStructure(s, TypeMemoryController, "memory controller", 0x0f); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+
+	mc := &MemoryController{
+		Header: s.Header,
+
+		ErrorDetectingMethod:        ErrorDetectingMethod(f.byte(0x04)),
+		ErrorCorrectingCapabilities: ErrorCorrectingCapabilities(f.byte(0x05)),
+		SupportedInterleave:         MemoryInterleave(f.byte(0x06)),
+		CurrentInterleave:           MemoryInterleave(f.byte(0x07)),
+		MaximumMemoryModuleSize:     f.byte(0x08),
+		SupportedSpeeds:             MemorySpeeds(f.word(0x09)),
+		SupportedMemoryTypes:        MemoryModuleTypes(f.word(0x0b)),
+		MemoryModuleVoltage:         MemoryModuleVoltages(f.byte(0x0d)),
+	}
+
+	// A list of memory module handles follows the count.
+	n := int(f.byte(0x0e))
+	if !f.has(0x0f, n*2) {
+		return nil, errShortList("memory controller", "memory module handles", n)
+	}
+
+	for i := 0; i < n; i++ {
+		mc.MemoryModuleHandles = append(mc.MemoryModuleHandles, f.word(0x0f+i*2))
+	}
+
+	mc.EnabledErrorCorrectingCapabilities = ErrorCorrectingCapabilities(f.byte(0x0f + n*2))
+
+	return mc, nil
+}
+
+// MaximumModuleSizeBytes returns the maximum size of a single memory module
+// supported by the controller in bytes.  If the size cannot be represented,
+// MaximumModuleSizeBytes returns false.
+func (mc *MemoryController) MaximumModuleSizeBytes() (uint64, bool) {
+	// The size is specified as a power of 2 in megabytes.
+	n := uint(mc.MaximumMemoryModuleSize) + 20
+	if n >= 64 {
+		return 0, false
+	}
+
+	return 1 << n, true
+}
+
+// An ErrorDetectingMethod is the error detecting method of a memory
+// controller.
+type ErrorDetectingMethod uint8
+
+// Possible ErrorDetectingMethod values.
+const (
+	ErrorDetectingMethodOther      ErrorDetectingMethod = 0x01
+	ErrorDetectingMethodUnknown    ErrorDetectingMethod = 0x02
+	ErrorDetectingMethodNone       ErrorDetectingMethod = 0x03
+	ErrorDetectingMethodParity8Bit ErrorDetectingMethod = 0x04
+	ErrorDetectingMethodECC32Bit   ErrorDetectingMethod = 0x05
+	ErrorDetectingMethodECC64Bit   ErrorDetectingMethod = 0x06
+	ErrorDetectingMethodECC128Bit  ErrorDetectingMethod = 0x07
+	ErrorDetectingMethodCRC        ErrorDetectingMethod = 0x08
+)
+
+// errorDetectingMethodNames are the names of each ErrorDetectingMethod, as
+// given in the SMBIOS specification.
+var errorDetectingMethodNames = map[ErrorDetectingMethod]string{
+	ErrorDetectingMethodOther:      "Other",
+	ErrorDetectingMethodUnknown:    "Unknown",
+	ErrorDetectingMethodNone:       "None",
+	ErrorDetectingMethodParity8Bit: "8-bit Parity",
+	ErrorDetectingMethodECC32Bit:   "32-bit ECC",
+	ErrorDetectingMethodECC64Bit:   "64-bit ECC",
+	ErrorDetectingMethodECC128Bit:  "128-bit ECC",
+	ErrorDetectingMethodCRC:        "CRC",
+}
+
+// String returns the name of an ErrorDetectingMethod as given in the SMBIOS
+// specification.
+func (m ErrorDetectingMethod) String() string {
+	if s, ok := errorDetectingMethodNames[m]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("ErrorDetectingMethod(%d)", uint8(m))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding m as its name.
+func (m ErrorDetectingMethod) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (m *ErrorDetectingMethod) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "ErrorDetectingMethod", func(v uint8) string { return ErrorDetectingMethod(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*m = ErrorDetectingMethod(v)
+	return nil
+}
+
+// ErrorCorrectingCapabilities is a bitfield which describes the error
+// correcting capabilities of a memory controller.
+type ErrorCorrectingCapabilities uint8
+
+// Possible ErrorCorrectingCapabilities bits.
+const (
+	ErrorCorrectingOther          ErrorCorrectingCapabilities = 1 << 0
+	ErrorCorrectingUnknown        ErrorCorrectingCapabilities = 1 << 1
+	ErrorCorrectingNone           ErrorCorrectingCapabilities = 1 << 2
+	ErrorCorrectingSingleBit      ErrorCorrectingCapabilities = 1 << 3
+	ErrorCorrectingDoubleBit      ErrorCorrectingCapabilities = 1 << 4
+	ErrorCorrectingErrorScrubbing ErrorCorrectingCapabilities = 1 << 5
+)
+
+// errorCorrectingCapabilityNames are the names of each
+// ErrorCorrectingCapabilities bit, indexed by bit number, as given in the
+// SMBIOS specification.
+var errorCorrectingCapabilityNames = []string{
+	"Other",
+	"Unknown",
+	"None",
+	"Single-bit Error Correcting",
+	"Double-bit Error Correcting",
+	"Error Scrubbing",
+}
+
+// Names returns the names of each bit set in c, in bit order.  Reserved bits
+// are ignored.
+func (c ErrorCorrectingCapabilities) Names() []string {
+	return bitNames(uint64(c), errorCorrectingCapabilityNames)
+}
+
+// String returns the names of each bit set in c separated by commas, or
+// "None" if no bits are set.
+func (c ErrorCorrectingCapabilities) String() string {
+	return joinNames(c.Names(), ", ")
+}
+
+// MarshalJSON implements json.Marshaler, encoding c as an array of the names
+// of each bit set in c.
+func (c ErrorCorrectingCapabilities) MarshalJSON() ([]byte, error) {
+	return marshalNames(c.Names())
+}
+
+// A MemoryInterleave is the interleave configuration of a memory controller.
+type MemoryInterleave uint8
+
+// Possible MemoryInterleave values.
+const (
+	MemoryInterleaveOther      MemoryInterleave = 0x01
+	MemoryInterleaveUnknown    MemoryInterleave = 0x02
+	MemoryInterleaveOneWay     MemoryInterleave = 0x03
+	MemoryInterleaveTwoWay     MemoryInterleave = 0x04
+	MemoryInterleaveFourWay    MemoryInterleave = 0x05
+	MemoryInterleaveEightWay   MemoryInterleave = 0x06
+	MemoryInterleaveSixteenWay MemoryInterleave = 0x07
+)
+
+// memoryInterleaveNames are the names of each MemoryInterleave, as given in
+// the SMBIOS specification.
+var memoryInterleaveNames = map[MemoryInterleave]string{
+	MemoryInterleaveOther:      "Other",
+	MemoryInterleaveUnknown:    "Unknown",
+	MemoryInterleaveOneWay:     "One-way Interleave",
+	MemoryInterleaveTwoWay:     "Two-way Interleave",
+	MemoryInterleaveFourWay:    "Four-way Interleave",
+	MemoryInterleaveEightWay:   "Eight-way Interleave",
+	MemoryInterleaveSixteenWay: "Sixteen-way Interleave",
+}
+
+// String returns the name of a MemoryInterleave as given in the SMBIOS
+// specification.
+func (i MemoryInterleave) String() string {
+	if s, ok := memoryInterleaveNames[i]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("MemoryInterleave(%d)", uint8(i))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding i as its name.
+func (i MemoryInterleave) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (i *MemoryInterleave) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "MemoryInterleave", func(v uint8) string { return MemoryInterleave(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*i = MemoryInterleave(v)
+	return nil
+}
+
+// MemorySpeeds is a bitfield which describes the memory speeds supported by
+// a memory controller.
+type MemorySpeeds uint16
+
+// Possible MemorySpeeds bits.
+const (
+	MemorySpeedOther   MemorySpeeds = 1 << 0
+	MemorySpeedUnknown MemorySpeeds = 1 << 1
+	MemorySpeed70ns    MemorySpeeds = 1 << 2
+	MemorySpeed60ns    MemorySpeeds = 1 << 3
+	MemorySpeed50ns    MemorySpeeds = 1 << 4
+)
+
+// memorySpeedNames are the names of each MemorySpeeds bit, indexed by bit
+// number, as given in the SMBIOS specification.
+var memorySpeedNames = []string{
+	"Other",
+	"Unknown",
+	"70 ns",
+	"60 ns",
+	"50 ns",
+}
+
+// Names returns the names of each bit set in s, in bit order.  Reserved bits
+// are ignored.
+func (s MemorySpeeds) Names() []string {
+	return bitNames(uint64(s), memorySpeedNames)
+}
+
+// String returns the names of each bit set in s separated by commas, or
+// "None" if no bits are set.
+func (s MemorySpeeds) String() string {
+	return joinNames(s.Names(), ", ")
+}
+
+// MarshalJSON implements json.Marshaler, encoding s as an array of the names
+// of each bit set in s.
+func (s MemorySpeeds) MarshalJSON() ([]byte, error) {
+	return marshalNames(s.Names())
+}
+
+// MemoryModuleTypes is a bitfield which describes the types of a memory
+// module, or the memory module types supported by a memory controller.
+type MemoryModuleTypes uint16
+
+// Possible MemoryModuleTypes bits.
+const (
+	MemoryModuleTypeOther    MemoryModuleTypes = 1 << 0
+	MemoryModuleTypeUnknown  MemoryModuleTypes = 1 << 1
+	MemoryModuleTypeStandard MemoryModuleTypes = 1 << 2
+	MemoryModuleTypeFPM      MemoryModuleTypes = 1 << 3
+	MemoryModuleTypeEDO      MemoryModuleTypes = 1 << 4
+	MemoryModuleTypeParity   MemoryModuleTypes = 1 << 5
+	MemoryModuleTypeECC      MemoryModuleTypes = 1 << 6
+	MemoryModuleTypeSIMM     MemoryModuleTypes = 1 << 7
+	MemoryModuleTypeDIMM     MemoryModuleTypes = 1 << 8
+	MemoryModuleTypeBurstEDO MemoryModuleTypes = 1 << 9
+	MemoryModuleTypeSDRAM    MemoryModuleTypes = 1 << 10
+)
+
+// memoryModuleTypeNames are the names of each MemoryModuleTypes bit, indexed
+// by bit number, as given in the SMBIOS specification.
+var memoryModuleTypeNames = []string{
+	"Other",
+	"Unknown",
+	"Standard",
+	"FPM",
+	"EDO",
+	"Parity",
+	"ECC",
+	"SIMM",
+	"DIMM",
+	"Burst EDO",
+	"SDRAM",
+}
+
+// Names returns the names of each bit set in t, in bit order.  Reserved bits
+// are ignored.
+func (t MemoryModuleTypes) Names() []string {
+	return bitNames(uint64(t), memoryModuleTypeNames)
+}
+
+// String returns the names of each bit set in t separated by spaces, as
+// dmidecode does, or "None" if no bits are set.
+func (t MemoryModuleTypes) String() string {
+	return joinNames(t.Names(), " ")
+}
+
+// MarshalJSON implements json.Marshaler, encoding t as an array of the names
+// of each bit set in t.
+func (t MemoryModuleTypes) MarshalJSON() ([]byte, error) {
+	return marshalNames(t.Names())
+}
+
+// MemoryModuleVoltages is a bitfield which describes the memory module
+// voltages supported by a memory controller.
+type MemoryModuleVoltages uint8
+
+// Possible MemoryModuleVoltages bits.
+const (
+	MemoryModuleVoltage5V0 MemoryModuleVoltages = 1 << 0
+	MemoryModuleVoltage3V3 MemoryModuleVoltages = 1 << 1
+	MemoryModuleVoltage2V9 MemoryModuleVoltages = 1 << 2
+)
+
+// memoryModuleVoltageNames are the names of each MemoryModuleVoltages bit,
+// indexed by bit number.
+var memoryModuleVoltageNames = []string{
+	"5.0 V",
+	"3.3 V",
+	"2.9 V",
+}
+
+// Names returns the names of each bit set in v, in bit order.  Reserved bits
+// are ignored.
+func (v MemoryModuleVoltages) Names() []string {
+	return bitNames(uint64(v), memoryModuleVoltageNames)
+}
+
+// String returns the names of each bit set in v separated by commas, or
+// "None" if no bits are set.
+func (v MemoryModuleVoltages) String() string {
+	return joinNames(v.Names(), ", ")
+}
+
+// MarshalJSON implements json.Marshaler, encoding v as an array of the names
+// of each bit set in v.
+func (v MemoryModuleVoltages) MarshalJSON() ([]byte, error) {
+	return marshalNames(v.Names())
+}
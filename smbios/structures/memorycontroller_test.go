@@ -0,0 +1,159 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseMemoryController(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		mc   *structures.MemoryController
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s:    newBuilder(6, 0x0f).structure(),
+		},
+		{
+			name: "too short",
+			s:    newBuilder(5, 0x0e).structure(),
+		},
+		{
+			name: "short memory module handles",
+			s: newBuilder(5, 0x12).
+				byte(0x0e, 2).
+				structure(),
+		},
+		{
+			name: "OK, 2.0",
+			s: newBuilder(5, 0x0f).
+				byte(0x04, 0x03).
+				structure(),
+			mc: &structures.MemoryController{
+				Header:               header(5, 0x0f),
+				ErrorDetectingMethod: structures.ErrorDetectingMethodNone,
+			},
+			ok: true,
+		},
+		{
+			name: "OK, 2.1",
+			s: newBuilder(5, 0x14).
+				byte(0x04, 0x06).
+				byte(0x05, 0x08).
+				byte(0x06, 0x03).
+				byte(0x07, 0x04).
+				byte(0x08, 0x0b).
+				word(0x09, 0x0004).
+				word(0x0b, 0x0500).
+				byte(0x0d, 0x02).
+				byte(0x0e, 2).
+				word(0x0f, 0x0006).
+				word(0x11, 0x0007).
+				byte(0x13, 0x08).
+				structure(),
+			mc: &structures.MemoryController{
+				Header:                             header(5, 0x14),
+				ErrorDetectingMethod:               structures.ErrorDetectingMethodECC64Bit,
+				ErrorCorrectingCapabilities:        structures.ErrorCorrectingSingleBit,
+				SupportedInterleave:                structures.MemoryInterleaveOneWay,
+				CurrentInterleave:                  structures.MemoryInterleaveTwoWay,
+				MaximumMemoryModuleSize:            0x0b,
+				SupportedSpeeds:                    structures.MemorySpeed70ns,
+				SupportedMemoryTypes:               structures.MemoryModuleTypeDIMM | structures.MemoryModuleTypeSDRAM,
+				MemoryModuleVoltage:                structures.MemoryModuleVoltage3V3,
+				MemoryModuleHandles:                []uint16{0x0006, 0x0007},
+				EnabledErrorCorrectingCapabilities: structures.ErrorCorrectingSingleBit,
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc, err := structures.ParseMemoryController(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.mc, mc); diff != "" {
+				t.Fatalf("unexpected memory controller (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMemoryControllerMaximumModuleSizeBytes(t *testing.T) {
+	size, ok := (&structures.MemoryController{MaximumMemoryModuleSize: 0x0b}).MaximumModuleSizeBytes()
+	if !ok || size != 2<<30 {
+		t.Fatalf("unexpected maximum module size: %d, %v", size, ok)
+	}
+
+	if _, ok := (&structures.MemoryController{MaximumMemoryModuleSize: 0xff}).MaximumModuleSizeBytes(); ok {
+		t.Fatal("expected maximum module size to be unrepresentable")
+	}
+}
+
+func TestMemoryControllerStrings(t *testing.T) {
+	mc := &structures.MemoryController{
+		ErrorDetectingMethod:        structures.ErrorDetectingMethodCRC,
+		ErrorCorrectingCapabilities: structures.ErrorCorrectingSingleBit | structures.ErrorCorrectingErrorScrubbing,
+		SupportedInterleave:         structures.MemoryInterleave(0x10),
+		SupportedSpeeds:             structures.MemorySpeed60ns | structures.MemorySpeed50ns,
+		SupportedMemoryTypes:        structures.MemoryModuleTypeDIMM | structures.MemoryModuleTypeSDRAM,
+	}
+
+	got := []string{
+		mc.ErrorDetectingMethod.String(),
+		mc.ErrorCorrectingCapabilities.String(),
+		mc.SupportedInterleave.String(),
+		mc.SupportedSpeeds.String(),
+		mc.SupportedMemoryTypes.String(),
+		mc.MemoryModuleVoltage.String(),
+	}
+
+	want := []string{
+		"CRC",
+		"Single-bit Error Correcting, Error Scrubbing",
+		"MemoryInterleave(16)",
+		"60 ns, 50 ns",
+		"DIMM SDRAM",
+		"None",
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected strings (-want +got):\n%s", diff)
+	}
+
+	b, err := json.Marshal(mc.MemoryModuleVoltage)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if diff := cmp.Diff("[]", string(b)); diff != "" {
+		t.Fatalf("unexpected JSON (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,703 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"fmt"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/humanize"
+)
+
+// TypeMemoryDevice is the structure type of a Memory Device (type 17).
+const TypeMemoryDevice = 17
+
+// A MemoryDevice is a Memory Device (type 17) structure, which describes a
+// single memory device such as a DIMM.
+type MemoryDevice struct {
+	Header smbios.Header
+
+	// SMBIOS 2.1+.
+	PhysicalMemoryArrayHandle    uint16
+	MemoryErrorInformationHandle uint16
+	TotalWidth                   uint16
+	DataWidth                    uint16
+	Size                         uint16
+	FormFactor                   MemoryFormFactor
+	DeviceSet                    uint8
+	DeviceLocator                string
+	BankLocator                  string
+	MemoryType                   MemoryDeviceType
+	TypeDetail                   MemoryTypeDetail
+
+	// SMBIOS 2.3+.
+	Speed        uint16
+	Manufacturer string
+	SerialNumber string
+	AssetTag     string
+	PartNumber   string
+
+	// SMBIOS 2.6+.
+	Attributes uint8
+
+	// SMBIOS 2.7+.
+	ExtendedSize          uint32
+	ConfiguredMemorySpeed uint16
+
+	// SMBIOS 2.8+.
+	MinimumVoltage    uint16
+	MaximumVoltage    uint16
+	ConfiguredVoltage uint16
+
+	// SMBIOS 3.2+.
+	MemoryTechnology                        MemoryTechnology
+	MemoryOperatingModeCapability           MemoryOperatingModes
+	FirmwareVersion                         string
+	ModuleManufacturerID                    JEDECID
+	ModuleProductID                         uint16
+	MemorySubsystemControllerManufacturerID JEDECID
+	MemorySubsystemControllerProductID      uint16
+	NonVolatileSize                         uint64
+	VolatileSize                            uint64
+	CacheSize                               uint64
+	LogicalSize                             uint64
+
+	// SMBIOS 3.3+.
+	ExtendedSpeed                 uint32
+	ExtendedConfiguredMemorySpeed uint32
+
+	// SMBIOS 3.7+.
+	PMIC0ManufacturerID JEDECID
+	PMIC0RevisionNumber uint16
+	RCDManufacturerID   JEDECID
+	RCDRevisionNumber   uint16
+}
+
+// ParseMemoryDevice parses a MemoryDevice from a Structure.
+func ParseMemoryDevice(s *smbios.Structure) (*MemoryDevice, error) {
+	// The SMBIOS 2.1 structure ends after the type detail field.
+	if err := checkStructure(s, TypeMemoryDevice, "memory device", 0x15); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+
+	return &MemoryDevice{
+		Header: s.Header,
+
+		PhysicalMemoryArrayHandle:    f.word(0x04),
+		MemoryErrorInformationHandle: f.word(0x06),
+		TotalWidth:                   f.word(0x08),
+		DataWidth:                    f.word(0x0a),
+		Size:                         f.word(0x0c),
+		FormFactor:                   MemoryFormFactor(f.byte(0x0e)),
+		DeviceSet:                    f.byte(0x0f),
+		DeviceLocator:                f.str(0x10),
+		BankLocator:                  f.str(0x11),
+		MemoryType:                   MemoryDeviceType(f.byte(0x12)),
+		TypeDetail:                   MemoryTypeDetail(f.word(0x13)),
+
+		Speed:        f.word(0x15),
+		Manufacturer: f.str(0x17),
+		SerialNumber: f.str(0x18),
+		AssetTag:     f.str(0x19),
+		PartNumber:   f.str(0x1a),
+
+		Attributes: f.byte(0x1b),
+
+		ExtendedSize:          f.dword(0x1c),
+		ConfiguredMemorySpeed: f.word(0x20),
+
+		MinimumVoltage:    f.word(0x22),
+		MaximumVoltage:    f.word(0x24),
+		ConfiguredVoltage: f.word(0x26),
+
+		MemoryTechnology:                        MemoryTechnology(f.byte(0x28)),
+		MemoryOperatingModeCapability:           MemoryOperatingModes(f.word(0x29)),
+		FirmwareVersion:                         f.str(0x2b),
+		ModuleManufacturerID:                    JEDECID(f.word(0x2c)),
+		ModuleProductID:                         f.word(0x2e),
+		MemorySubsystemControllerManufacturerID: JEDECID(f.word(0x30)),
+		MemorySubsystemControllerProductID:      f.word(0x32),
+		NonVolatileSize:                         f.qword(0x34),
+		VolatileSize:                            f.qword(0x3c),
+		CacheSize:                               f.qword(0x44),
+		LogicalSize:                             f.qword(0x4c),
+
+		ExtendedSpeed:                 f.dword(0x54),
+		ExtendedConfiguredMemorySpeed: f.dword(0x58),
+
+		PMIC0ManufacturerID: JEDECID(f.word(0x5c)),
+		PMIC0RevisionNumber: f.word(0x5e),
+		RCDManufacturerID:   JEDECID(f.word(0x60)),
+		RCDRevisionNumber:   f.word(0x62),
+	}, nil
+}
+
+// MemoryDevices parses all MemoryDevices from a list of Structures, ignoring
+// Structures of other types.
+func MemoryDevices(ss []*smbios.Structure) ([]*MemoryDevice, error) {
+	var mds []*MemoryDevice
+	for _, s := range ss {
+		if s.Header.Type != TypeMemoryDevice {
+			continue
+		}
+
+		md, err := ParseMemoryDevice(s)
+		if err = processed(md, err); err != nil {
+			return nil, err
+		}
+
+		mds = append(mds, md)
+	}
+
+	return mds, nil
+}
+
+// SizeBytes returns the size of the memory device in bytes, consulting the
+// SMBIOS 2.7+ extended size field when required.  If the memory device is
+// not installed or its size is unknown, SizeBytes returns false.
+func (md *MemoryDevice) SizeBytes() (uint64, bool) {
+	const (
+		kib = 1 << 10
+		mib = 1 << 20
+	)
+
+	switch md.Size {
+	case 0, 0xffff:
+		// No device installed, or unknown size.
+		return 0, false
+	case 0x7fff:
+		// The extended size field is always specified in megabytes.
+		return uint64(md.ExtendedSize&0x7fffffff) * mib, true
+	}
+
+	// Bit 15 specifies the granularity of the size field: 0 for megabytes,
+	// 1 for kilobytes.
+	if md.Size&0x8000 != 0 {
+		return uint64(md.Size&0x7fff) * kib, true
+	}
+
+	return uint64(md.Size) * mib, true
+}
+
+// HumanSize returns the size of the memory device formatted using IEC binary
+// units, such as "16 GiB", or "No Module Installed" or "Unknown" if the size
+// is not available.  Use SizeBytes to obtain the size in bytes.
+func (md *MemoryDevice) HumanSize() string {
+	size, ok := md.SizeBytes()
+	switch {
+	case ok:
+		return humanize.Bytes(size)
+	case md.Size == 0:
+		return "No Module Installed"
+	default:
+		return "Unknown"
+	}
+}
+
+// EffectiveSpeed returns the maximum capable speed of the memory device in
+// megatransfers per second, consulting the SMBIOS 3.3+ extended speed field
+// when required.  If the speed is unknown, EffectiveSpeed returns false.
+func (md *MemoryDevice) EffectiveSpeed() (uint32, bool) {
+	return speed(md.Speed, md.ExtendedSpeed)
+}
+
+// EffectiveConfiguredSpeed returns the configured speed of the memory device
+// in megatransfers per second, consulting the SMBIOS 3.3+ extended configured
+// memory speed field when required.  If the speed is unknown,
+// EffectiveConfiguredSpeed returns false.
+func (md *MemoryDevice) EffectiveConfiguredSpeed() (uint32, bool) {
+	return speed(md.ConfiguredMemorySpeed, md.ExtendedConfiguredMemorySpeed)
+}
+
+// MinimumMillivolts returns the minimum operating voltage of the memory device
+// in millivolts.  If the voltage is unknown, MinimumMillivolts returns false.
+func (md *MemoryDevice) MinimumMillivolts() (int, bool) {
+	return millivolts(md.MinimumVoltage)
+}
+
+// MaximumMillivolts returns the maximum operating voltage of the memory device
+// in millivolts.  If the voltage is unknown, MaximumMillivolts returns false.
+func (md *MemoryDevice) MaximumMillivolts() (int, bool) {
+	return millivolts(md.MaximumVoltage)
+}
+
+// ConfiguredMillivolts returns the configured voltage of the memory device in
+// millivolts.  If the voltage is unknown, ConfiguredMillivolts returns false.
+func (md *MemoryDevice) ConfiguredMillivolts() (int, bool) {
+	return millivolts(md.ConfiguredVoltage)
+}
+
+// millivolts interprets a memory device voltage field, for which 0 indicates
+// an unknown voltage.
+func millivolts(v uint16) (int, bool) {
+	return int(v), v != 0
+}
+
+// speed interprets a memory device speed field and its extended counterpart.
+func speed(s uint16, ext uint32) (uint32, bool) {
+	switch s {
+	case 0:
+		return 0, false
+	case 0xffff:
+		// Bit 31 of the extended field is reserved.
+		if ext &= 0x7fffffff; ext == 0 {
+			return 0, false
+		}
+
+		return ext, true
+	}
+
+	return uint32(s), true
+}
+
+// A JEDECID is a JEDEC JEP-106 manufacturer identifier, as stored in the
+// SMBIOS 3.2+ module, memory subsystem controller, PMIC, and RCD manufacturer
+// ID fields.
+type JEDECID uint16
+
+// Bank returns the 1-based JEP-106 bank number of the manufacturer.
+func (id JEDECID) Bank() int {
+	// The first byte holds the number of continuation codes, with odd parity
+	// in bit 7.
+	return int(id&0x7f) + 1
+}
+
+// Code returns the JEP-106 manufacturer code within its bank, without the
+// odd parity bit.
+func (id JEDECID) Code() uint8 {
+	return uint8(id>>8) & 0x7f
+}
+
+// Known reports whether id holds a manufacturer ID.  The SMBIOS specification
+// uses 0 to indicate an unknown manufacturer.
+func (id JEDECID) Known() bool {
+	return id != 0
+}
+
+// IsCXL reports whether the memory device is attached using CXL.  SMBIOS
+// identifies CXL-attached memory devices by the location of their physical
+// memory array, so the device's array is located by handle in pmas.  If the
+// device's array is not found in pmas, IsCXL returns false.
+func (md *MemoryDevice) IsCXL(pmas []*PhysicalMemoryArray) bool {
+	for _, pma := range pmas {
+		if pma.Header.Handle == md.PhysicalMemoryArrayHandle {
+			return pma.IsCXL()
+		}
+	}
+
+	return false
+}
+
+// A MemoryTechnology is the technology of a memory device.
+type MemoryTechnology uint8
+
+// Possible MemoryTechnology values.
+const (
+	MemoryTechnologyOther   MemoryTechnology = 0x01
+	MemoryTechnologyUnknown MemoryTechnology = 0x02
+	MemoryTechnologyDRAM    MemoryTechnology = 0x03
+	MemoryTechnologyNVDIMMN MemoryTechnology = 0x04
+	MemoryTechnologyNVDIMMF MemoryTechnology = 0x05
+	MemoryTechnologyNVDIMMP MemoryTechnology = 0x06
+	MemoryTechnologyOptane  MemoryTechnology = 0x07
+)
+
+// String returns the name of a MemoryTechnology as given in the SMBIOS
+// specification.
+func (t MemoryTechnology) String() string {
+	switch t {
+	case MemoryTechnologyOther:
+		return "Other"
+	case MemoryTechnologyUnknown:
+		return "Unknown"
+	case MemoryTechnologyDRAM:
+		return "DRAM"
+	case MemoryTechnologyNVDIMMN:
+		return "NVDIMM-N"
+	case MemoryTechnologyNVDIMMF:
+		return "NVDIMM-F"
+	case MemoryTechnologyNVDIMMP:
+		return "NVDIMM-P"
+	case MemoryTechnologyOptane:
+		return "Intel Optane persistent memory"
+	default:
+		return fmt.Sprintf("MemoryTechnology(%d)", uint8(t))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding t as its name.
+func (t MemoryTechnology) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (t *MemoryTechnology) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "MemoryTechnology", func(v uint8) string { return MemoryTechnology(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*t = MemoryTechnology(v)
+	return nil
+}
+
+// Technology returns the technology of the memory device.  Memory devices
+// which predate SMBIOS 3.2 do not report their technology, and are reported
+// as MemoryTechnologyUnknown.
+func (md *MemoryDevice) Technology() MemoryTechnology {
+	if md.MemoryTechnology == 0 {
+		return MemoryTechnologyUnknown
+	}
+
+	return md.MemoryTechnology
+}
+
+// IsPersistent reports whether the memory device provides persistent memory,
+// such as an NVDIMM or Intel Optane persistent memory module, as indicated by
+// its technology or by its byte-accessible or block-accessible persistent
+// memory operating mode capabilities.  Persistent memory devices should not be
+// counted as system RAM.
+func (md *MemoryDevice) IsPersistent() bool {
+	switch md.Technology() {
+	case MemoryTechnologyNVDIMMN, MemoryTechnologyNVDIMMF, MemoryTechnologyNVDIMMP, MemoryTechnologyOptane:
+		return true
+	}
+
+	return md.MemoryOperatingModeCapability&(MemoryOperatingModeBytePersistent|MemoryOperatingModeBlockPersistent) != 0
+}
+
+// Capacity returns the volatile and persistent memory capacity of the memory
+// device in bytes.  For SMBIOS 3.2+ memory devices which report them, the
+// volatile and non-volatile size fields are used, so that devices which
+// provide both, such as Intel Optane persistent memory, are not counted
+// twice.  Otherwise, the device's size is classified using IsPersistent.
+//
+// The portion of a memory device which is used as cache, such as DRAM which
+// caches Intel Optane persistent memory in Memory Mode, is not counted, so
+// that the memory it caches is not counted twice.
+//
+// Memory devices which are not installed or are of unknown size have no
+// capacity.
+func (md *MemoryDevice) Capacity() (volatile, persistent uint64) {
+	// All bits set indicates an unknown size.
+	const unknown = 0xffffffffffffffff
+
+	if md.VolatileSize != unknown && md.NonVolatileSize != unknown &&
+		(md.VolatileSize != 0 || md.NonVolatileSize != 0) {
+		return md.VolatileSize, md.NonVolatileSize
+	}
+
+	size, ok := md.SizeBytes()
+	if !ok {
+		return 0, 0
+	}
+
+	if md.CacheSize != unknown {
+		if md.CacheSize >= size {
+			return 0, 0
+		}
+
+		size -= md.CacheSize
+	}
+
+	if md.IsPersistent() {
+		return 0, size
+	}
+
+	return size, 0
+}
+
+// A MemoryTypeDetail is a bitfield which describes additional details of a
+// memory device's type.
+type MemoryTypeDetail uint16
+
+// Possible MemoryTypeDetail bits.
+const (
+	MemoryTypeDetailOther        MemoryTypeDetail = 1 << 1
+	MemoryTypeDetailUnknown      MemoryTypeDetail = 1 << 2
+	MemoryTypeDetailFastPaged    MemoryTypeDetail = 1 << 3
+	MemoryTypeDetailStaticColumn MemoryTypeDetail = 1 << 4
+	MemoryTypeDetailPseudoStatic MemoryTypeDetail = 1 << 5
+	MemoryTypeDetailRAMBUS       MemoryTypeDetail = 1 << 6
+	MemoryTypeDetailSynchronous  MemoryTypeDetail = 1 << 7
+	MemoryTypeDetailCMOS         MemoryTypeDetail = 1 << 8
+	MemoryTypeDetailEDO          MemoryTypeDetail = 1 << 9
+	MemoryTypeDetailWindowDRAM   MemoryTypeDetail = 1 << 10
+	MemoryTypeDetailCacheDRAM    MemoryTypeDetail = 1 << 11
+	MemoryTypeDetailNonVolatile  MemoryTypeDetail = 1 << 12
+	MemoryTypeDetailRegistered   MemoryTypeDetail = 1 << 13
+	MemoryTypeDetailUnbuffered   MemoryTypeDetail = 1 << 14
+	MemoryTypeDetailLRDIMM       MemoryTypeDetail = 1 << 15
+)
+
+// memoryTypeDetailNames are the names of each MemoryTypeDetail bit, indexed
+// by bit number, as given in the SMBIOS specification.
+var memoryTypeDetailNames = []string{
+	1:  "Other",
+	2:  "Unknown",
+	3:  "Fast-paged",
+	4:  "Static Column",
+	5:  "Pseudo-static",
+	6:  "RAMBUS",
+	7:  "Synchronous",
+	8:  "CMOS",
+	9:  "EDO",
+	10: "Window DRAM",
+	11: "Cache DRAM",
+	12: "Non-volatile",
+	13: "Registered (Buffered)",
+	14: "Unbuffered (Unregistered)",
+	15: "LRDIMM",
+}
+
+// Names returns the names of each bit set in d, in bit order.  Reserved bits
+// are ignored.
+func (d MemoryTypeDetail) Names() []string {
+	return bitNames(uint64(d), memoryTypeDetailNames)
+}
+
+// String returns the names of each bit set in d separated by spaces, as
+// dmidecode does, or "None" if no bits are set.
+func (d MemoryTypeDetail) String() string {
+	return joinNames(d.Names(), " ")
+}
+
+// MarshalJSON implements json.Marshaler, encoding d as an array of the names
+// of each bit set in d.
+func (d MemoryTypeDetail) MarshalJSON() ([]byte, error) {
+	return marshalNames(d.Names())
+}
+
+// A MemoryFormFactor is the physical form factor of a memory device.
+type MemoryFormFactor uint8
+
+// Possible MemoryFormFactor values.
+const (
+	MemoryFormFactorOther           MemoryFormFactor = 0x01
+	MemoryFormFactorUnknown         MemoryFormFactor = 0x02
+	MemoryFormFactorSIMM            MemoryFormFactor = 0x03
+	MemoryFormFactorSIP             MemoryFormFactor = 0x04
+	MemoryFormFactorChip            MemoryFormFactor = 0x05
+	MemoryFormFactorDIP             MemoryFormFactor = 0x06
+	MemoryFormFactorZIP             MemoryFormFactor = 0x07
+	MemoryFormFactorProprietaryCard MemoryFormFactor = 0x08
+	MemoryFormFactorDIMM            MemoryFormFactor = 0x09
+	MemoryFormFactorTSOP            MemoryFormFactor = 0x0a
+	MemoryFormFactorRowOfChips      MemoryFormFactor = 0x0b
+	MemoryFormFactorRIMM            MemoryFormFactor = 0x0c
+	MemoryFormFactorSODIMM          MemoryFormFactor = 0x0d
+	MemoryFormFactorSRIMM           MemoryFormFactor = 0x0e
+	MemoryFormFactorFBDIMM          MemoryFormFactor = 0x0f
+	MemoryFormFactorDie             MemoryFormFactor = 0x10
+	MemoryFormFactorCAMM            MemoryFormFactor = 0x11
+)
+
+// memoryFormFactorNames are the names of each MemoryFormFactor, as given in
+// the SMBIOS specification.
+var memoryFormFactorNames = map[MemoryFormFactor]string{
+	MemoryFormFactorOther:           "Other",
+	MemoryFormFactorUnknown:         "Unknown",
+	MemoryFormFactorSIMM:            "SIMM",
+	MemoryFormFactorSIP:             "SIP",
+	MemoryFormFactorChip:            "Chip",
+	MemoryFormFactorDIP:             "DIP",
+	MemoryFormFactorZIP:             "ZIP",
+	MemoryFormFactorProprietaryCard: "Proprietary Card",
+	MemoryFormFactorDIMM:            "DIMM",
+	MemoryFormFactorTSOP:            "TSOP",
+	MemoryFormFactorRowOfChips:      "Row of chips",
+	MemoryFormFactorRIMM:            "RIMM",
+	MemoryFormFactorSODIMM:          "SODIMM",
+	MemoryFormFactorSRIMM:           "SRIMM",
+	MemoryFormFactorFBDIMM:          "FB-DIMM",
+	MemoryFormFactorDie:             "Die",
+	MemoryFormFactorCAMM:            "CAMM",
+}
+
+// String returns the name of a MemoryFormFactor as given in the SMBIOS
+// specification.
+func (f MemoryFormFactor) String() string {
+	if s, ok := memoryFormFactorNames[f]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("MemoryFormFactor(%d)", uint8(f))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding f as its name.
+func (f MemoryFormFactor) MarshalText() ([]byte, error) {
+	return []byte(f.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (f *MemoryFormFactor) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "MemoryFormFactor", func(v uint8) string { return MemoryFormFactor(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*f = MemoryFormFactor(v)
+	return nil
+}
+
+// A MemoryDeviceType is the type of memory used by a memory device.
+type MemoryDeviceType uint8
+
+// Possible MemoryDeviceType values.
+const (
+	MemoryDeviceTypeOther       MemoryDeviceType = 0x01
+	MemoryDeviceTypeUnknown     MemoryDeviceType = 0x02
+	MemoryDeviceTypeDRAM        MemoryDeviceType = 0x03
+	MemoryDeviceTypeEDRAM       MemoryDeviceType = 0x04
+	MemoryDeviceTypeVRAM        MemoryDeviceType = 0x05
+	MemoryDeviceTypeSRAM        MemoryDeviceType = 0x06
+	MemoryDeviceTypeRAM         MemoryDeviceType = 0x07
+	MemoryDeviceTypeROM         MemoryDeviceType = 0x08
+	MemoryDeviceTypeFlash       MemoryDeviceType = 0x09
+	MemoryDeviceTypeEEPROM      MemoryDeviceType = 0x0a
+	MemoryDeviceTypeFEPROM      MemoryDeviceType = 0x0b
+	MemoryDeviceTypeEPROM       MemoryDeviceType = 0x0c
+	MemoryDeviceTypeCDRAM       MemoryDeviceType = 0x0d
+	MemoryDeviceType3DRAM       MemoryDeviceType = 0x0e
+	MemoryDeviceTypeSDRAM       MemoryDeviceType = 0x0f
+	MemoryDeviceTypeSGRAM       MemoryDeviceType = 0x10
+	MemoryDeviceTypeRDRAM       MemoryDeviceType = 0x11
+	MemoryDeviceTypeDDR         MemoryDeviceType = 0x12
+	MemoryDeviceTypeDDR2        MemoryDeviceType = 0x13
+	MemoryDeviceTypeDDR2FBDIMM  MemoryDeviceType = 0x14
+	MemoryDeviceTypeDDR3        MemoryDeviceType = 0x18
+	MemoryDeviceTypeFBD2        MemoryDeviceType = 0x19
+	MemoryDeviceTypeDDR4        MemoryDeviceType = 0x1a
+	MemoryDeviceTypeLPDDR       MemoryDeviceType = 0x1b
+	MemoryDeviceTypeLPDDR2      MemoryDeviceType = 0x1c
+	MemoryDeviceTypeLPDDR3      MemoryDeviceType = 0x1d
+	MemoryDeviceTypeLPDDR4      MemoryDeviceType = 0x1e
+	MemoryDeviceTypeNonVolatile MemoryDeviceType = 0x1f
+	MemoryDeviceTypeHBM         MemoryDeviceType = 0x20
+	MemoryDeviceTypeHBM2        MemoryDeviceType = 0x21
+	MemoryDeviceTypeDDR5        MemoryDeviceType = 0x22
+	MemoryDeviceTypeLPDDR5      MemoryDeviceType = 0x23
+	MemoryDeviceTypeHBM3        MemoryDeviceType = 0x24
+)
+
+// memoryDeviceTypeNames are the names of each MemoryDeviceType, as given in
+// the SMBIOS specification.
+var memoryDeviceTypeNames = map[MemoryDeviceType]string{
+	MemoryDeviceTypeOther:       "Other",
+	MemoryDeviceTypeUnknown:     "Unknown",
+	MemoryDeviceTypeDRAM:        "DRAM",
+	MemoryDeviceTypeEDRAM:       "EDRAM",
+	MemoryDeviceTypeVRAM:        "VRAM",
+	MemoryDeviceTypeSRAM:        "SRAM",
+	MemoryDeviceTypeRAM:         "RAM",
+	MemoryDeviceTypeROM:         "ROM",
+	MemoryDeviceTypeFlash:       "Flash",
+	MemoryDeviceTypeEEPROM:      "EEPROM",
+	MemoryDeviceTypeFEPROM:      "FEPROM",
+	MemoryDeviceTypeEPROM:       "EPROM",
+	MemoryDeviceTypeCDRAM:       "CDRAM",
+	MemoryDeviceType3DRAM:       "3DRAM",
+	MemoryDeviceTypeSDRAM:       "SDRAM",
+	MemoryDeviceTypeSGRAM:       "SGRAM",
+	MemoryDeviceTypeRDRAM:       "RDRAM",
+	MemoryDeviceTypeDDR:         "DDR",
+	MemoryDeviceTypeDDR2:        "DDR2",
+	MemoryDeviceTypeDDR2FBDIMM:  "DDR2 FB-DIMM",
+	MemoryDeviceTypeDDR3:        "DDR3",
+	MemoryDeviceTypeFBD2:        "FBD2",
+	MemoryDeviceTypeDDR4:        "DDR4",
+	MemoryDeviceTypeLPDDR:       "LPDDR",
+	MemoryDeviceTypeLPDDR2:      "LPDDR2",
+	MemoryDeviceTypeLPDDR3:      "LPDDR3",
+	MemoryDeviceTypeLPDDR4:      "LPDDR4",
+	MemoryDeviceTypeNonVolatile: "Logical non-volatile device",
+	MemoryDeviceTypeHBM:         "HBM",
+	MemoryDeviceTypeHBM2:        "HBM2",
+	MemoryDeviceTypeDDR5:        "DDR5",
+	MemoryDeviceTypeLPDDR5:      "LPDDR5",
+	MemoryDeviceTypeHBM3:        "HBM3",
+}
+
+// String returns the name of a MemoryDeviceType as given in the SMBIOS
+// specification.
+func (t MemoryDeviceType) String() string {
+	if s, ok := memoryDeviceTypeNames[t]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("MemoryDeviceType(%d)", uint8(t))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding t as its name.
+func (t MemoryDeviceType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (t *MemoryDeviceType) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "MemoryDeviceType", func(v uint8) string { return MemoryDeviceType(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*t = MemoryDeviceType(v)
+	return nil
+}
+
+// MemoryOperatingModes is a bitfield of the operating modes supported by a
+// memory device.
+type MemoryOperatingModes uint16
+
+// Possible MemoryOperatingModes bits.
+const (
+	MemoryOperatingModeOther           MemoryOperatingModes = 1 << 1
+	MemoryOperatingModeUnknown         MemoryOperatingModes = 1 << 2
+	MemoryOperatingModeVolatile        MemoryOperatingModes = 1 << 3
+	MemoryOperatingModeBytePersistent  MemoryOperatingModes = 1 << 4
+	MemoryOperatingModeBlockPersistent MemoryOperatingModes = 1 << 5
+)
+
+// memoryOperatingModeNames are the names of each MemoryOperatingModes bit,
+// indexed by bit number, as given in the SMBIOS specification.
+var memoryOperatingModeNames = []string{
+	1: "Other",
+	2: "Unknown",
+	3: "Volatile memory",
+	4: "Byte-accessible persistent memory",
+	5: "Block-accessible persistent memory",
+}
+
+// Names returns the names of each bit set in m, in bit order.  Reserved bits
+// are ignored.
+func (m MemoryOperatingModes) Names() []string {
+	return bitNames(uint64(m), memoryOperatingModeNames)
+}
+
+// String returns the names of each bit set in m separated by commas, or
+// "None" if no bits are set.
+func (m MemoryOperatingModes) String() string {
+	return joinNames(m.Names(), ", ")
+}
+
+// MarshalJSON implements json.Marshaler, encoding m as an array of the names
+// of each bit set in m.
+func (m MemoryOperatingModes) MarshalJSON() ([]byte, error) {
+	return marshalNames(m.Names())
+}
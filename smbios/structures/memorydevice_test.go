@@ -0,0 +1,637 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseMemoryDevice(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		md   *structures.MemoryDevice
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s: &smbios.Structure{
+				Header: smbios.Header{Type: 16},
+			},
+		},
+		{
+			name: "too short",
+			s: &smbios.Structure{
+				Header:    smbios.Header{Type: 17},
+				Formatted: make([]byte, 0x10),
+			},
+		},
+		{
+			name: "OK, 2.1",
+			s: &smbios.Structure{
+				Header: smbios.Header{
+					Type:   17,
+					Length: 0x15,
+					Handle: 0x0011,
+				},
+				Formatted: []byte{
+					0x10, 0x00,
+					0xfe, 0xff,
+					0x48, 0x00,
+					0x40, 0x00,
+					0x00, 0x04,
+					0x09,
+					0x00,
+					0x01,
+					0x02,
+					0x12,
+					0x80, 0x00,
+				},
+				Strings: []string{"DIMM 0", "BANK 0"},
+			},
+			md: &structures.MemoryDevice{
+				Header: smbios.Header{
+					Type:   17,
+					Length: 0x15,
+					Handle: 0x0011,
+				},
+				PhysicalMemoryArrayHandle:    0x0010,
+				MemoryErrorInformationHandle: 0xfffe,
+				TotalWidth:                   72,
+				DataWidth:                    64,
+				Size:                         1024,
+				FormFactor:                   0x09,
+				DeviceLocator:                "DIMM 0",
+				BankLocator:                  "BANK 0",
+				MemoryType:                   0x12,
+				TypeDetail:                   0x0080,
+			},
+			ok: true,
+		},
+		{
+			name: "OK, 2.8",
+			s:    memoryDevice28(),
+			md: &structures.MemoryDevice{
+				Header: smbios.Header{
+					Type:   17,
+					Length: 0x28,
+					Handle: 0x0011,
+				},
+				PhysicalMemoryArrayHandle:    0x0010,
+				MemoryErrorInformationHandle: 0xfffe,
+				TotalWidth:                   72,
+				DataWidth:                    64,
+				Size:                         0x7fff,
+				FormFactor:                   0x09,
+				DeviceLocator:                "DIMM_A1",
+				BankLocator:                  "NODE 0",
+				MemoryType:                   0x1a,
+				TypeDetail:                   0x2080,
+				Speed:                        2666,
+				Manufacturer:                 "Samsung",
+				SerialNumber:                 "12345678",
+				AssetTag:                     "",
+				PartNumber:                   "M393A8G40AB2-CWE",
+				Attributes:                   0x02,
+				ExtendedSize:                 65536,
+				ConfiguredMemorySpeed:        2400,
+				MinimumVoltage:               1200,
+				MaximumVoltage:               1200,
+				ConfiguredVoltage:            1200,
+			},
+			ok: true,
+		},
+		{
+			name: "OK, 3.7 DDR5",
+			s:    memoryDevice37(),
+			md: &structures.MemoryDevice{
+				Header:                                  header(17, 0x64),
+				PhysicalMemoryArrayHandle:               0x0010,
+				MemoryErrorInformationHandle:            0xfffe,
+				TotalWidth:                              80,
+				DataWidth:                               64,
+				Size:                                    0x7fff,
+				FormFactor:                              0x09,
+				DeviceLocator:                           "CPU0_DIMM_A1",
+				BankLocator:                             "NODE 0",
+				MemoryType:                              0x22,
+				TypeDetail:                              0x2080,
+				Speed:                                   4800,
+				Manufacturer:                            "Samsung",
+				SerialNumber:                            "80CE012234ABCDEF",
+				PartNumber:                              "M321R4GA3BB6-CQKET",
+				Attributes:                              0x02,
+				ExtendedSize:                            32768,
+				ConfiguredMemorySpeed:                   4400,
+				MinimumVoltage:                          1100,
+				MaximumVoltage:                          1100,
+				ConfiguredVoltage:                       1100,
+				MemoryTechnology:                        0x03,
+				MemoryOperatingModeCapability:           0x0008,
+				ModuleManufacturerID:                    0xce80,
+				MemorySubsystemControllerManufacturerID: 0x3204,
+				MemorySubsystemControllerProductID:      0x0083,
+				VolatileSize:                            32 << 30,
+				ExtendedSpeed:                           0,
+				ExtendedConfiguredMemorySpeed:           0,
+				PMIC0ManufacturerID:                     0x8a8c,
+				PMIC0RevisionNumber:                     0x0013,
+				RCDManufacturerID:                       0x3204,
+				RCDRevisionNumber:                       0x0021,
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md, err := structures.ParseMemoryDevice(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if !tt.ok {
+				t.Logf("OK error: %v", err)
+				return
+			}
+
+			if diff := cmp.Diff(tt.md, md); diff != "" {
+				t.Fatalf("unexpected memory device (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMemoryDevices(t *testing.T) {
+	ss := []*smbios.Structure{
+		{Header: smbios.Header{Type: 16}},
+		memoryDevice28(),
+		{Header: smbios.Header{Type: 127}},
+	}
+
+	mds, err := structures.MemoryDevices(ss)
+	if err != nil {
+		t.Fatalf("failed to parse memory devices: %v", err)
+	}
+
+	if diff := cmp.Diff(1, len(mds)); diff != "" {
+		t.Fatalf("unexpected number of memory devices (-want +got):\n%s", diff)
+	}
+}
+
+// memoryDevice28 returns an SMBIOS 2.8 memory device structure.
+func memoryDevice28() *smbios.Structure {
+	return &smbios.Structure{
+		Header: smbios.Header{
+			Type:   17,
+			Length: 0x28,
+			Handle: 0x0011,
+		},
+		Formatted: []byte{
+			// 2.1.
+			0x10, 0x00,
+			0xfe, 0xff,
+			0x48, 0x00,
+			0x40, 0x00,
+			0xff, 0x7f,
+			0x09,
+			0x00,
+			0x01,
+			0x02,
+			0x1a,
+			0x80, 0x20,
+			// 2.3.
+			0x6a, 0x0a,
+			0x03,
+			0x04,
+			0x00,
+			0x05,
+			// 2.6.
+			0x02,
+			// 2.7.
+			0x00, 0x00, 0x01, 0x00,
+			0x60, 0x09,
+			// 2.8.
+			0xb0, 0x04,
+			0xb0, 0x04,
+			0xb0, 0x04,
+		},
+		Strings: []string{
+			"DIMM_A1",
+			"NODE 0",
+			"Samsung",
+			"12345678",
+			"M393A8G40AB2-CWE",
+		},
+	}
+}
+
+// memoryDevice37 returns an SMBIOS 3.7 memory device structure for a DDR5
+// RDIMM.
+func memoryDevice37() *smbios.Structure {
+	return newBuilder(17, 0x64,
+		"CPU0_DIMM_A1",
+		"NODE 0",
+		"Samsung",
+		"80CE012234ABCDEF",
+		"M321R4GA3BB6-CQKET",
+	).
+		// 2.1.
+		word(0x04, 0x0010).
+		word(0x06, 0xfffe).
+		word(0x08, 80).
+		word(0x0a, 64).
+		word(0x0c, 0x7fff).
+		byte(0x0e, 0x09).
+		byte(0x10, 1).
+		byte(0x11, 2).
+		byte(0x12, 0x22).
+		word(0x13, 0x2080).
+		// 2.3.
+		word(0x15, 4800).
+		byte(0x17, 3).
+		byte(0x18, 4).
+		byte(0x1a, 5).
+		// 2.6.
+		byte(0x1b, 0x02).
+		// 2.7.
+		dword(0x1c, 32768).
+		word(0x20, 4400).
+		// 2.8.
+		word(0x22, 1100).
+		word(0x24, 1100).
+		word(0x26, 1100).
+		// 3.2.
+		byte(0x28, 0x03).
+		word(0x29, 0x0008).
+		word(0x2c, 0xce80).
+		word(0x30, 0x3204).
+		word(0x32, 0x0083).
+		qword(0x3c, 32<<30).
+		// 3.7.
+		word(0x5c, 0x8a8c).
+		word(0x5e, 0x0013).
+		word(0x60, 0x3204).
+		word(0x62, 0x0021).
+		structure()
+}
+
+func TestMemoryDeviceEffectiveSpeed(t *testing.T) {
+	tests := []struct {
+		name  string
+		md    *structures.MemoryDevice
+		speed uint32
+		ok    bool
+	}{
+		{
+			name: "unknown",
+			md:   &structures.MemoryDevice{},
+		},
+		{
+			name: "extended unknown",
+			md:   &structures.MemoryDevice{Speed: 0xffff},
+		},
+		{
+			name:  "speed",
+			md:    &structures.MemoryDevice{Speed: 4800},
+			speed: 4800,
+			ok:    true,
+		},
+		{
+			name: "extended",
+			md: &structures.MemoryDevice{
+				Speed:         0xffff,
+				ExtendedSpeed: 0x80000000 | 70400,
+			},
+			speed: 70400,
+			ok:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The configured speed fields follow the same rules.
+			configured := &structures.MemoryDevice{
+				ConfiguredMemorySpeed:         tt.md.Speed,
+				ExtendedConfiguredMemorySpeed: tt.md.ExtendedSpeed,
+			}
+
+			for _, fn := range []func() (uint32, bool){
+				tt.md.EffectiveSpeed,
+				configured.EffectiveConfiguredSpeed,
+			} {
+				speed, ok := fn()
+
+				if diff := cmp.Diff(tt.ok, ok); diff != "" {
+					t.Fatalf("unexpected speed presence (-want +got):\n%s", diff)
+				}
+				if diff := cmp.Diff(tt.speed, speed); diff != "" {
+					t.Fatalf("unexpected speed (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestMemoryDeviceMillivolts(t *testing.T) {
+	md := &structures.MemoryDevice{
+		MinimumVoltage:    1200,
+		ConfiguredVoltage: 1350,
+	}
+
+	type voltage struct {
+		MV int
+		OK bool
+	}
+
+	var got []voltage
+	for _, fn := range []func() (int, bool){
+		md.MinimumMillivolts,
+		md.MaximumMillivolts,
+		md.ConfiguredMillivolts,
+	} {
+		mv, ok := fn()
+		got = append(got, voltage{MV: mv, OK: ok})
+	}
+
+	want := []voltage{
+		{MV: 1200, OK: true},
+		{},
+		{MV: 1350, OK: true},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected voltages (-want +got):\n%s", diff)
+	}
+}
+
+func TestJEDECID(t *testing.T) {
+	// Samsung: bank 1, code 0xce with odd parity.
+	id := structures.JEDECID(0xce80)
+
+	if diff := cmp.Diff(1, id.Bank()); diff != "" {
+		t.Fatalf("unexpected bank (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(uint8(0x4e), id.Code()); diff != "" {
+		t.Fatalf("unexpected code (-want +got):\n%s", diff)
+	}
+	if !id.Known() {
+		t.Fatal("manufacturer ID should be known")
+	}
+	if structures.JEDECID(0).Known() {
+		t.Fatal("zero manufacturer ID should be unknown")
+	}
+}
+
+func TestMemoryDeviceSizeBytes(t *testing.T) {
+	tests := []struct {
+		name string
+		md   *structures.MemoryDevice
+		size uint64
+		ok   bool
+	}{
+		{
+			name: "not installed",
+			md:   &structures.MemoryDevice{},
+		},
+		{
+			name: "unknown",
+			md:   &structures.MemoryDevice{Size: 0xffff},
+		},
+		{
+			name: "megabytes",
+			md:   &structures.MemoryDevice{Size: 16384},
+			size: 16 << 30,
+			ok:   true,
+		},
+		{
+			name: "kilobytes",
+			md:   &structures.MemoryDevice{Size: 0x8000 | 512},
+			size: 512 << 10,
+			ok:   true,
+		},
+		{
+			name: "extended",
+			md: &structures.MemoryDevice{
+				Size:         0x7fff,
+				ExtendedSize: 65536,
+			},
+			size: 64 << 30,
+			ok:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			size, ok := tt.md.SizeBytes()
+
+			if diff := cmp.Diff(tt.ok, ok); diff != "" {
+				t.Fatalf("unexpected size presence (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.size, size); diff != "" {
+				t.Fatalf("unexpected size (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMemoryDeviceHumanSize(t *testing.T) {
+	tests := []struct {
+		name string
+		md   *structures.MemoryDevice
+		s    string
+	}{
+		{
+			name: "not installed",
+			md:   &structures.MemoryDevice{},
+			s:    "No Module Installed",
+		},
+		{
+			name: "unknown",
+			md:   &structures.MemoryDevice{Size: 0xffff},
+			s:    "Unknown",
+		},
+		{
+			name: "kilobytes",
+			md:   &structures.MemoryDevice{Size: 0x8000 | 512},
+			s:    "512 KiB",
+		},
+		{
+			name: "extended",
+			md:   &structures.MemoryDevice{Size: 0x7fff, ExtendedSize: 65536},
+			s:    "64 GiB",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.s, tt.md.HumanSize()); diff != "" {
+				t.Fatalf("unexpected size (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMemoryDeviceTechnology(t *testing.T) {
+	tests := []struct {
+		name       string
+		md         *structures.MemoryDevice
+		tech       string
+		persistent bool
+	}{
+		{
+			name: "pre-3.2",
+			md:   &structures.MemoryDevice{},
+			tech: "Unknown",
+		},
+		{
+			name: "DRAM",
+			md: &structures.MemoryDevice{
+				MemoryTechnology:              0x03,
+				MemoryOperatingModeCapability: 0x0008,
+			},
+			tech: "DRAM",
+		},
+		{
+			name:       "NVDIMM-N",
+			md:         &structures.MemoryDevice{MemoryTechnology: 0x04},
+			tech:       "NVDIMM-N",
+			persistent: true,
+		},
+		{
+			name: "Optane, memory and app direct modes",
+			md: &structures.MemoryDevice{
+				MemoryTechnology:              0x07,
+				MemoryOperatingModeCapability: 0x0018,
+			},
+			tech:       "Intel Optane persistent memory",
+			persistent: true,
+		},
+		{
+			name: "other, block-accessible persistent",
+			md: &structures.MemoryDevice{
+				MemoryTechnology:              0x01,
+				MemoryOperatingModeCapability: 0x0020,
+			},
+			tech:       "Other",
+			persistent: true,
+		},
+		{
+			name: "unrecognized",
+			md:   &structures.MemoryDevice{MemoryTechnology: 0x20},
+			tech: "MemoryTechnology(32)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.tech, tt.md.Technology().String()); diff != "" {
+				t.Fatalf("unexpected technology (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.persistent, tt.md.IsPersistent()); diff != "" {
+				t.Fatalf("unexpected persistence (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMemoryTypeDetail(t *testing.T) {
+	tests := []struct {
+		name  string
+		d     structures.MemoryTypeDetail
+		names []string
+		s     string
+		json  string
+	}{
+		{
+			name: "none",
+			s:    "None",
+			json: `[]`,
+		},
+		{
+			name: "reserved",
+			d:    0x0001,
+			s:    "None",
+			json: `[]`,
+		},
+		{
+			name:  "registered",
+			d:     structures.MemoryTypeDetailSynchronous | structures.MemoryTypeDetailRegistered,
+			names: []string{"Synchronous", "Registered (Buffered)"},
+			s:     "Synchronous Registered (Buffered)",
+			json:  `["Synchronous","Registered (Buffered)"]`,
+		},
+		{
+			name:  "LRDIMM",
+			d:     0x8080,
+			names: []string{"Synchronous", "LRDIMM"},
+			s:     "Synchronous LRDIMM",
+			json:  `["Synchronous","LRDIMM"]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.names, tt.d.Names()); diff != "" {
+				t.Fatalf("unexpected names (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.s, tt.d.String()); diff != "" {
+				t.Fatalf("unexpected string (-want +got):\n%s", diff)
+			}
+
+			b, err := json.Marshal(tt.d)
+			if err != nil {
+				t.Fatalf("failed to marshal JSON: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.json, string(b)); diff != "" {
+				t.Fatalf("unexpected JSON (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMemoryDeviceStrings(t *testing.T) {
+	got := []string{
+		structures.MemoryFormFactorSODIMM.String(),
+		structures.MemoryFormFactor(0xff).String(),
+		structures.MemoryDeviceTypeDDR5.String(),
+		structures.MemoryDeviceType(0x15).String(),
+		(structures.MemoryOperatingModeVolatile | structures.MemoryOperatingModeBytePersistent).String(),
+		structures.MemoryOperatingModes(0x0001).String(),
+	}
+
+	want := []string{
+		"SODIMM",
+		"MemoryFormFactor(255)",
+		"DDR5",
+		"MemoryDeviceType(21)",
+		"Volatile memory, Byte-accessible persistent memory",
+		"None",
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected strings (-want +got):\n%s", diff)
+	}
+}
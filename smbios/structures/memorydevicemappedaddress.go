@@ -0,0 +1,124 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeMemoryDeviceMappedAddress is the structure type of a Memory Device
+// Mapped Address (type 20).
+const TypeMemoryDeviceMappedAddress = 20
+
+// A MemoryDeviceMappedAddress is a Memory Device Mapped Address (type 20)
+// structure, which describes the physical address range mapped to a
+// MemoryDevice.
+type MemoryDeviceMappedAddress struct {
+	Header smbios.Header
+
+	// SMBIOS 2.1+.
+	StartingAddress                uint32
+	EndingAddress                  uint32
+	MemoryDeviceHandle             uint16
+	MemoryArrayMappedAddressHandle uint16
+	PartitionRowPosition           uint8
+	InterleavePosition             uint8
+	InterleavedDataDepth           uint8
+
+	// SMBIOS 2.7+.
+	ExtendedStartingAddress uint64
+	ExtendedEndingAddress   uint64
+}
+
+// ParseMemoryDeviceMappedAddress parses a MemoryDeviceMappedAddress from a
+// Structure.
+func ParseMemoryDeviceMappedAddress(s *smbios.Structure) (*MemoryDeviceMappedAddress, error) {
+	// The SMBIOS 2.1 structure ends after the interleaved data depth field.
+	if err := checkStructure(s, TypeMemoryDeviceMappedAddress, "memory device mapped address", 0x13); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+
+	return &MemoryDeviceMappedAddress{
+		Header: s.Header,
+
+		StartingAddress:                f.dword(0x04),
+		EndingAddress:                  f.dword(0x08),
+		MemoryDeviceHandle:             f.word(0x0c),
+		MemoryArrayMappedAddressHandle: f.word(0x0e),
+		PartitionRowPosition:           f.byte(0x10),
+		InterleavePosition:             f.byte(0x11),
+		InterleavedDataDepth:           f.byte(0x12),
+
+		ExtendedStartingAddress: f.qword(0x13),
+		ExtendedEndingAddress:   f.qword(0x1b),
+	}, nil
+}
+
+// MemoryDeviceMappedAddresses parses all MemoryDeviceMappedAddresses from a
+// list of Structures, ignoring Structures of other types.
+func MemoryDeviceMappedAddresses(ss []*smbios.Structure) ([]*MemoryDeviceMappedAddress, error) {
+	var mds []*MemoryDeviceMappedAddress
+	for _, s := range ss {
+		if s.Header.Type != TypeMemoryDeviceMappedAddress {
+			continue
+		}
+
+		md, err := ParseMemoryDeviceMappedAddress(s)
+		if err = processed(md, err); err != nil {
+			return nil, err
+		}
+
+		mds = append(mds, md)
+	}
+
+	return mds, nil
+}
+
+// AddressRange returns the inclusive range of physical byte addresses mapped
+// to the memory device, consulting the SMBIOS 2.7+ extended address fields
+// when required.  If the range is unknown or invalid, AddressRange returns
+// false.
+func (md *MemoryDeviceMappedAddress) AddressRange() (start, end uint64, ok bool) {
+	return addressRange(
+		md.StartingAddress, md.EndingAddress,
+		md.ExtendedStartingAddress, md.ExtendedEndingAddress,
+	)
+}
+
+// SizeBytes returns the number of bytes mapped to the memory device.  If the
+// address range is unknown, SizeBytes returns false.
+func (md *MemoryDeviceMappedAddress) SizeBytes() (uint64, bool) {
+	start, end, ok := md.AddressRange()
+	if !ok {
+		return 0, false
+	}
+
+	return end - start + 1, true
+}
+
+// Interleave returns the position of the memory device within an interleave
+// and the number of consecutive rows accessed from the device in a single
+// interleaved transfer.  A position of 0 indicates that the device is not
+// interleaved.  If the interleave position or depth is unknown, Interleave
+// returns false.
+func (md *MemoryDeviceMappedAddress) Interleave() (position, depth uint8, ok bool) {
+	if md.InterleavePosition == 0xff || md.InterleavedDataDepth == 0xff {
+		return 0, 0, false
+	}
+
+	return md.InterleavePosition, md.InterleavedDataDepth, true
+}
@@ -0,0 +1,124 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseMemoryDeviceMappedAddress(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		md   *structures.MemoryDeviceMappedAddress
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s:    newBuilder(19, 0x13).structure(),
+		},
+		{
+			name: "too short",
+			s:    newBuilder(20, 0x12).structure(),
+		},
+		{
+			name: "SMBIOS 2.1",
+			s: newBuilder(20, 0x13).
+				dword(0x04, 0x00000000).
+				dword(0x08, 0x003fffff).
+				word(0x0c, 0x1100).
+				word(0x0e, 0x1300).
+				byte(0x10, 0xff).
+				byte(0x11, 0x01).
+				byte(0x12, 0x02).
+				structure(),
+			md: &structures.MemoryDeviceMappedAddress{
+				Header:                         header(20, 0x13),
+				EndingAddress:                  0x003fffff,
+				MemoryDeviceHandle:             0x1100,
+				MemoryArrayMappedAddressHandle: 0x1300,
+				PartitionRowPosition:           0xff,
+				InterleavePosition:             1,
+				InterleavedDataDepth:           2,
+			},
+			ok: true,
+		},
+		{
+			name: "SMBIOS 2.7",
+			s: newBuilder(20, 0x23).
+				dword(0x04, 0xffffffff).
+				dword(0x08, 0xffffffff).
+				word(0x0c, 0x1100).
+				word(0x0e, 0x1300).
+				byte(0x10, 0xff).
+				qword(0x13, 0x0000010000000000).
+				qword(0x1b, 0x0000013fffffffff).
+				structure(),
+			md: &structures.MemoryDeviceMappedAddress{
+				Header:                         header(20, 0x23),
+				StartingAddress:                0xffffffff,
+				EndingAddress:                  0xffffffff,
+				MemoryDeviceHandle:             0x1100,
+				MemoryArrayMappedAddressHandle: 0x1300,
+				PartitionRowPosition:           0xff,
+				ExtendedStartingAddress:        0x0000010000000000,
+				ExtendedEndingAddress:          0x0000013fffffffff,
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md, err := structures.ParseMemoryDeviceMappedAddress(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.md, md); diff != "" {
+				t.Fatalf("unexpected memory device mapped address (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMemoryDeviceMappedAddressAccessors(t *testing.T) {
+	md := &structures.MemoryDeviceMappedAddress{
+		EndingAddress:        0x003fffff,
+		InterleavePosition:   1,
+		InterleavedDataDepth: 2,
+	}
+
+	if size, ok := md.SizeBytes(); !ok || size != 4<<30 {
+		t.Fatalf("unexpected size: %d, %v", size, ok)
+	}
+
+	if pos, depth, ok := md.Interleave(); !ok || pos != 1 || depth != 2 {
+		t.Fatalf("unexpected interleave: %d, %d, %v", pos, depth, ok)
+	}
+
+	md.InterleavePosition = 0xff
+	if _, _, ok := md.Interleave(); ok {
+		t.Fatal("expected unknown interleave")
+	}
+}
@@ -0,0 +1,292 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"fmt"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeMemoryErrorInformation32 is the structure type of 32-Bit Memory Error
+// Information (type 18).
+const TypeMemoryErrorInformation32 = 18
+
+// Special values of the memory error information handles in
+// PhysicalMemoryArray and MemoryDevice structures.
+const (
+	// MemoryErrorHandleNotProvided indicates that no error information is
+	// provided for the memory.
+	MemoryErrorHandleNotProvided = 0xfffe
+
+	// MemoryErrorHandleNoError indicates that no error has been detected in
+	// the memory.
+	MemoryErrorHandleNoError = 0xffff
+)
+
+// A MemoryErrorInformation32 is a 32-Bit Memory Error Information (type 18)
+// structure, which describes the most recent error detected in a memory
+// array or device.
+type MemoryErrorInformation32 struct {
+	Header smbios.Header
+
+	ErrorType               MemoryErrorType
+	ErrorGranularity        MemoryErrorGranularity
+	ErrorOperation          MemoryErrorOperation
+	VendorSyndrome          uint32
+	MemoryArrayErrorAddress uint32
+	DeviceErrorAddress      uint32
+	ErrorResolution         uint32
+}
+
+// ParseMemoryErrorInformation32 parses a MemoryErrorInformation32 from a
+// Structure.
+func ParseMemoryErrorInformation32(s *smbios.Structure) (*MemoryErrorInformation32, error) {
+	if err := checkStructure(s, TypeMemoryErrorInformation32, "32-bit memory error information", 0x17); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+
+	return &MemoryErrorInformation32{
+		Header: s.Header,
+
+		ErrorType:               MemoryErrorType(f.byte(0x04)),
+		ErrorGranularity:        MemoryErrorGranularity(f.byte(0x05)),
+		ErrorOperation:          MemoryErrorOperation(f.byte(0x06)),
+		VendorSyndrome:          f.dword(0x07),
+		MemoryArrayErrorAddress: f.dword(0x0b),
+		DeviceErrorAddress:      f.dword(0x0f),
+		ErrorResolution:         f.dword(0x13),
+	}, nil
+}
+
+// LookupMemoryErrorInformation32 finds and parses the
+// MemoryErrorInformation32 referred to by handle, such as the memory error
+// information handle of a PhysicalMemoryArray or MemoryDevice.  If handle
+// indicates that no error information is provided or no error was detected,
+// or handle does not refer to a MemoryErrorInformation32,
+// LookupMemoryErrorInformation32 returns nil.
+func LookupMemoryErrorInformation32(ss []*smbios.Structure, handle uint16) (*MemoryErrorInformation32, error) {
+	if handle == MemoryErrorHandleNotProvided || handle == MemoryErrorHandleNoError {
+		return nil, nil
+	}
+
+	for _, s := range ss {
+		if s.Header.Handle != handle || s.Header.Type != TypeMemoryErrorInformation32 {
+			continue
+		}
+
+		v, err := ParseMemoryErrorInformation32(s)
+		if err = processed(v, err); err != nil {
+			return nil, err
+		}
+
+		return v, nil
+	}
+
+	return nil, nil
+}
+
+// memoryErrorAddress interprets a 32-bit memory error address field, for
+// which 0x80000000 indicates an unknown address.
+func memoryErrorAddress(v uint32) (uint32, bool) {
+	return v, v != 0x80000000
+}
+
+// ArrayAddress returns the 32-bit physical address of the error within the
+// memory array.  If the address is unknown, ArrayAddress returns false.
+func (me *MemoryErrorInformation32) ArrayAddress() (uint32, bool) {
+	return memoryErrorAddress(me.MemoryArrayErrorAddress)
+}
+
+// DeviceAddress returns the 32-bit physical address of the error relative to
+// the start of the failing memory device.  If the address is unknown,
+// DeviceAddress returns false.
+func (me *MemoryErrorInformation32) DeviceAddress() (uint32, bool) {
+	return memoryErrorAddress(me.DeviceErrorAddress)
+}
+
+// Resolution returns the range, in bytes, within which the error can be
+// determined when an error address is given.  If the resolution is unknown,
+// Resolution returns false.
+func (me *MemoryErrorInformation32) Resolution() (uint32, bool) {
+	return memoryErrorAddress(me.ErrorResolution)
+}
+
+// A MemoryErrorType is the type of a memory error.
+type MemoryErrorType uint8
+
+// Possible MemoryErrorType values.
+const (
+	MemoryErrorTypeOther              MemoryErrorType = 0x01
+	MemoryErrorTypeUnknown            MemoryErrorType = 0x02
+	MemoryErrorTypeOK                 MemoryErrorType = 0x03
+	MemoryErrorTypeBadRead            MemoryErrorType = 0x04
+	MemoryErrorTypeParity             MemoryErrorType = 0x05
+	MemoryErrorTypeSingleBit          MemoryErrorType = 0x06
+	MemoryErrorTypeDoubleBit          MemoryErrorType = 0x07
+	MemoryErrorTypeMultiBit           MemoryErrorType = 0x08
+	MemoryErrorTypeNibble             MemoryErrorType = 0x09
+	MemoryErrorTypeChecksum           MemoryErrorType = 0x0a
+	MemoryErrorTypeCRC                MemoryErrorType = 0x0b
+	MemoryErrorTypeCorrectedSingleBit MemoryErrorType = 0x0c
+	MemoryErrorTypeCorrected          MemoryErrorType = 0x0d
+	MemoryErrorTypeUncorrectable      MemoryErrorType = 0x0e
+)
+
+// memoryErrorTypeNames are the names of each MemoryErrorType, as given in the
+// SMBIOS specification.
+var memoryErrorTypeNames = map[MemoryErrorType]string{
+	MemoryErrorTypeOther:              "Other",
+	MemoryErrorTypeUnknown:            "Unknown",
+	MemoryErrorTypeOK:                 "OK",
+	MemoryErrorTypeBadRead:            "Bad read",
+	MemoryErrorTypeParity:             "Parity error",
+	MemoryErrorTypeSingleBit:          "Single-bit error",
+	MemoryErrorTypeDoubleBit:          "Double-bit error",
+	MemoryErrorTypeMultiBit:           "Multi-bit error",
+	MemoryErrorTypeNibble:             "Nibble error",
+	MemoryErrorTypeChecksum:           "Checksum error",
+	MemoryErrorTypeCRC:                "CRC error",
+	MemoryErrorTypeCorrectedSingleBit: "Corrected single-bit error",
+	MemoryErrorTypeCorrected:          "Corrected error",
+	MemoryErrorTypeUncorrectable:      "Uncorrectable error",
+}
+
+// String returns the name of a MemoryErrorType as given in the SMBIOS
+// specification.
+func (t MemoryErrorType) String() string {
+	if s, ok := memoryErrorTypeNames[t]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("MemoryErrorType(%d)", uint8(t))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding t as its name.
+func (t MemoryErrorType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (t *MemoryErrorType) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "MemoryErrorType", func(v uint8) string { return MemoryErrorType(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*t = MemoryErrorType(v)
+	return nil
+}
+
+// A MemoryErrorGranularity is the granularity to which a memory error can be
+// resolved.
+type MemoryErrorGranularity uint8
+
+// Possible MemoryErrorGranularity values.
+const (
+	MemoryErrorGranularityOther     MemoryErrorGranularity = 0x01
+	MemoryErrorGranularityUnknown   MemoryErrorGranularity = 0x02
+	MemoryErrorGranularityDevice    MemoryErrorGranularity = 0x03
+	MemoryErrorGranularityPartition MemoryErrorGranularity = 0x04
+)
+
+// memoryErrorGranularityNames are the names of each MemoryErrorGranularity,
+// as given in the SMBIOS specification.
+var memoryErrorGranularityNames = map[MemoryErrorGranularity]string{
+	MemoryErrorGranularityOther:     "Other",
+	MemoryErrorGranularityUnknown:   "Unknown",
+	MemoryErrorGranularityDevice:    "Device level",
+	MemoryErrorGranularityPartition: "Memory partition level",
+}
+
+// String returns the name of a MemoryErrorGranularity as given in the SMBIOS
+// specification.
+func (g MemoryErrorGranularity) String() string {
+	if s, ok := memoryErrorGranularityNames[g]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("MemoryErrorGranularity(%d)", uint8(g))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding g as its name.
+func (g MemoryErrorGranularity) MarshalText() ([]byte, error) {
+	return []byte(g.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (g *MemoryErrorGranularity) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "MemoryErrorGranularity", func(v uint8) string { return MemoryErrorGranularity(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*g = MemoryErrorGranularity(v)
+	return nil
+}
+
+// A MemoryErrorOperation is the memory access operation which caused a memory
+// error.
+type MemoryErrorOperation uint8
+
+// Possible MemoryErrorOperation values.
+const (
+	MemoryErrorOperationOther        MemoryErrorOperation = 0x01
+	MemoryErrorOperationUnknown      MemoryErrorOperation = 0x02
+	MemoryErrorOperationRead         MemoryErrorOperation = 0x03
+	MemoryErrorOperationWrite        MemoryErrorOperation = 0x04
+	MemoryErrorOperationPartialWrite MemoryErrorOperation = 0x05
+)
+
+// memoryErrorOperationNames are the names of each MemoryErrorOperation, as
+// given in the SMBIOS specification.
+var memoryErrorOperationNames = map[MemoryErrorOperation]string{
+	MemoryErrorOperationOther:        "Other",
+	MemoryErrorOperationUnknown:      "Unknown",
+	MemoryErrorOperationRead:         "Read",
+	MemoryErrorOperationWrite:        "Write",
+	MemoryErrorOperationPartialWrite: "Partial write",
+}
+
+// String returns the name of a MemoryErrorOperation as given in the SMBIOS
+// specification.
+func (o MemoryErrorOperation) String() string {
+	if s, ok := memoryErrorOperationNames[o]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("MemoryErrorOperation(%d)", uint8(o))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding o as its name.
+func (o MemoryErrorOperation) MarshalText() ([]byte, error) {
+	return []byte(o.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (o *MemoryErrorOperation) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "MemoryErrorOperation", func(v uint8) string { return MemoryErrorOperation(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*o = MemoryErrorOperation(v)
+	return nil
+}
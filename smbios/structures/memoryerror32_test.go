@@ -0,0 +1,158 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseMemoryErrorInformation32(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		me   *structures.MemoryErrorInformation32
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s:    newBuilder(17, 0x17).structure(),
+		},
+		{
+			name: "too short",
+			s:    newBuilder(18, 0x16).structure(),
+		},
+		{
+			name: "OK",
+			s: newBuilder(18, 0x17).
+				byte(0x04, 0x0c).
+				byte(0x05, 0x03).
+				byte(0x06, 0x03).
+				dword(0x07, 0xdeadbeef).
+				dword(0x0b, 0x80000000).
+				dword(0x0f, 0x00001000).
+				dword(0x13, 64).
+				structure(),
+			me: &structures.MemoryErrorInformation32{
+				Header:                  header(18, 0x17),
+				ErrorType:               structures.MemoryErrorTypeCorrectedSingleBit,
+				ErrorGranularity:        structures.MemoryErrorGranularityDevice,
+				ErrorOperation:          structures.MemoryErrorOperationRead,
+				VendorSyndrome:          0xdeadbeef,
+				MemoryArrayErrorAddress: 0x80000000,
+				DeviceErrorAddress:      0x00001000,
+				ErrorResolution:         64,
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			me, err := structures.ParseMemoryErrorInformation32(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.me, me); diff != "" {
+				t.Fatalf("unexpected memory error information (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMemoryErrorInformation32Addresses(t *testing.T) {
+	me := &structures.MemoryErrorInformation32{
+		MemoryArrayErrorAddress: 0x80000000,
+		DeviceErrorAddress:      0x00001000,
+		ErrorResolution:         64,
+	}
+
+	type address struct {
+		Value uint32
+		OK    bool
+	}
+
+	var got []address
+	for _, fn := range []func() (uint32, bool){me.ArrayAddress, me.DeviceAddress, me.Resolution} {
+		v, ok := fn()
+		got = append(got, address{Value: v, OK: ok})
+	}
+
+	want := []address{
+		{Value: 0x80000000},
+		{Value: 0x00001000, OK: true},
+		{Value: 64, OK: true},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected addresses (-want +got):\n%s", diff)
+	}
+}
+
+func TestLookupMemoryErrorInformation32(t *testing.T) {
+	me := newBuilder(18, 0x17).byte(0x04, 0x03).structure()
+	me.Header.Handle = 0x0012
+
+	md := newBuilder(17, 0x15).word(0x06, 0x0012).structure()
+	ss := []*smbios.Structure{md, me}
+
+	tests := []struct {
+		name   string
+		handle uint16
+		ok     bool
+	}{
+		{
+			name:   "not provided",
+			handle: structures.MemoryErrorHandleNotProvided,
+		},
+		{
+			name:   "no error",
+			handle: structures.MemoryErrorHandleNoError,
+		},
+		{
+			name:   "wrong type",
+			handle: md.Header.Handle,
+		},
+		{
+			name:   "OK",
+			handle: 0x0012,
+			ok:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := structures.LookupMemoryErrorInformation32(ss, tt.handle)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if ok := got != nil; tt.ok != ok {
+				t.Fatalf("unexpected lookup result: %v", got)
+			}
+			if tt.ok && got.ErrorType != structures.MemoryErrorTypeOK {
+				t.Fatalf("unexpected error type: %v", got.ErrorType)
+			}
+		})
+	}
+}
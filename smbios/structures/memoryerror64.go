@@ -0,0 +1,112 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeMemoryErrorInformation64 is the structure type of 64-Bit Memory Error
+// Information (type 33).
+const TypeMemoryErrorInformation64 = 33
+
+// A MemoryErrorInformation64 is a 64-Bit Memory Error Information (type 33)
+// structure, which describes the most recent error detected in a memory
+// array or device on systems with error addresses beyond 4GB.
+type MemoryErrorInformation64 struct {
+	Header smbios.Header
+
+	ErrorType               MemoryErrorType
+	ErrorGranularity        MemoryErrorGranularity
+	ErrorOperation          MemoryErrorOperation
+	VendorSyndrome          uint32
+	MemoryArrayErrorAddress uint64
+	DeviceErrorAddress      uint64
+	ErrorResolution         uint32
+}
+
+// ParseMemoryErrorInformation64 parses a MemoryErrorInformation64 from a
+// Structure.
+func ParseMemoryErrorInformation64(s *smbios.Structure) (*MemoryErrorInformation64, error) {
+	if err := checkStructure(s, TypeMemoryErrorInformation64, "64-bit memory error information", 0x1f); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+
+	return &MemoryErrorInformation64{
+		Header: s.Header,
+
+		ErrorType:               MemoryErrorType(f.byte(0x04)),
+		ErrorGranularity:        MemoryErrorGranularity(f.byte(0x05)),
+		ErrorOperation:          MemoryErrorOperation(f.byte(0x06)),
+		VendorSyndrome:          f.dword(0x07),
+		MemoryArrayErrorAddress: f.qword(0x0b),
+		DeviceErrorAddress:      f.qword(0x13),
+		ErrorResolution:         f.dword(0x1b),
+	}, nil
+}
+
+// LookupMemoryErrorInformation64 finds and parses the
+// MemoryErrorInformation64 referred to by handle, like
+// LookupMemoryErrorInformation32.  If handle indicates that no error
+// information is provided or no error was detected, or handle does not refer
+// to a MemoryErrorInformation64, LookupMemoryErrorInformation64 returns nil.
+func LookupMemoryErrorInformation64(ss []*smbios.Structure, handle uint16) (*MemoryErrorInformation64, error) {
+	if handle == MemoryErrorHandleNotProvided || handle == MemoryErrorHandleNoError {
+		return nil, nil
+	}
+
+	for _, s := range ss {
+		if s.Header.Handle != handle || s.Header.Type != TypeMemoryErrorInformation64 {
+			continue
+		}
+
+		v, err := ParseMemoryErrorInformation64(s)
+		if err = processed(v, err); err != nil {
+			return nil, err
+		}
+
+		return v, nil
+	}
+
+	return nil, nil
+}
+
+// memoryErrorAddress64 interprets a 64-bit memory error address field, for
+// which 0x8000000000000000 indicates an unknown address.
+func memoryErrorAddress64(v uint64) (uint64, bool) {
+	return v, v != 0x8000000000000000
+}
+
+// ArrayAddress returns the 64-bit physical address of the error within the
+// memory array.  If the address is unknown, ArrayAddress returns false.
+func (me *MemoryErrorInformation64) ArrayAddress() (uint64, bool) {
+	return memoryErrorAddress64(me.MemoryArrayErrorAddress)
+}
+
+// DeviceAddress returns the 64-bit physical address of the error relative to
+// the start of the failing memory device.  If the address is unknown,
+// DeviceAddress returns false.
+func (me *MemoryErrorInformation64) DeviceAddress() (uint64, bool) {
+	return memoryErrorAddress64(me.DeviceErrorAddress)
+}
+
+// Resolution returns the range, in bytes, within which the error can be
+// determined when an error address is given.  If the resolution is unknown,
+// Resolution returns false.
+func (me *MemoryErrorInformation64) Resolution() (uint32, bool) {
+	return memoryErrorAddress(me.ErrorResolution)
+}
@@ -0,0 +1,138 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseMemoryErrorInformation64(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		me   *structures.MemoryErrorInformation64
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s:    newBuilder(18, 0x1f).structure(),
+		},
+		{
+			name: "too short",
+			s:    newBuilder(33, 0x1e).structure(),
+		},
+		{
+			name: "OK",
+			s: newBuilder(33, 0x1f).
+				byte(0x04, 0x0e).
+				byte(0x05, 0x04).
+				byte(0x06, 0x04).
+				dword(0x07, 0xdeadbeef).
+				qword(0x0b, 0x0000001234567000).
+				qword(0x13, 0x8000000000000000).
+				dword(0x1b, 0x80000000).
+				structure(),
+			me: &structures.MemoryErrorInformation64{
+				Header:                  header(33, 0x1f),
+				ErrorType:               structures.MemoryErrorTypeUncorrectable,
+				ErrorGranularity:        structures.MemoryErrorGranularityPartition,
+				ErrorOperation:          structures.MemoryErrorOperationWrite,
+				VendorSyndrome:          0xdeadbeef,
+				MemoryArrayErrorAddress: 0x0000001234567000,
+				DeviceErrorAddress:      0x8000000000000000,
+				ErrorResolution:         0x80000000,
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			me, err := structures.ParseMemoryErrorInformation64(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.me, me); diff != "" {
+				t.Fatalf("unexpected memory error information (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMemoryErrorInformation64Addresses(t *testing.T) {
+	me := &structures.MemoryErrorInformation64{
+		MemoryArrayErrorAddress: 0x0000001234567000,
+		DeviceErrorAddress:      0x8000000000000000,
+		ErrorResolution:         0x80000000,
+	}
+
+	type address struct {
+		Value uint64
+		OK    bool
+	}
+
+	var got []address
+	for _, fn := range []func() (uint64, bool){me.ArrayAddress, me.DeviceAddress} {
+		v, ok := fn()
+		got = append(got, address{Value: v, OK: ok})
+	}
+
+	want := []address{
+		{Value: 0x0000001234567000, OK: true},
+		{Value: 0x8000000000000000},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected addresses (-want +got):\n%s", diff)
+	}
+
+	if _, ok := me.Resolution(); ok {
+		t.Fatal("expected unknown resolution")
+	}
+}
+
+func TestLookupMemoryErrorInformation64(t *testing.T) {
+	me := newBuilder(33, 0x1f).byte(0x04, 0x03).structure()
+	me.Header.Handle = 0x0021
+
+	ss := []*smbios.Structure{newBuilder(18, 0x17).structure(), me}
+
+	for _, handle := range []uint16{structures.MemoryErrorHandleNoError, 0x0100} {
+		got, err := structures.LookupMemoryErrorInformation64(ss, handle)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != nil {
+			t.Fatalf("unexpected lookup result for handle %#04x: %v", handle, got)
+		}
+	}
+
+	got, err := structures.LookupMemoryErrorInformation64(ss, 0x0021)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got == nil || got.ErrorType != structures.MemoryErrorTypeOK {
+		t.Fatalf("unexpected lookup result: %v", got)
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/humanize"
+)
+
+// TypeMemoryModule is the structure type of Memory Module Information (type
+// 6).
+const TypeMemoryModule = 6
+
+// A MemoryModule is a Memory Module Information (type 6) structure, which
+// describes a memory module installed in a socket of a MemoryController.
+//
+// This structure is obsolete as of SMBIOS 2.1, and is superseded by
+// MemoryDevice.
+type MemoryModule struct {
+	Header smbios.Header
+
+	SocketDesignation string
+	BankConnections   uint8
+	CurrentSpeed      uint8
+	CurrentMemoryType MemoryModuleTypes
+	InstalledSize     MemoryModuleSize
+	EnabledSize       MemoryModuleSize
+	ErrorStatus       MemoryModuleErrorStatus
+}
+
+// ParseMemoryModule parses a MemoryModule from a Structure.
+func ParseMemoryModule(s *smbios.Structure) (*MemoryModule, error) {
+	if err := checkStructure(s, TypeMemoryModule, "memory module", 0x0c); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+
+	return &MemoryModule{
+		Header: s.Header,
+
+		SocketDesignation: f.str(0x04),
+		BankConnections:   f.byte(0x05),
+		CurrentSpeed:      f.byte(0x06),
+		CurrentMemoryType: MemoryModuleTypes(f.word(0x07)),
+		InstalledSize:     MemoryModuleSize(f.byte(0x09)),
+		EnabledSize:       MemoryModuleSize(f.byte(0x0a)),
+		ErrorStatus:       MemoryModuleErrorStatus(f.byte(0x0b)),
+	}, nil
+}
+
+// Banks returns the RAS bank numbers which the module is connected to.
+func (mm *MemoryModule) Banks() []int {
+	var banks []int
+
+	// Each nibble holds a bank number, and 0xf indicates no connection.
+	for _, b := range []uint8{mm.BankConnections >> 4, mm.BankConnections & 0x0f} {
+		if b != 0x0f {
+			banks = append(banks, int(b))
+		}
+	}
+
+	return banks
+}
+
+// CurrentSpeedNanoseconds returns the speed of the module in nanoseconds.  If
+// the speed is unknown, CurrentSpeedNanoseconds returns false.
+func (mm *MemoryModule) CurrentSpeedNanoseconds() (int, bool) {
+	return int(mm.CurrentSpeed), mm.CurrentSpeed != 0
+}
+
+// A MemoryModuleSize is the installed or enabled size of a MemoryModule.
+type MemoryModuleSize uint8
+
+// Special MemoryModuleSize values, ignoring the double-bank bit.
+const (
+	MemoryModuleSizeNotDeterminable MemoryModuleSize = 0x7d
+	MemoryModuleSizeNotEnabled      MemoryModuleSize = 0x7e
+	MemoryModuleSizeNotInstalled    MemoryModuleSize = 0x7f
+)
+
+// Bytes returns the size in bytes.  If the size is not determinable, or the
+// module is not installed or enabled, Bytes returns false.
+func (s MemoryModuleSize) Bytes() (uint64, bool) {
+	// Bits 0-6 specify the size as a power of 2 in megabytes.
+	n := s & 0x7f
+	switch n {
+	case MemoryModuleSizeNotDeterminable, MemoryModuleSizeNotEnabled, MemoryModuleSizeNotInstalled:
+		return 0, false
+	}
+
+	if n > 43 {
+		// Not representable in bytes.
+		return 0, false
+	}
+
+	return 1 << (uint(n) + 20), true
+}
+
+// DoubleBank reports whether the module has a double-bank connection.
+func (s MemoryModuleSize) DoubleBank() bool {
+	return s&0x80 != 0
+}
+
+// String returns a description of the size and bank connection, such as
+// "256 MiB (Double-bank Connection)" or "Not Installed".
+func (s MemoryModuleSize) String() string {
+	switch s & 0x7f {
+	case MemoryModuleSizeNotDeterminable:
+		return "Not Determinable"
+	case MemoryModuleSizeNotEnabled:
+		return "Not Enabled"
+	case MemoryModuleSizeNotInstalled:
+		return "Not Installed"
+	}
+
+	b, ok := s.Bytes()
+	if !ok {
+		return "Unknown"
+	}
+
+	conn := " (Single-bank Connection)"
+	if s.DoubleBank() {
+		conn = " (Double-bank Connection)"
+	}
+
+	return humanize.Bytes(b) + conn
+}
+
+// A MemoryModuleErrorStatus describes errors detected in a MemoryModule.
+type MemoryModuleErrorStatus uint8
+
+// InEventLog reports whether error status is recorded in the event log rather
+// than in the MemoryModuleErrorStatus.
+func (e MemoryModuleErrorStatus) InEventLog() bool {
+	return e&0x04 != 0
+}
+
+// Uncorrectable reports whether uncorrectable errors were detected.
+func (e MemoryModuleErrorStatus) Uncorrectable() bool {
+	return !e.InEventLog() && e&0x01 != 0
+}
+
+// Correctable reports whether correctable errors were detected.
+func (e MemoryModuleErrorStatus) Correctable() bool {
+	return !e.InEventLog() && e&0x02 != 0
+}
+
+// String returns a description of the error status, as dmidecode does.
+func (e MemoryModuleErrorStatus) String() string {
+	switch {
+	case e.InEventLog():
+		return "See Event Log"
+	case e.Uncorrectable():
+		return "Uncorrectable Errors"
+	case e.Correctable():
+		return "Correctable Errors"
+	default:
+		return "OK"
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseMemoryModule(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		mm   *structures.MemoryModule
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s:    newBuilder(5, 0x0c).structure(),
+		},
+		{
+			name: "too short",
+			s:    newBuilder(6, 0x0b).structure(),
+		},
+		{
+			name: "OK",
+			s: newBuilder(6, 0x0c, "DIMM 0").
+				byte(0x04, 1).
+				byte(0x05, 0x01).
+				byte(0x06, 60).
+				word(0x07, 0x0500).
+				byte(0x09, 0x88).
+				byte(0x0a, 0x7e).
+				byte(0x0b, 0x02).
+				structure(),
+			mm: &structures.MemoryModule{
+				Header:            header(6, 0x0c),
+				SocketDesignation: "DIMM 0",
+				BankConnections:   0x01,
+				CurrentSpeed:      60,
+				CurrentMemoryType: structures.MemoryModuleTypeDIMM | structures.MemoryModuleTypeSDRAM,
+				InstalledSize:     0x88,
+				EnabledSize:       structures.MemoryModuleSizeNotEnabled,
+				ErrorStatus:       0x02,
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mm, err := structures.ParseMemoryModule(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.mm, mm); diff != "" {
+				t.Fatalf("unexpected memory module (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMemoryModuleBanks(t *testing.T) {
+	tests := []struct {
+		name  string
+		conn  uint8
+		banks []int
+	}{
+		{name: "none", conn: 0xff},
+		{name: "one", conn: 0xf2, banks: []int{2}},
+		{name: "two", conn: 0x01, banks: []int{0, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			banks := (&structures.MemoryModule{BankConnections: tt.conn}).Banks()
+			if diff := cmp.Diff(tt.banks, banks); diff != "" {
+				t.Fatalf("unexpected banks (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMemoryModuleSize(t *testing.T) {
+	tests := []struct {
+		size  structures.MemoryModuleSize
+		bytes uint64
+		ok    bool
+		s     string
+	}{
+		{size: 0x08, bytes: 256 << 20, ok: true, s: "256 MiB (Single-bank Connection)"},
+		{size: 0x8b, bytes: 2 << 30, ok: true, s: "2 GiB (Double-bank Connection)"},
+		{size: 0x7d, s: "Not Determinable"},
+		{size: 0xfe, s: "Not Enabled"},
+		{size: 0x7f, s: "Not Installed"},
+		{size: 0x70, s: "Unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			b, ok := tt.size.Bytes()
+			if diff := cmp.Diff(tt.bytes, b); diff != "" {
+				t.Fatalf("unexpected bytes (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.ok, ok); diff != "" {
+				t.Fatalf("unexpected OK (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.s, tt.size.String()); diff != "" {
+				t.Fatalf("unexpected string (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMemoryModuleErrorStatus(t *testing.T) {
+	tests := []struct {
+		status structures.MemoryModuleErrorStatus
+		s      string
+	}{
+		{status: 0x00, s: "OK"},
+		{status: 0x01, s: "Uncorrectable Errors"},
+		{status: 0x02, s: "Correctable Errors"},
+		{status: 0x07, s: "See Event Log"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if diff := cmp.Diff(tt.s, tt.status.String()); diff != "" {
+				t.Fatalf("unexpected string (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
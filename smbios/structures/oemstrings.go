@@ -0,0 +1,58 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"fmt"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeOEMStrings is the structure type of OEM Strings (type 11).
+const TypeOEMStrings = 11
+
+// OEMStrings is an OEM Strings (type 11) structure, which contains free-form
+// strings defined by the manufacturer, such as part numbers or identifiers
+// injected by a hypervisor.
+type OEMStrings struct {
+	Header smbios.Header
+
+	Strings []string
+}
+
+// ParseOEMStrings parses an OEMStrings from a Structure.
+func ParseOEMStrings(s *smbios.Structure) (*OEMStrings, error) {
+	if err := checkStructure(s, TypeOEMStrings, "OEM strings", 0x05); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+
+	// The count must not exceed the number of strings present.
+	n := int(f.byte(0x04))
+	if l := len(s.Strings); n > l {
+		return nil, fmt.Errorf("SMBIOS OEM strings structure has count %d, but only %d strings", n, l)
+	}
+
+	strs := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		strs = append(strs, s.Strings[i])
+	}
+
+	return &OEMStrings{
+		Header:  s.Header,
+		Strings: strs,
+	}, nil
+}
@@ -0,0 +1,153 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"fmt"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeOnBoardDevices is the structure type of On Board Devices Information
+// (type 10).
+const TypeOnBoardDevices = 10
+
+// OnBoardDevices is an On Board Devices Information (type 10) structure,
+// which describes devices integrated into the system board.
+//
+// This structure is obsolete as of SMBIOS 2.6 in favor of Onboard Devices
+// Extended Information (type 41), but is still provided by many systems.
+type OnBoardDevices struct {
+	Header smbios.Header
+
+	Devices []OnBoardDevice
+}
+
+// An OnBoardDevice is a single device described by an OnBoardDevices
+// structure.
+type OnBoardDevice struct {
+	Type        OnBoardDeviceType
+	Enabled     bool
+	Description string
+}
+
+// ParseOnBoardDevices parses an OnBoardDevices from a Structure.
+func ParseOnBoardDevices(s *smbios.Structure) (*OnBoardDevices, error) {
+	if err := checkStructure(s, TypeOnBoardDevices, "on board devices", 0x04); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+
+	// Each device is a type byte followed by a description string number, and
+	// the number of devices is determined by the structure's length.
+	n := len(s.Formatted) / 2
+	ds := make([]OnBoardDevice, 0, n)
+	for i := 0; i < n; i++ {
+		off := 0x04 + 2*i
+		typ := f.byte(off)
+
+		ds = append(ds, OnBoardDevice{
+			Type:        OnBoardDeviceType(typ & 0x7f),
+			Enabled:     typ&0x80 != 0,
+			Description: f.str(off + 1),
+		})
+	}
+
+	return &OnBoardDevices{
+		Header:  s.Header,
+		Devices: ds,
+	}, nil
+}
+
+// AllOnBoardDevices parses all OnBoardDevices structures from a list of
+// Structures, ignoring Structures of other types, and returns the devices
+// described by each.
+func AllOnBoardDevices(ss []*smbios.Structure) ([]OnBoardDevice, error) {
+	var ds []OnBoardDevice
+	for _, s := range ss {
+		if s.Header.Type != TypeOnBoardDevices {
+			continue
+		}
+
+		obd, err := ParseOnBoardDevices(s)
+		if err = processed(obd, err); err != nil {
+			return nil, err
+		}
+
+		ds = append(ds, obd.Devices...)
+	}
+
+	return ds, nil
+}
+
+// An OnBoardDeviceType is the type of an OnBoardDevice.
+type OnBoardDeviceType uint8
+
+// Possible OnBoardDeviceType values.
+const (
+	OnBoardDeviceTypeOther          OnBoardDeviceType = 0x01
+	OnBoardDeviceTypeUnknown        OnBoardDeviceType = 0x02
+	OnBoardDeviceTypeVideo          OnBoardDeviceType = 0x03
+	OnBoardDeviceTypeSCSIController OnBoardDeviceType = 0x04
+	OnBoardDeviceTypeEthernet       OnBoardDeviceType = 0x05
+	OnBoardDeviceTypeTokenRing      OnBoardDeviceType = 0x06
+	OnBoardDeviceTypeSound          OnBoardDeviceType = 0x07
+	OnBoardDeviceTypePATAController OnBoardDeviceType = 0x08
+	OnBoardDeviceTypeSATAController OnBoardDeviceType = 0x09
+	OnBoardDeviceTypeSASController  OnBoardDeviceType = 0x0a
+)
+
+// onBoardDeviceTypeNames are the names of each OnBoardDeviceType, as given
+// in the SMBIOS specification.
+var onBoardDeviceTypeNames = map[OnBoardDeviceType]string{
+	OnBoardDeviceTypeOther:          "Other",
+	OnBoardDeviceTypeUnknown:        "Unknown",
+	OnBoardDeviceTypeVideo:          "Video",
+	OnBoardDeviceTypeSCSIController: "SCSI Controller",
+	OnBoardDeviceTypeEthernet:       "Ethernet",
+	OnBoardDeviceTypeTokenRing:      "Token Ring",
+	OnBoardDeviceTypeSound:          "Sound",
+	OnBoardDeviceTypePATAController: "PATA Controller",
+	OnBoardDeviceTypeSATAController: "SATA Controller",
+	OnBoardDeviceTypeSASController:  "SAS Controller",
+}
+
+// String returns the name of an OnBoardDeviceType as given in the SMBIOS
+// specification.
+func (t OnBoardDeviceType) String() string {
+	if s, ok := onBoardDeviceTypeNames[t]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("OnBoardDeviceType(%d)", uint8(t))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding t as its name.
+func (t OnBoardDeviceType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (t *OnBoardDeviceType) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "OnBoardDeviceType", func(v uint8) string { return OnBoardDeviceType(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*t = OnBoardDeviceType(v)
+	return nil
+}
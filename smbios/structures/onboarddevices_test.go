@@ -0,0 +1,146 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseOnBoardDevices(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		obd  *structures.OnBoardDevices
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s:    newBuilder(11, 0x06).structure(),
+		},
+		{
+			name: "no devices",
+			s:    newBuilder(10, 0x04).structure(),
+			obd: &structures.OnBoardDevices{
+				Header:  header(10, 0x04),
+				Devices: []structures.OnBoardDevice{},
+			},
+			ok: true,
+		},
+		{
+			name: "OK",
+			s: newBuilder(10, 0x0a, "Onboard VGA", "Intel I350", "Realtek HDA").
+				byte(0x04, 0x83).
+				byte(0x05, 1).
+				byte(0x06, 0x05).
+				byte(0x07, 2).
+				byte(0x08, 0x87).
+				byte(0x09, 3).
+				structure(),
+			obd: &structures.OnBoardDevices{
+				Header: header(10, 0x0a),
+				Devices: []structures.OnBoardDevice{
+					{
+						Type:        structures.OnBoardDeviceTypeVideo,
+						Enabled:     true,
+						Description: "Onboard VGA",
+					},
+					{
+						Type:        structures.OnBoardDeviceTypeEthernet,
+						Description: "Intel I350",
+					},
+					{
+						Type:        structures.OnBoardDeviceTypeSound,
+						Enabled:     true,
+						Description: "Realtek HDA",
+					},
+				},
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obd, err := structures.ParseOnBoardDevices(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.obd, obd); diff != "" {
+				t.Fatalf("unexpected on board devices (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAllOnBoardDevices(t *testing.T) {
+	ss := []*smbios.Structure{
+		newBuilder(10, 0x06, "Video").
+			byte(0x04, 0x83).
+			byte(0x05, 1).
+			structure(),
+		newBuilder(0, 0x04).structure(),
+		newBuilder(10, 0x06, "Ethernet").
+			byte(0x04, 0x85).
+			byte(0x05, 1).
+			structure(),
+	}
+
+	ds, err := structures.AllOnBoardDevices(ss)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []structures.OnBoardDevice{
+		{
+			Type:        structures.OnBoardDeviceTypeVideo,
+			Enabled:     true,
+			Description: "Video",
+		},
+		{
+			Type:        structures.OnBoardDeviceTypeEthernet,
+			Enabled:     true,
+			Description: "Ethernet",
+		},
+	}
+
+	if diff := cmp.Diff(want, ds); diff != "" {
+		t.Fatalf("unexpected devices (-want +got):\n%s", diff)
+	}
+}
+
+func TestOnBoardDeviceTypeString(t *testing.T) {
+	got := []string{
+		structures.OnBoardDeviceTypeSATAController.String(),
+		structures.OnBoardDeviceType(0x7f).String(),
+	}
+
+	want := []string{
+		"SATA Controller",
+		"OnBoardDeviceType(127)",
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected strings (-want +got):\n%s", diff)
+	}
+}
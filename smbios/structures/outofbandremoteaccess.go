@@ -0,0 +1,56 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeOutOfBandRemoteAccess is the structure type of Out-of-Band Remote
+// Access (type 30).
+const TypeOutOfBandRemoteAccess = 30
+
+// An OutOfBandRemoteAccess is an Out-of-Band Remote Access (type 30)
+// structure, which describes the attributes and policy settings of a hardware
+// facility used to remotely contact a system.
+type OutOfBandRemoteAccess struct {
+	Header smbios.Header
+
+	ManufacturerName string
+
+	// Decoded from the connections field.
+	InboundConnectionEnabled  bool
+	OutboundConnectionEnabled bool
+}
+
+// ParseOutOfBandRemoteAccess parses an OutOfBandRemoteAccess from a
+// Structure.
+func ParseOutOfBandRemoteAccess(s *smbios.Structure) (*OutOfBandRemoteAccess, error) {
+	if err := checkStructure(s, TypeOutOfBandRemoteAccess, "out-of-band remote access", 0x06); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+	connections := f.byte(0x05)
+
+	return &OutOfBandRemoteAccess{
+		Header: s.Header,
+
+		ManufacturerName: f.str(0x04),
+
+		InboundConnectionEnabled:  connections&0x01 != 0,
+		OutboundConnectionEnabled: connections&0x02 != 0,
+	}, nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseOutOfBandRemoteAccess(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		ra   *structures.OutOfBandRemoteAccess
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s:    newBuilder(29, 0x06).structure(),
+		},
+		{
+			name: "too short",
+			s:    newBuilder(30, 0x05).structure(),
+		},
+		{
+			name: "inbound",
+			s:    newBuilder(30, 0x06, "ACME").byte(0x04, 1).byte(0x05, 0x01).structure(),
+			ra: &structures.OutOfBandRemoteAccess{
+				Header:                   header(30, 0x06),
+				ManufacturerName:         "ACME",
+				InboundConnectionEnabled: true,
+			},
+			ok: true,
+		},
+		{
+			name: "outbound",
+			// Reserved bits are ignored.
+			s: newBuilder(30, 0x06).byte(0x05, 0xfe).structure(),
+			ra: &structures.OutOfBandRemoteAccess{
+				Header:                    header(30, 0x06),
+				OutboundConnectionEnabled: true,
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ra, err := structures.ParseOutOfBandRemoteAccess(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.ra, ra); diff != "" {
+				t.Fatalf("unexpected out-of-band remote access (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,288 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"fmt"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypePhysicalMemoryArray is the structure type of a Physical Memory Array
+// (type 16).
+const TypePhysicalMemoryArray = 16
+
+// A PhysicalMemoryArray is a Physical Memory Array (type 16) structure,
+// which describes a collection of memory devices which operate together to
+// form a memory address space.
+type PhysicalMemoryArray struct {
+	Header smbios.Header
+
+	// SMBIOS 2.1+.
+	Location                     MemoryArrayLocation
+	Use                          MemoryArrayUse
+	MemoryErrorCorrection        MemoryErrorCorrection
+	MaximumCapacity              uint32
+	MemoryErrorInformationHandle uint16
+	NumberOfMemoryDevices        uint16
+
+	// SMBIOS 2.7+.
+	ExtendedMaximumCapacity uint64
+}
+
+// ParsePhysicalMemoryArray parses a PhysicalMemoryArray from a Structure.
+func ParsePhysicalMemoryArray(s *smbios.Structure) (*PhysicalMemoryArray, error) {
+	// The SMBIOS 2.1 structure ends after the number of memory devices field.
+	if err := checkStructure(s, TypePhysicalMemoryArray, "physical memory array", 0x0f); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+
+	return &PhysicalMemoryArray{
+		Header: s.Header,
+
+		Location:                     MemoryArrayLocation(f.byte(0x04)),
+		Use:                          MemoryArrayUse(f.byte(0x05)),
+		MemoryErrorCorrection:        MemoryErrorCorrection(f.byte(0x06)),
+		MaximumCapacity:              f.dword(0x07),
+		MemoryErrorInformationHandle: f.word(0x0b),
+		NumberOfMemoryDevices:        f.word(0x0d),
+
+		ExtendedMaximumCapacity: f.qword(0x0f),
+	}, nil
+}
+
+// PhysicalMemoryArrays parses all PhysicalMemoryArrays from a list of
+// Structures, ignoring Structures of other types.
+func PhysicalMemoryArrays(ss []*smbios.Structure) ([]*PhysicalMemoryArray, error) {
+	var pmas []*PhysicalMemoryArray
+	for _, s := range ss {
+		if s.Header.Type != TypePhysicalMemoryArray {
+			continue
+		}
+
+		pma, err := ParsePhysicalMemoryArray(s)
+		if err = processed(pma, err); err != nil {
+			return nil, err
+		}
+
+		pmas = append(pmas, pma)
+	}
+
+	return pmas, nil
+}
+
+// MaximumCapacityBytes returns the maximum memory capacity of the array in
+// bytes, consulting the SMBIOS 2.7+ extended maximum capacity field when
+// required.  If the maximum capacity is unknown, MaximumCapacityBytes returns
+// false.
+func (pma *PhysicalMemoryArray) MaximumCapacityBytes() (uint64, bool) {
+	switch pma.MaximumCapacity {
+	case 0x80000000:
+		// The extended field is specified in bytes.
+		if pma.ExtendedMaximumCapacity == 0 {
+			return 0, false
+		}
+
+		return pma.ExtendedMaximumCapacity, true
+	case 0:
+		return 0, false
+	}
+
+	// The maximum capacity field is specified in kilobytes.
+	return uint64(pma.MaximumCapacity) << 10, true
+}
+
+// IsCXL reports whether the array is located on a CXL add-on card, such as a
+// CXL memory expander.
+func (pma *PhysicalMemoryArray) IsCXL() bool {
+	return pma.Location == MemoryArrayLocationCXL
+}
+
+// A MemoryArrayLocation is the physical location of a PhysicalMemoryArray.
+type MemoryArrayLocation uint8
+
+// Possible MemoryArrayLocation values.
+const (
+	MemoryArrayLocationOther        MemoryArrayLocation = 0x01
+	MemoryArrayLocationUnknown      MemoryArrayLocation = 0x02
+	MemoryArrayLocationSystemBoard  MemoryArrayLocation = 0x03
+	MemoryArrayLocationISA          MemoryArrayLocation = 0x04
+	MemoryArrayLocationEISA         MemoryArrayLocation = 0x05
+	MemoryArrayLocationPCI          MemoryArrayLocation = 0x06
+	MemoryArrayLocationMCA          MemoryArrayLocation = 0x07
+	MemoryArrayLocationPCMCIA       MemoryArrayLocation = 0x08
+	MemoryArrayLocationProprietary  MemoryArrayLocation = 0x09
+	MemoryArrayLocationNuBus        MemoryArrayLocation = 0x0a
+	MemoryArrayLocationPC98C20      MemoryArrayLocation = 0xa0
+	MemoryArrayLocationPC98C24      MemoryArrayLocation = 0xa1
+	MemoryArrayLocationPC98E        MemoryArrayLocation = 0xa2
+	MemoryArrayLocationPC98LocalBus MemoryArrayLocation = 0xa3
+
+	// SMBIOS 3.5+.  A CXL add-on card, such as a CXL memory expander.
+	MemoryArrayLocationCXL MemoryArrayLocation = 0xa4
+)
+
+// memoryArrayLocationNames are the names of each MemoryArrayLocation, as
+// given by dmidecode.
+var memoryArrayLocationNames = map[MemoryArrayLocation]string{
+	MemoryArrayLocationOther:        "Other",
+	MemoryArrayLocationUnknown:      "Unknown",
+	MemoryArrayLocationSystemBoard:  "System Board Or Motherboard",
+	MemoryArrayLocationISA:          "ISA Add-on Card",
+	MemoryArrayLocationEISA:         "EISA Add-on Card",
+	MemoryArrayLocationPCI:          "PCI Add-on Card",
+	MemoryArrayLocationMCA:          "MCA Add-on Card",
+	MemoryArrayLocationPCMCIA:       "PCMCIA Add-on Card",
+	MemoryArrayLocationProprietary:  "Proprietary Add-on Card",
+	MemoryArrayLocationNuBus:        "NuBus",
+	MemoryArrayLocationPC98C20:      "PC-98/C20 Add-on Card",
+	MemoryArrayLocationPC98C24:      "PC-98/C24 Add-on Card",
+	MemoryArrayLocationPC98E:        "PC-98/E Add-on Card",
+	MemoryArrayLocationPC98LocalBus: "PC-98/Local Bus Add-on Card",
+	MemoryArrayLocationCXL:          "CXL Add-on Card",
+}
+
+// String returns the name of a MemoryArrayLocation.
+func (l MemoryArrayLocation) String() string {
+	if s, ok := memoryArrayLocationNames[l]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("MemoryArrayLocation(%d)", uint8(l))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding l as its name.
+func (l MemoryArrayLocation) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (l *MemoryArrayLocation) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "MemoryArrayLocation", func(v uint8) string { return MemoryArrayLocation(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*l = MemoryArrayLocation(v)
+	return nil
+}
+
+// A MemoryArrayUse is the function for which a PhysicalMemoryArray is used.
+type MemoryArrayUse uint8
+
+// Possible MemoryArrayUse values.
+const (
+	MemoryArrayUseOther          MemoryArrayUse = 0x01
+	MemoryArrayUseUnknown        MemoryArrayUse = 0x02
+	MemoryArrayUseSystemMemory   MemoryArrayUse = 0x03
+	MemoryArrayUseVideoMemory    MemoryArrayUse = 0x04
+	MemoryArrayUseFlashMemory    MemoryArrayUse = 0x05
+	MemoryArrayUseNonVolatileRAM MemoryArrayUse = 0x06
+	MemoryArrayUseCacheMemory    MemoryArrayUse = 0x07
+)
+
+// memoryArrayUseNames are the names of each MemoryArrayUse, as given in the
+// SMBIOS specification.
+var memoryArrayUseNames = map[MemoryArrayUse]string{
+	MemoryArrayUseOther:          "Other",
+	MemoryArrayUseUnknown:        "Unknown",
+	MemoryArrayUseSystemMemory:   "System Memory",
+	MemoryArrayUseVideoMemory:    "Video Memory",
+	MemoryArrayUseFlashMemory:    "Flash Memory",
+	MemoryArrayUseNonVolatileRAM: "Non-volatile RAM",
+	MemoryArrayUseCacheMemory:    "Cache Memory",
+}
+
+// String returns the name of a MemoryArrayUse.
+func (u MemoryArrayUse) String() string {
+	if s, ok := memoryArrayUseNames[u]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("MemoryArrayUse(%d)", uint8(u))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding u as its name.
+func (u MemoryArrayUse) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (u *MemoryArrayUse) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "MemoryArrayUse", func(v uint8) string { return MemoryArrayUse(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*u = MemoryArrayUse(v)
+	return nil
+}
+
+// A MemoryErrorCorrection is the primary hardware error correction or
+// detection method supported by a PhysicalMemoryArray.
+type MemoryErrorCorrection uint8
+
+// Possible MemoryErrorCorrection values.
+const (
+	MemoryErrorCorrectionOther        MemoryErrorCorrection = 0x01
+	MemoryErrorCorrectionUnknown      MemoryErrorCorrection = 0x02
+	MemoryErrorCorrectionNone         MemoryErrorCorrection = 0x03
+	MemoryErrorCorrectionParity       MemoryErrorCorrection = 0x04
+	MemoryErrorCorrectionSingleBitECC MemoryErrorCorrection = 0x05
+	MemoryErrorCorrectionMultiBitECC  MemoryErrorCorrection = 0x06
+	MemoryErrorCorrectionCRC          MemoryErrorCorrection = 0x07
+)
+
+// memoryErrorCorrectionNames are the names of each MemoryErrorCorrection, as
+// given in the SMBIOS specification.
+var memoryErrorCorrectionNames = map[MemoryErrorCorrection]string{
+	MemoryErrorCorrectionOther:        "Other",
+	MemoryErrorCorrectionUnknown:      "Unknown",
+	MemoryErrorCorrectionNone:         "None",
+	MemoryErrorCorrectionParity:       "Parity",
+	MemoryErrorCorrectionSingleBitECC: "Single-bit ECC",
+	MemoryErrorCorrectionMultiBitECC:  "Multi-bit ECC",
+	MemoryErrorCorrectionCRC:          "CRC",
+}
+
+// String returns the name of a MemoryErrorCorrection.
+func (c MemoryErrorCorrection) String() string {
+	if s, ok := memoryErrorCorrectionNames[c]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("MemoryErrorCorrection(%d)", uint8(c))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding c as its name.
+func (c MemoryErrorCorrection) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (c *MemoryErrorCorrection) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "MemoryErrorCorrection", func(v uint8) string { return MemoryErrorCorrection(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*c = MemoryErrorCorrection(v)
+	return nil
+}
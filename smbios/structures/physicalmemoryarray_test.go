@@ -0,0 +1,187 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParsePhysicalMemoryArray(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		pma  *structures.PhysicalMemoryArray
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s: &smbios.Structure{
+				Header: smbios.Header{Type: 17},
+			},
+		},
+		{
+			name: "too short",
+			s:    newBuilder(16, 0x0e).structure(),
+		},
+		{
+			name: "OK, 2.1",
+			s: newBuilder(16, 0x0f).
+				byte(0x04, 0x03).
+				byte(0x05, 0x03).
+				byte(0x06, 0x06).
+				dword(0x07, 256<<20).
+				word(0x0b, 0xfffe).
+				word(0x0d, 16).
+				structure(),
+			pma: &structures.PhysicalMemoryArray{
+				Header:                       header(16, 0x0f),
+				Location:                     0x03,
+				Use:                          0x03,
+				MemoryErrorCorrection:        0x06,
+				MaximumCapacity:              256 << 20,
+				MemoryErrorInformationHandle: 0xfffe,
+				NumberOfMemoryDevices:        16,
+			},
+			ok: true,
+		},
+		{
+			name: "OK, 2.7 CXL",
+			s: newBuilder(16, 0x17).
+				byte(0x04, uint8(structures.MemoryArrayLocationCXL)).
+				byte(0x05, 0x03).
+				byte(0x06, 0x03).
+				dword(0x07, 0x80000000).
+				word(0x0b, 0xfffe).
+				word(0x0d, 2).
+				qword(0x0f, 8<<40).
+				structure(),
+			pma: &structures.PhysicalMemoryArray{
+				Header:                       header(16, 0x17),
+				Location:                     structures.MemoryArrayLocationCXL,
+				Use:                          0x03,
+				MemoryErrorCorrection:        0x03,
+				MaximumCapacity:              0x80000000,
+				MemoryErrorInformationHandle: 0xfffe,
+				NumberOfMemoryDevices:        2,
+				ExtendedMaximumCapacity:      8 << 40,
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pma, err := structures.ParsePhysicalMemoryArray(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if !tt.ok {
+				t.Logf("OK error: %v", err)
+				return
+			}
+
+			if diff := cmp.Diff(tt.pma, pma); diff != "" {
+				t.Fatalf("unexpected physical memory array (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPhysicalMemoryArrayMaximumCapacityBytes(t *testing.T) {
+	tests := []struct {
+		name string
+		pma  *structures.PhysicalMemoryArray
+		size uint64
+		ok   bool
+	}{
+		{
+			name: "unknown",
+			pma:  &structures.PhysicalMemoryArray{},
+		},
+		{
+			name: "extended unknown",
+			pma:  &structures.PhysicalMemoryArray{MaximumCapacity: 0x80000000},
+		},
+		{
+			name: "kilobytes",
+			pma:  &structures.PhysicalMemoryArray{MaximumCapacity: 256 << 20},
+			size: 256 << 30,
+			ok:   true,
+		},
+		{
+			name: "extended",
+			pma: &structures.PhysicalMemoryArray{
+				MaximumCapacity:         0x80000000,
+				ExtendedMaximumCapacity: 8 << 40,
+			},
+			size: 8 << 40,
+			ok:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			size, ok := tt.pma.MaximumCapacityBytes()
+
+			if diff := cmp.Diff(tt.ok, ok); diff != "" {
+				t.Fatalf("unexpected capacity presence (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.size, size); diff != "" {
+				t.Fatalf("unexpected capacity (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMemoryDeviceIsCXL(t *testing.T) {
+	pmas := []*structures.PhysicalMemoryArray{
+		{
+			Header:   smbios.Header{Handle: 0x0010},
+			Location: 0x03,
+		},
+		{
+			Header:   smbios.Header{Handle: 0x0020},
+			Location: structures.MemoryArrayLocationCXL,
+		},
+	}
+
+	tests := []struct {
+		name   string
+		handle uint16
+		cxl    bool
+	}{
+		{name: "system board", handle: 0x0010},
+		{name: "CXL", handle: 0x0020, cxl: true},
+		{name: "unknown array", handle: 0x0030},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := &structures.MemoryDevice{PhysicalMemoryArrayHandle: tt.handle}
+			if diff := cmp.Diff(tt.cxl, md.IsCXL(pmas)); diff != "" {
+				t.Fatalf("unexpected CXL status (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
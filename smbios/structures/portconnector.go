@@ -0,0 +1,297 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"fmt"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypePortConnector is the structure type of Port Connector Information
+// (type 8).
+const TypePortConnector = 8
+
+// A PortConnector is a Port Connector Information (type 8) structure, which
+// describes a system port and its internal and external connectors.
+type PortConnector struct {
+	Header smbios.Header
+
+	InternalReferenceDesignator string
+	InternalConnectorType       ConnectorType
+	ExternalReferenceDesignator string
+	ExternalConnectorType       ConnectorType
+	PortType                    PortType
+}
+
+// ParsePortConnector parses a PortConnector from a Structure.
+func ParsePortConnector(s *smbios.Structure) (*PortConnector, error) {
+	if err := checkStructure(s, TypePortConnector, "port connector", 0x09); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+
+	return &PortConnector{
+		Header: s.Header,
+
+		InternalReferenceDesignator: f.str(0x04),
+		InternalConnectorType:       ConnectorType(f.byte(0x05)),
+		ExternalReferenceDesignator: f.str(0x06),
+		ExternalConnectorType:       ConnectorType(f.byte(0x07)),
+		PortType:                    PortType(f.byte(0x08)),
+	}, nil
+}
+
+// A ConnectorType is the type of an internal or external connector of a
+// PortConnector.
+type ConnectorType uint8
+
+// Possible ConnectorType values.
+const (
+	ConnectorTypeNone                       ConnectorType = 0x00
+	ConnectorTypeCentronics                 ConnectorType = 0x01
+	ConnectorTypeMiniCentronics             ConnectorType = 0x02
+	ConnectorTypeProprietary                ConnectorType = 0x03
+	ConnectorTypeDB25Male                   ConnectorType = 0x04
+	ConnectorTypeDB25Female                 ConnectorType = 0x05
+	ConnectorTypeDB15Male                   ConnectorType = 0x06
+	ConnectorTypeDB15Female                 ConnectorType = 0x07
+	ConnectorTypeDB9Male                    ConnectorType = 0x08
+	ConnectorTypeDB9Female                  ConnectorType = 0x09
+	ConnectorTypeRJ11                       ConnectorType = 0x0a
+	ConnectorTypeRJ45                       ConnectorType = 0x0b
+	ConnectorTypeMiniSCSI50Pin              ConnectorType = 0x0c
+	ConnectorTypeMiniDIN                    ConnectorType = 0x0d
+	ConnectorTypeMicroDIN                   ConnectorType = 0x0e
+	ConnectorTypePS2                        ConnectorType = 0x0f
+	ConnectorTypeInfrared                   ConnectorType = 0x10
+	ConnectorTypeHPHIL                      ConnectorType = 0x11
+	ConnectorTypeAccessBusUSB               ConnectorType = 0x12
+	ConnectorTypeSSASCSI                    ConnectorType = 0x13
+	ConnectorTypeCircularDIN8Male           ConnectorType = 0x14
+	ConnectorTypeCircularDIN8Female         ConnectorType = 0x15
+	ConnectorTypeOnBoardIDE                 ConnectorType = 0x16
+	ConnectorTypeOnBoardFloppy              ConnectorType = 0x17
+	ConnectorTypeDualInline9Pin             ConnectorType = 0x18
+	ConnectorTypeDualInline25Pin            ConnectorType = 0x19
+	ConnectorTypeDualInline50Pin            ConnectorType = 0x1a
+	ConnectorTypeDualInline68Pin            ConnectorType = 0x1b
+	ConnectorTypeOnBoardSoundInputFromCDROM ConnectorType = 0x1c
+	ConnectorTypeMiniCentronicsType14       ConnectorType = 0x1d
+	ConnectorTypeMiniCentronicsType26       ConnectorType = 0x1e
+	ConnectorTypeMiniJack                   ConnectorType = 0x1f
+	ConnectorTypeBNC                        ConnectorType = 0x20
+	ConnectorTypeIEEE1394                   ConnectorType = 0x21
+	ConnectorTypeSASSATAPlugReceptacle      ConnectorType = 0x22
+	ConnectorTypeUSBTypeCReceptacle         ConnectorType = 0x23
+	ConnectorTypePC98                       ConnectorType = 0xa0
+	ConnectorTypePC98Hireso                 ConnectorType = 0xa1
+	ConnectorTypePCH98                      ConnectorType = 0xa2
+	ConnectorTypePC98Note                   ConnectorType = 0xa3
+	ConnectorTypePC98Full                   ConnectorType = 0xa4
+	ConnectorTypeOther                      ConnectorType = 0xff
+)
+
+// connectorTypeNames are the names of each ConnectorType, as given in the
+// SMBIOS specification.
+var connectorTypeNames = map[ConnectorType]string{
+	ConnectorTypeNone:                       "None",
+	ConnectorTypeCentronics:                 "Centronics",
+	ConnectorTypeMiniCentronics:             "Mini Centronics",
+	ConnectorTypeProprietary:                "Proprietary",
+	ConnectorTypeDB25Male:                   "DB-25 male",
+	ConnectorTypeDB25Female:                 "DB-25 female",
+	ConnectorTypeDB15Male:                   "DB-15 male",
+	ConnectorTypeDB15Female:                 "DB-15 female",
+	ConnectorTypeDB9Male:                    "DB-9 male",
+	ConnectorTypeDB9Female:                  "DB-9 female",
+	ConnectorTypeRJ11:                       "RJ-11",
+	ConnectorTypeRJ45:                       "RJ-45",
+	ConnectorTypeMiniSCSI50Pin:              "50 Pin MiniSCSI",
+	ConnectorTypeMiniDIN:                    "Mini DIN",
+	ConnectorTypeMicroDIN:                   "Micro DIN",
+	ConnectorTypePS2:                        "PS/2",
+	ConnectorTypeInfrared:                   "Infrared",
+	ConnectorTypeHPHIL:                      "HP-HIL",
+	ConnectorTypeAccessBusUSB:               "Access Bus (USB)",
+	ConnectorTypeSSASCSI:                    "SSA SCSI",
+	ConnectorTypeCircularDIN8Male:           "Circular DIN-8 male",
+	ConnectorTypeCircularDIN8Female:         "Circular DIN-8 female",
+	ConnectorTypeOnBoardIDE:                 "On Board IDE",
+	ConnectorTypeOnBoardFloppy:              "On Board Floppy",
+	ConnectorTypeDualInline9Pin:             "9 Pin Dual Inline (pin 10 cut)",
+	ConnectorTypeDualInline25Pin:            "25 Pin Dual Inline (pin 26 cut)",
+	ConnectorTypeDualInline50Pin:            "50 Pin Dual Inline",
+	ConnectorTypeDualInline68Pin:            "68 Pin Dual Inline",
+	ConnectorTypeOnBoardSoundInputFromCDROM: "On Board Sound Input From CD-ROM",
+	ConnectorTypeMiniCentronicsType14:       "Mini Centronics Type-14",
+	ConnectorTypeMiniCentronicsType26:       "Mini Centronics Type-26",
+	ConnectorTypeMiniJack:                   "Mini Jack (headphones)",
+	ConnectorTypeBNC:                        "BNC",
+	ConnectorTypeIEEE1394:                   "IEEE 1394",
+	ConnectorTypeSASSATAPlugReceptacle:      "SAS/SATA Plug Receptacle",
+	ConnectorTypeUSBTypeCReceptacle:         "USB Type-C Receptacle",
+	ConnectorTypePC98:                       "PC-98",
+	ConnectorTypePC98Hireso:                 "PC-98 Hireso",
+	ConnectorTypePCH98:                      "PC-H98",
+	ConnectorTypePC98Note:                   "PC-98 Note",
+	ConnectorTypePC98Full:                   "PC-98 Full",
+	ConnectorTypeOther:                      "Other",
+}
+
+// String returns the name of a ConnectorType as given in the SMBIOS
+// specification.
+func (t ConnectorType) String() string {
+	if s, ok := connectorTypeNames[t]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("ConnectorType(%d)", uint8(t))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding t as its name.
+func (t ConnectorType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (t *ConnectorType) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "ConnectorType", func(v uint8) string { return ConnectorType(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*t = ConnectorType(v)
+	return nil
+}
+
+// A PortType is the function of the port described by a PortConnector.
+type PortType uint8
+
+// Possible PortType values.
+const (
+	PortTypeNone               PortType = 0x00
+	PortTypeParallelXTAT       PortType = 0x01
+	PortTypeParallelPS2        PortType = 0x02
+	PortTypeParallelECP        PortType = 0x03
+	PortTypeParallelEPP        PortType = 0x04
+	PortTypeParallelECPEPP     PortType = 0x05
+	PortTypeSerialXTAT         PortType = 0x06
+	PortTypeSerial16450        PortType = 0x07
+	PortTypeSerial16550        PortType = 0x08
+	PortTypeSerial16550A       PortType = 0x09
+	PortTypeSCSI               PortType = 0x0a
+	PortTypeMIDI               PortType = 0x0b
+	PortTypeJoystick           PortType = 0x0c
+	PortTypeKeyboard           PortType = 0x0d
+	PortTypeMouse              PortType = 0x0e
+	PortTypeSSASCSI            PortType = 0x0f
+	PortTypeUSB                PortType = 0x10
+	PortTypeFirewire           PortType = 0x11
+	PortTypePCMCIATypeI        PortType = 0x12
+	PortTypePCMCIATypeII       PortType = 0x13
+	PortTypePCMCIATypeIII      PortType = 0x14
+	PortTypeCardbus            PortType = 0x15
+	PortTypeAccessBus          PortType = 0x16
+	PortTypeSCSIII             PortType = 0x17
+	PortTypeSCSIWide           PortType = 0x18
+	PortTypePC98               PortType = 0x19
+	PortTypePC98Hireso         PortType = 0x1a
+	PortTypePCH98              PortType = 0x1b
+	PortTypeVideo              PortType = 0x1c
+	PortTypeAudio              PortType = 0x1d
+	PortTypeModem              PortType = 0x1e
+	PortTypeNetwork            PortType = 0x1f
+	PortTypeSATA               PortType = 0x20
+	PortTypeSAS                PortType = 0x21
+	PortTypeMFDP               PortType = 0x22
+	PortTypeThunderbolt        PortType = 0x23
+	PortType8251Compatible     PortType = 0xa0
+	PortType8251FIFOCompatible PortType = 0xa1
+	PortTypeOther              PortType = 0xff
+)
+
+// portTypeNames are the names of each PortType, as given in the SMBIOS
+// specification.
+var portTypeNames = map[PortType]string{
+	PortTypeNone:               "None",
+	PortTypeParallelXTAT:       "Parallel Port XT/AT Compatible",
+	PortTypeParallelPS2:        "Parallel Port PS/2",
+	PortTypeParallelECP:        "Parallel Port ECP",
+	PortTypeParallelEPP:        "Parallel Port EPP",
+	PortTypeParallelECPEPP:     "Parallel Port ECP/EPP",
+	PortTypeSerialXTAT:         "Serial Port XT/AT Compatible",
+	PortTypeSerial16450:        "Serial Port 16450 Compatible",
+	PortTypeSerial16550:        "Serial Port 16550 Compatible",
+	PortTypeSerial16550A:       "Serial Port 16550A Compatible",
+	PortTypeSCSI:               "SCSI Port",
+	PortTypeMIDI:               "MIDI Port",
+	PortTypeJoystick:           "Joystick Port",
+	PortTypeKeyboard:           "Keyboard Port",
+	PortTypeMouse:              "Mouse Port",
+	PortTypeSSASCSI:            "SSA SCSI",
+	PortTypeUSB:                "USB",
+	PortTypeFirewire:           "Firewire (IEEE P1394)",
+	PortTypePCMCIATypeI:        "PCMCIA Type I",
+	PortTypePCMCIATypeII:       "PCMCIA Type II",
+	PortTypePCMCIATypeIII:      "PCMCIA Type III",
+	PortTypeCardbus:            "Cardbus",
+	PortTypeAccessBus:          "Access Bus Port",
+	PortTypeSCSIII:             "SCSI II",
+	PortTypeSCSIWide:           "SCSI Wide",
+	PortTypePC98:               "PC-98",
+	PortTypePC98Hireso:         "PC-98 Hireso",
+	PortTypePCH98:              "PC-H98",
+	PortTypeVideo:              "Video Port",
+	PortTypeAudio:              "Audio Port",
+	PortTypeModem:              "Modem Port",
+	PortTypeNetwork:            "Network Port",
+	PortTypeSATA:               "SATA",
+	PortTypeSAS:                "SAS",
+	PortTypeMFDP:               "MFDP (Multi-Function Display Port)",
+	PortTypeThunderbolt:        "Thunderbolt",
+	PortType8251Compatible:     "8251 Compatible",
+	PortType8251FIFOCompatible: "8251 FIFO Compatible",
+	PortTypeOther:              "Other",
+}
+
+// String returns the name of a PortType as given in the SMBIOS
+// specification.
+func (t PortType) String() string {
+	if s, ok := portTypeNames[t]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("PortType(%d)", uint8(t))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding t as its name.
+func (t PortType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (t *PortType) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "PortType", func(v uint8) string { return PortType(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*t = PortType(v)
+	return nil
+}
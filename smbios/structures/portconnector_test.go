@@ -0,0 +1,76 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParsePortConnector(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		pc   *structures.PortConnector
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s:    newBuilder(9, 0x09).structure(),
+		},
+		{
+			name: "too short",
+			s:    newBuilder(8, 0x08).structure(),
+		},
+		{
+			name: "OK",
+			s: newBuilder(8, 0x09, "J1A1", "USB").
+				byte(0x04, 1).
+				byte(0x05, 0x00).
+				byte(0x06, 2).
+				byte(0x07, 0x12).
+				byte(0x08, 0x10).
+				structure(),
+			pc: &structures.PortConnector{
+				Header:                      header(8, 0x09),
+				InternalReferenceDesignator: "J1A1",
+				ExternalReferenceDesignator: "USB",
+				ExternalConnectorType:       structures.ConnectorTypeAccessBusUSB,
+				PortType:                    structures.PortTypeUSB,
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pc, err := structures.ParsePortConnector(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.pc, pc); diff != "" {
+				t.Fatalf("unexpected port connector (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeSystemPowerSupply is the structure type of a System Power Supply (type
+// 39).
+const TypeSystemPowerSupply = 39
+
+// A SystemPowerSupply is a System Power Supply (type 39) structure, which
+// describes a power supply unit.
+type SystemPowerSupply struct {
+	Header smbios.Header
+
+	PowerUnitGroup             uint8
+	Location                   string
+	DeviceName                 string
+	Manufacturer               string
+	SerialNumber               string
+	AssetTag                   string
+	ModelPartNumber            string
+	RevisionLevel              string
+	MaxPowerCapacity           uint16
+	PowerSupplyCharacteristics uint16
+	InputVoltageProbeHandle    uint16
+	CoolingDeviceHandle        uint16
+	InputCurrentProbeHandle    uint16
+}
+
+// ParseSystemPowerSupply parses a SystemPowerSupply from a Structure.
+func ParseSystemPowerSupply(s *smbios.Structure) (*SystemPowerSupply, error) {
+	// Probe and cooling device handles are present only when the structure
+	// is long enough to contain them.
+	if err := checkStructure(s, TypeSystemPowerSupply, "system power supply", 0x10); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+
+	return &SystemPowerSupply{
+		Header: s.Header,
+
+		PowerUnitGroup:             f.byte(0x04),
+		Location:                   f.str(0x05),
+		DeviceName:                 f.str(0x06),
+		Manufacturer:               f.str(0x07),
+		SerialNumber:               f.str(0x08),
+		AssetTag:                   f.str(0x09),
+		ModelPartNumber:            f.str(0x0a),
+		RevisionLevel:              f.str(0x0b),
+		MaxPowerCapacity:           f.word(0x0c),
+		PowerSupplyCharacteristics: f.word(0x0e),
+		InputVoltageProbeHandle:    f.word(0x10),
+		CoolingDeviceHandle:        f.word(0x12),
+		InputCurrentProbeHandle:    f.word(0x14),
+	}, nil
+}
+
+// SystemPowerSupplies parses all SystemPowerSupply structures in ss.
+func SystemPowerSupplies(ss []*smbios.Structure) ([]*SystemPowerSupply, error) {
+	var out []*SystemPowerSupply
+	for _, s := range ss {
+		if s.Header.Type != TypeSystemPowerSupply {
+			continue
+		}
+
+		psu, err := ParseSystemPowerSupply(s)
+		if err = processed(psu, err); err != nil {
+			return nil, err
+		}
+
+		out = append(out, psu)
+	}
+
+	return out, nil
+}
+
+// MaxPowerWatts returns the maximum power capacity of the power supply in
+// watts.  If the capacity is unknown, MaxPowerWatts returns false.
+func (psu *SystemPowerSupply) MaxPowerWatts() (int, bool) {
+	if psu.MaxPowerCapacity == 0x8000 {
+		return 0, false
+	}
+
+	return int(psu.MaxPowerCapacity), true
+}
+
+// Present reports whether the power supply is installed.
+func (psu *SystemPowerSupply) Present() bool {
+	return psu.PowerSupplyCharacteristics&0x0004 != 0
+}
@@ -0,0 +1,113 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseSystemPowerSupply(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		psu  *structures.SystemPowerSupply
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s:    newBuilder(38, 0x16).structure(),
+		},
+		{
+			name: "too short",
+			s:    newBuilder(39, 0x0f).structure(),
+		},
+		{
+			name: "OK",
+			s: newBuilder(39, 0x16, "PSU 1", "PWS-1K", "Supermicro", "P1234", "ASSET", "PWS-1K62A-1R", "1.0").
+				byte(0x04, 1).
+				byte(0x05, 1).
+				byte(0x06, 2).
+				byte(0x07, 3).
+				byte(0x08, 4).
+				byte(0x09, 5).
+				byte(0x0a, 6).
+				byte(0x0b, 7).
+				word(0x0c, 1600).
+				word(0x0e, 0x11a6).
+				word(0x10, 0xffff).
+				word(0x12, 0x0030).
+				word(0x14, 0xffff).
+				structure(),
+			psu: &structures.SystemPowerSupply{
+				Header:                     header(39, 0x16),
+				PowerUnitGroup:             1,
+				Location:                   "PSU 1",
+				DeviceName:                 "PWS-1K",
+				Manufacturer:               "Supermicro",
+				SerialNumber:               "P1234",
+				AssetTag:                   "ASSET",
+				ModelPartNumber:            "PWS-1K62A-1R",
+				RevisionLevel:              "1.0",
+				MaxPowerCapacity:           1600,
+				PowerSupplyCharacteristics: 0x11a6,
+				InputVoltageProbeHandle:    0xffff,
+				CoolingDeviceHandle:        0x0030,
+				InputCurrentProbeHandle:    0xffff,
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			psu, err := structures.ParseSystemPowerSupply(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.psu, psu); diff != "" {
+				t.Fatalf("unexpected system power supply (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSystemPowerSupplyMaxPowerWatts(t *testing.T) {
+	if _, ok := (&structures.SystemPowerSupply{MaxPowerCapacity: 0x8000}).MaxPowerWatts(); ok {
+		t.Fatal("expected unknown capacity")
+	}
+
+	psu := &structures.SystemPowerSupply{
+		MaxPowerCapacity:           800,
+		PowerSupplyCharacteristics: 0x0004,
+	}
+
+	w, ok := psu.MaxPowerWatts()
+	if !ok || w != 800 {
+		t.Fatalf("unexpected capacity: %d, %v", w, ok)
+	}
+
+	if !psu.Present() {
+		t.Fatal("expected power supply to be present")
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"fmt"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// A Probe holds the fields shared by the Voltage Probe (type 26), Temperature
+// Probe (type 28), and Electrical Current Probe (type 29) structures, which
+// differ only in the units of their readings.
+//
+// The reading fields are raw values which are 0x8000 if unknown.  Use the
+// accessor methods of the embedding structure to interpret them.
+type Probe struct {
+	Header smbios.Header
+
+	Description  string
+	Location     ProbeLocation
+	Status       ProbeStatus
+	MaximumValue uint16
+	MinimumValue uint16
+	Resolution   uint16
+	Tolerance    uint16
+	Accuracy     uint16
+	OEMDefined   uint32
+
+	// Present only if the structure is long enough.
+	NominalValue uint16
+}
+
+// parseProbe parses the fields of a probe structure of type typ, described by
+// name in errors, from a Structure.
+func parseProbe(s *smbios.Structure, typ uint8, name string) (Probe, error) {
+	// The structure ends after the OEM-defined field, and may be followed by
+	// the nominal value field.
+	if err := checkStructure(s, typ, name, 0x14); err != nil {
+		return Probe{}, err
+	}
+
+	f := fields{s: s}
+	ls := f.byte(0x05)
+
+	nominal := uint16(probeUnknown)
+	if f.has(0x14, 2) {
+		nominal = f.word(0x14)
+	}
+
+	return Probe{
+		Header: s.Header,
+
+		Description:  f.str(0x04),
+		Location:     ProbeLocation(ls & 0x1f),
+		Status:       ProbeStatus(ls >> 5),
+		MaximumValue: f.word(0x06),
+		MinimumValue: f.word(0x08),
+		Resolution:   f.word(0x0a),
+		Tolerance:    f.word(0x0c),
+		Accuracy:     f.word(0x0e),
+		OEMDefined:   f.dword(0x10),
+		NominalValue: nominal,
+	}, nil
+}
+
+// AccuracyPercent returns the accuracy of the probe's reading in plus or minus
+// percent.  If the value is unknown, AccuracyPercent returns false.
+func (p *Probe) AccuracyPercent() (float64, bool) {
+	// The accuracy field is specified in hundredths of a percent.
+	v, ok := probeUnsigned(p.Accuracy)
+	return float64(v) / 100, ok
+}
+
+// probeUnknown is the value of a probe reading field which is unknown.
+const probeUnknown = 0x8000
+
+// probeSigned interprets a signed probe reading field.
+func probeSigned(v uint16) (int, bool) {
+	if v == probeUnknown {
+		return 0, false
+	}
+
+	return int(int16(v)), true
+}
+
+// probeUnsigned interprets an unsigned probe reading field.
+func probeUnsigned(v uint16) (int, bool) {
+	if v == probeUnknown {
+		return 0, false
+	}
+
+	return int(v), true
+}
+
+// A ProbeLocation is the location of a voltage, temperature, or electrical
+// current probe, decoded from bits 4:0 of its location and status field.
+type ProbeLocation uint8
+
+// Possible ProbeLocation values.  Locations after ProbeLocationAddInCard are
+// only defined for temperature probes.
+const (
+	ProbeLocationOther                  ProbeLocation = 0x01
+	ProbeLocationUnknown                ProbeLocation = 0x02
+	ProbeLocationProcessor              ProbeLocation = 0x03
+	ProbeLocationDisk                   ProbeLocation = 0x04
+	ProbeLocationPeripheralBay          ProbeLocation = 0x05
+	ProbeLocationSystemManagementModule ProbeLocation = 0x06
+	ProbeLocationMotherboard            ProbeLocation = 0x07
+	ProbeLocationMemoryModule           ProbeLocation = 0x08
+	ProbeLocationProcessorModule        ProbeLocation = 0x09
+	ProbeLocationPowerUnit              ProbeLocation = 0x0a
+	ProbeLocationAddInCard              ProbeLocation = 0x0b
+	ProbeLocationFrontPanelBoard        ProbeLocation = 0x0c
+	ProbeLocationBackPanelBoard         ProbeLocation = 0x0d
+	ProbeLocationPowerSystemBoard       ProbeLocation = 0x0e
+	ProbeLocationDriveBackPlane         ProbeLocation = 0x0f
+)
+
+// probeLocationNames are the names of each ProbeLocation, as given by
+// dmidecode.
+var probeLocationNames = map[ProbeLocation]string{
+	ProbeLocationOther:                  "Other",
+	ProbeLocationUnknown:                "Unknown",
+	ProbeLocationProcessor:              "Processor",
+	ProbeLocationDisk:                   "Disk",
+	ProbeLocationPeripheralBay:          "Peripheral Bay",
+	ProbeLocationSystemManagementModule: "System Management Module",
+	ProbeLocationMotherboard:            "Motherboard",
+	ProbeLocationMemoryModule:           "Memory Module",
+	ProbeLocationProcessorModule:        "Processor Module",
+	ProbeLocationPowerUnit:              "Power Unit",
+	ProbeLocationAddInCard:              "Add-in Card",
+	ProbeLocationFrontPanelBoard:        "Front Panel Board",
+	ProbeLocationBackPanelBoard:         "Back Panel Board",
+	ProbeLocationPowerSystemBoard:       "Power System Board",
+	ProbeLocationDriveBackPlane:         "Drive Back Plane",
+}
+
+// String returns the name of a ProbeLocation as given by dmidecode.
+func (l ProbeLocation) String() string {
+	if s, ok := probeLocationNames[l]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("ProbeLocation(%d)", uint8(l))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding l as its name.
+func (l ProbeLocation) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (l *ProbeLocation) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "ProbeLocation", func(v uint8) string { return ProbeLocation(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*l = ProbeLocation(v)
+	return nil
+}
+
+// A ProbeStatus is the status of a probe or cooling device, decoded from bits
+// 7:5 of its location and status field.
+type ProbeStatus uint8
+
+// Possible ProbeStatus values.
+const (
+	ProbeStatusOther          ProbeStatus = 0x01
+	ProbeStatusUnknown        ProbeStatus = 0x02
+	ProbeStatusOK             ProbeStatus = 0x03
+	ProbeStatusNonCritical    ProbeStatus = 0x04
+	ProbeStatusCritical       ProbeStatus = 0x05
+	ProbeStatusNonRecoverable ProbeStatus = 0x06
+)
+
+// probeStatusNames are the names of each ProbeStatus, as given by dmidecode.
+var probeStatusNames = map[ProbeStatus]string{
+	ProbeStatusOther:          "Other",
+	ProbeStatusUnknown:        "Unknown",
+	ProbeStatusOK:             "OK",
+	ProbeStatusNonCritical:    "Non-critical",
+	ProbeStatusCritical:       "Critical",
+	ProbeStatusNonRecoverable: "Non-recoverable",
+}
+
+// String returns the name of a ProbeStatus as given by dmidecode.
+func (st ProbeStatus) String() string {
+	if s, ok := probeStatusNames[st]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("ProbeStatus(%d)", uint8(st))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding st as its name.
+func (st ProbeStatus) MarshalText() ([]byte, error) {
+	return []byte(st.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (st *ProbeStatus) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "ProbeStatus", func(v uint8) string { return ProbeStatus(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*st = ProbeStatus(v)
+	return nil
+}
@@ -0,0 +1,158 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseProbe(t *testing.T) {
+	// Each probe type shares the same layout, so run the same tests against
+	// each of their parsers.
+	probes := []struct {
+		name  string
+		typ   uint8
+		parse func(s *smbios.Structure) (*structures.Probe, error)
+	}{
+		{
+			name: "voltage",
+			typ:  26,
+			parse: func(s *smbios.Structure) (*structures.Probe, error) {
+				vp, err := structures.ParseVoltageProbe(s)
+				if err != nil {
+					return nil, err
+				}
+
+				return &vp.Probe, nil
+			},
+		},
+		{
+			name: "temperature",
+			typ:  28,
+			parse: func(s *smbios.Structure) (*structures.Probe, error) {
+				tp, err := structures.ParseTemperatureProbe(s)
+				if err != nil {
+					return nil, err
+				}
+
+				return &tp.Probe, nil
+			},
+		},
+		{
+			name: "electrical current",
+			typ:  29,
+			parse: func(s *smbios.Structure) (*structures.Probe, error) {
+				cp, err := structures.ParseElectricalCurrentProbe(s)
+				if err != nil {
+					return nil, err
+				}
+
+				return &cp.Probe, nil
+			},
+		},
+	}
+
+	for _, pt := range probes {
+		tests := []struct {
+			name string
+			s    *smbios.Structure
+			p    *structures.Probe
+			ok   bool
+		}{
+			{
+				name: "wrong type",
+				s:    newBuilder(pt.typ+1, 0x14).structure(),
+			},
+			{
+				name: "too short",
+				s:    newBuilder(pt.typ, 0x13).structure(),
+			},
+			{
+				name: "no nominal value",
+				s: newBuilder(pt.typ, 0x14, "CPU Vcore").
+					byte(0x04, 1).
+					// OK, processor.
+					byte(0x05, 0x03<<5|0x03).
+					word(0x06, 1500).
+					word(0x08, 500).
+					word(0x0a, 0x8000).
+					word(0x0c, 0x8000).
+					word(0x0e, 150).
+					dword(0x10, 0xdeadbeef).
+					structure(),
+				p: &structures.Probe{
+					Header:       header(pt.typ, 0x14),
+					Description:  "CPU Vcore",
+					Location:     structures.ProbeLocationProcessor,
+					Status:       structures.ProbeStatusOK,
+					MaximumValue: 1500,
+					MinimumValue: 500,
+					Resolution:   0x8000,
+					Tolerance:    0x8000,
+					Accuracy:     150,
+					OEMDefined:   0xdeadbeef,
+					NominalValue: 0x8000,
+				},
+				ok: true,
+			},
+			{
+				name: "nominal value",
+				s: newBuilder(pt.typ, 0x16).
+					// Critical, power unit.
+					byte(0x05, 0x05<<5|0x0a).
+					word(0x14, 12000).
+					structure(),
+				p: &structures.Probe{
+					Header:       header(pt.typ, 0x16),
+					Location:     structures.ProbeLocationPowerUnit,
+					Status:       structures.ProbeStatusCritical,
+					NominalValue: 12000,
+				},
+				ok: true,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(pt.name+" "+tt.name, func(t *testing.T) {
+				p, err := pt.parse(tt.s)
+
+				if tt.ok && err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if !tt.ok && err == nil {
+					t.Fatalf("expected an error, but none occurred: %v", err)
+				}
+
+				if diff := cmp.Diff(tt.p, p); diff != "" {
+					t.Fatalf("unexpected probe (-want +got):\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestProbeAccuracyPercent(t *testing.T) {
+	if v, ok := (&structures.Probe{Accuracy: 150}).AccuracyPercent(); !ok || v != 1.5 {
+		t.Fatalf("unexpected accuracy: %v, %v", v, ok)
+	}
+
+	if _, ok := (&structures.Probe{Accuracy: 0x8000}).AccuracyPercent(); ok {
+		t.Fatal("expected unknown accuracy")
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeProcessor is the structure type of Processor Information (type 4).
+const TypeProcessor = 4
+
+// A Processor is a Processor Information (type 4) structure, which describes
+// a processor socket and the processor installed in it.
+type Processor struct {
+	Header smbios.Header
+
+	// SMBIOS 2.0+.
+	SocketDesignation     string
+	ProcessorType         uint8
+	ProcessorFamily       uint8
+	ProcessorManufacturer string
+	ProcessorID           uint64
+	ProcessorVersion      string
+	Voltage               uint8
+	ExternalClock         uint16
+	MaxSpeed              uint16
+	CurrentSpeed          uint16
+	Status                uint8
+	ProcessorUpgrade      uint8
+
+	// SMBIOS 2.1+.
+	L1CacheHandle uint16
+	L2CacheHandle uint16
+	L3CacheHandle uint16
+
+	// SMBIOS 2.3+.
+	SerialNumber string
+	AssetTag     string
+	PartNumber   string
+
+	// SMBIOS 2.5+.
+	CoreCount                uint8
+	CoreEnabled              uint8
+	ThreadCount              uint8
+	ProcessorCharacteristics uint16
+
+	// SMBIOS 2.6+.
+	ProcessorFamily2 uint16
+
+	// SMBIOS 3.0+.
+	CoreCount2   uint16
+	CoreEnabled2 uint16
+	ThreadCount2 uint16
+
+	// SMBIOS 3.6+.
+	ThreadEnabled uint16
+}
+
+// ParseProcessor parses a Processor from a Structure.
+func ParseProcessor(s *smbios.Structure) (*Processor, error) {
+	// The SMBIOS 2.0 structure ends after the processor upgrade field.
+	if err := checkStructure(s, TypeProcessor, "processor", 0x1a); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+
+	return &Processor{
+		Header: s.Header,
+
+		SocketDesignation:     f.str(0x04),
+		ProcessorType:         f.byte(0x05),
+		ProcessorFamily:       f.byte(0x06),
+		ProcessorManufacturer: f.str(0x07),
+		ProcessorID:           f.qword(0x08),
+		ProcessorVersion:      f.str(0x10),
+		Voltage:               f.byte(0x11),
+		ExternalClock:         f.word(0x12),
+		MaxSpeed:              f.word(0x14),
+		CurrentSpeed:          f.word(0x16),
+		Status:                f.byte(0x18),
+		ProcessorUpgrade:      f.byte(0x19),
+
+		L1CacheHandle: f.word(0x1a),
+		L2CacheHandle: f.word(0x1c),
+		L3CacheHandle: f.word(0x1e),
+
+		SerialNumber: f.str(0x20),
+		AssetTag:     f.str(0x21),
+		PartNumber:   f.str(0x22),
+
+		CoreCount:                f.byte(0x23),
+		CoreEnabled:              f.byte(0x24),
+		ThreadCount:              f.byte(0x25),
+		ProcessorCharacteristics: f.word(0x26),
+
+		ProcessorFamily2: f.word(0x28),
+
+		CoreCount2:   f.word(0x2a),
+		CoreEnabled2: f.word(0x2c),
+		ThreadCount2: f.word(0x2e),
+
+		ThreadEnabled: f.word(0x30),
+	}, nil
+}
+
+// Family returns the processor family, consulting the SMBIOS 2.6+ processor
+// family 2 field when indicated by the processor family field.
+func (p *Processor) Family() uint16 {
+	if p.ProcessorFamily == 0xfe {
+		return p.ProcessorFamily2
+	}
+
+	return uint16(p.ProcessorFamily)
+}
+
+// Cores returns the number of cores in the processor, consulting the SMBIOS
+// 3.0+ core count 2 field for processors with more than 255 cores.
+func (p *Processor) Cores() int {
+	if p.CoreCount == 0xff {
+		return int(p.CoreCount2)
+	}
+
+	return int(p.CoreCount)
+}
+
+// Threads returns the number of threads in the processor, consulting the
+// SMBIOS 3.0+ thread count 2 field for processors with more than 255 threads.
+func (p *Processor) Threads() int {
+	if p.ThreadCount == 0xff {
+		return int(p.ThreadCount2)
+	}
+
+	return int(p.ThreadCount)
+}
+
+// EffectiveMaxSpeed returns the maximum speed of the processor supported by
+// the system in megahertz.  If the speed is unknown, EffectiveMaxSpeed returns
+// false.
+//
+// A speed of 0 indicates an unknown speed, and the value 0xffff is reserved
+// by the specification for a future extended speed field, so neither is
+// reported as a speed.  The speed is returned as a uint32 so that callers
+// need not change if such a field is added.
+func (p *Processor) EffectiveMaxSpeed() (uint32, bool) {
+	return processorSpeed(p.MaxSpeed)
+}
+
+// EffectiveCurrentSpeed returns the speed of the processor at system boot in
+// megahertz.  If the speed is unknown, EffectiveCurrentSpeed returns false.
+// See EffectiveMaxSpeed for details.
+func (p *Processor) EffectiveCurrentSpeed() (uint32, bool) {
+	return processorSpeed(p.CurrentSpeed)
+}
+
+// EffectiveExternalClock returns the frequency of the external clock of the
+// processor in megahertz.  If the frequency is unknown,
+// EffectiveExternalClock returns false.  See EffectiveMaxSpeed for details.
+func (p *Processor) EffectiveExternalClock() (uint32, bool) {
+	return processorSpeed(p.ExternalClock)
+}
+
+// processorSpeed interprets a processor speed field.
+func processorSpeed(s uint16) (uint32, bool) {
+	switch s {
+	case 0, 0xffff:
+		return 0, false
+	default:
+		return uint32(s), true
+	}
+}
+
+// Populated reports whether the processor socket is populated.
+func (p *Processor) Populated() bool {
+	// Bit 6 of the status field indicates a populated socket.
+	return p.Status&0x40 != 0
+}
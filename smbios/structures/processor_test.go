@@ -0,0 +1,233 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseProcessor(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		p    *structures.Processor
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s:    newBuilder(3, 0x1a).structure(),
+		},
+		{
+			name: "too short",
+			s:    newBuilder(4, 0x19).structure(),
+		},
+		{
+			name: "OK, 2.0",
+			s: newBuilder(4, 0x1a, "CPU 1", "Intel", "Xeon").
+				byte(0x04, 1).
+				byte(0x05, 0x03).
+				byte(0x06, 0xb3).
+				byte(0x07, 2).
+				qword(0x08, 0xbfebfbff00050654).
+				byte(0x10, 3).
+				byte(0x11, 0x80|0x12).
+				word(0x12, 100).
+				word(0x14, 4000).
+				word(0x16, 2600).
+				byte(0x18, 0x41).
+				byte(0x19, 0x3f).
+				structure(),
+			p: &structures.Processor{
+				Header:                header(4, 0x1a),
+				SocketDesignation:     "CPU 1",
+				ProcessorType:         0x03,
+				ProcessorFamily:       0xb3,
+				ProcessorManufacturer: "Intel",
+				ProcessorID:           0xbfebfbff00050654,
+				ProcessorVersion:      "Xeon",
+				Voltage:               0x92,
+				ExternalClock:         100,
+				MaxSpeed:              4000,
+				CurrentSpeed:          2600,
+				Status:                0x41,
+				ProcessorUpgrade:      0x3f,
+			},
+			ok: true,
+		},
+		{
+			name: "OK, 3.6",
+			s: newBuilder(4, 0x32, "CPU 1").
+				byte(0x04, 1).
+				byte(0x06, 0xfe).
+				word(0x1a, 0x0010).
+				word(0x1c, 0x0011).
+				word(0x1e, 0x0012).
+				byte(0x23, 0xff).
+				byte(0x24, 0xff).
+				byte(0x25, 0xff).
+				word(0x26, 0x00fc).
+				word(0x28, 0x0101).
+				word(0x2a, 256).
+				word(0x2c, 256).
+				word(0x2e, 512).
+				word(0x30, 512).
+				structure(),
+			p: &structures.Processor{
+				Header:                   header(4, 0x32),
+				SocketDesignation:        "CPU 1",
+				ProcessorFamily:          0xfe,
+				L1CacheHandle:            0x0010,
+				L2CacheHandle:            0x0011,
+				L3CacheHandle:            0x0012,
+				CoreCount:                0xff,
+				CoreEnabled:              0xff,
+				ThreadCount:              0xff,
+				ProcessorCharacteristics: 0x00fc,
+				ProcessorFamily2:         0x0101,
+				CoreCount2:               256,
+				CoreEnabled2:             256,
+				ThreadCount2:             512,
+				ThreadEnabled:            512,
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := structures.ParseProcessor(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.p, p); diff != "" {
+				t.Fatalf("unexpected processor (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProcessorHelpers(t *testing.T) {
+	tests := []struct {
+		name           string
+		p              *structures.Processor
+		family         uint16
+		cores, threads int
+		populated      bool
+	}{
+		{
+			name: "empty socket",
+			p: &structures.Processor{
+				ProcessorFamily: 0x02,
+			},
+			family: 0x02,
+		},
+		{
+			name: "populated",
+			p: &structures.Processor{
+				ProcessorFamily: 0xb3,
+				CoreCount:       16,
+				ThreadCount:     32,
+				Status:          0x41,
+			},
+			family:    0xb3,
+			cores:     16,
+			threads:   32,
+			populated: true,
+		},
+		{
+			name: "extended fields",
+			p: &structures.Processor{
+				ProcessorFamily:  0xfe,
+				ProcessorFamily2: 0x0101,
+				CoreCount:        0xff,
+				CoreCount2:       256,
+				ThreadCount:      0xff,
+				ThreadCount2:     512,
+				Status:           0x41,
+			},
+			family:    0x0101,
+			cores:     256,
+			threads:   512,
+			populated: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []interface{}{tt.p.Family(), tt.p.Cores(), tt.p.Threads(), tt.p.Populated()}
+			want := []interface{}{tt.family, tt.cores, tt.threads, tt.populated}
+
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Fatalf("unexpected processor values (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProcessorSpeeds(t *testing.T) {
+	tests := []struct {
+		name  string
+		speed uint16
+		mhz   uint32
+		ok    bool
+	}{
+		{
+			name: "unknown",
+		},
+		{
+			name:  "reserved",
+			speed: 0xffff,
+		},
+		{
+			name:  "OK",
+			speed: 3700,
+			mhz:   3700,
+			ok:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &structures.Processor{
+				ExternalClock: tt.speed,
+				MaxSpeed:      tt.speed,
+				CurrentSpeed:  tt.speed,
+			}
+
+			for _, fn := range []func() (uint32, bool){
+				p.EffectiveExternalClock,
+				p.EffectiveMaxSpeed,
+				p.EffectiveCurrentSpeed,
+			} {
+				mhz, ok := fn()
+				if diff := cmp.Diff(tt.ok, ok); diff != "" {
+					t.Fatalf("unexpected speed presence (-want +got):\n%s", diff)
+				}
+				if diff := cmp.Diff(tt.mhz, mhz); diff != "" {
+					t.Fatalf("unexpected speed (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// headerLen is the length of an SMBIOS structure header.  Offsets in the
+// SMBIOS specification include the header, but a Structure's formatted area
+// does not.
+const headerLen = 4
+
+// checkStructure verifies that s is of type typ and that its formatted area
+// is long enough to contain the fields up to and including the specification
+// offset minLen.
+func checkStructure(s *smbios.Structure, typ uint8, name string, minLen int) error {
+	if s.Header.Type != typ {
+		return fmt.Errorf("expected SMBIOS %s structure type %d, but got: %d", name, typ, s.Header.Type)
+	}
+
+	if l := len(s.Formatted) + headerLen; l < minLen {
+		return fmt.Errorf("expected SMBIOS %s structure minimum length of at least %d, but got: %d", name, minLen, l)
+	}
+
+	return nil
+}
+
+// processed applies the post-processors registered for v's type, unless err
+// indicates that v could not be parsed.
+func processed(v smbios.TypedStructure, err error) error {
+	if err != nil {
+		return err
+	}
+
+	return smbios.PostProcess(v)
+}
+
+// fields provides access to a Structure's formatted area and strings using
+// the offsets given in the SMBIOS specification.
+type fields struct {
+	s *smbios.Structure
+}
+
+// has reports whether n bytes are present at offset off.
+func (f fields) has(off, n int) bool {
+	return off >= headerLen && off-headerLen+n <= len(f.s.Formatted)
+}
+
+// byte returns the byte at offset off, or 0 if it is not present.
+func (f fields) byte(off int) uint8 {
+	if !f.has(off, 1) {
+		return 0
+	}
+
+	return f.s.Formatted[off-headerLen]
+}
+
+// word returns the little endian uint16 at offset off, or 0 if it is not
+// present.
+func (f fields) word(off int) uint16 {
+	if !f.has(off, 2) {
+		return 0
+	}
+
+	return binary.LittleEndian.Uint16(f.s.Formatted[off-headerLen:])
+}
+
+// dword returns the little endian uint32 at offset off, or 0 if it is not
+// present.
+func (f fields) dword(off int) uint32 {
+	if !f.has(off, 4) {
+		return 0
+	}
+
+	return binary.LittleEndian.Uint32(f.s.Formatted[off-headerLen:])
+}
+
+// qword returns the little endian uint64 at offset off, or 0 if it is not
+// present.
+func (f fields) qword(off int) uint64 {
+	if !f.has(off, 8) {
+		return 0
+	}
+
+	return binary.LittleEndian.Uint64(f.s.Formatted[off-headerLen:])
+}
+
+// str returns the string referenced by the string number at offset off.
+// Strings are numbered from 1, and 0 indicates that no string is present.
+func (f fields) str(off int) string {
+	i := int(f.byte(off))
+	if i == 0 || i > len(f.s.Strings) {
+		return ""
+	}
+
+	return f.s.Strings[i-1]
+}
+
+// hasStr reports whether the string number at offset off references a
+// string.  A string number of 0 indicates that no string is present.
+func (f fields) hasStr(off int) bool {
+	i := int(f.byte(off))
+	return i != 0 && i <= len(f.s.Strings)
+}
+
+// errShortList returns an error indicating that a structure is too short to
+// contain a list of n items.
+func errShortList(name, list string, n int) error {
+	return fmt.Errorf("SMBIOS %s structure is too short to contain %d %s", name, n, list)
+}
+
+// parseName returns the value whose name, as returned by name, is the text b.
+// It implements encoding.TextUnmarshaler for enumerations of type typ, whose
+// String methods name every value.
+func parseName(b []byte, typ string, name func(v uint8) string) (uint8, error) {
+	s := string(b)
+	for v := 0; v <= 0xff; v++ {
+		if name(uint8(v)) == s {
+			return uint8(v), nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown %s: %q", typ, s)
+}
+
+// bitNames returns the names of each bit set in v, indexed by bit number.
+// Bits with empty names are reserved and ignored.
+func bitNames(v uint64, names []string) []string {
+	var out []string
+	for i, name := range names {
+		if name != "" && v&(1<<uint(i)) != 0 {
+			out = append(out, name)
+		}
+	}
+
+	return out
+}
+
+// joinNames joins names using sep, or returns "None" if names is empty.
+func joinNames(names []string, sep string) string {
+	if len(names) == 0 {
+		return "None"
+	}
+
+	return strings.Join(names, sep)
+}
+
+// marshalNames encodes names as a JSON array, which is empty rather than null
+// if names is nil.
+func marshalNames(names []string) ([]byte, error) {
+	if names == nil {
+		names = []string{}
+	}
+
+	return json.Marshal(names)
+}
@@ -0,0 +1,113 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios/structures"
+)
+
+func TestEnumerationTextRoundTrip(t *testing.T) {
+	enums := []interface{}{
+		structures.BaseboardType(0),
+		structures.BootStatus(0),
+		structures.CacheAssociativity(0),
+		structures.CacheErrorCorrectionType(0),
+		structures.CacheLocation(0),
+		structures.CacheOperationalMode(0),
+		structures.ChassisSecurityStatus(0),
+		structures.ChassisType(0),
+		structures.ChassisState(0),
+		structures.ConnectorType(0),
+		structures.CoolingDeviceType(0),
+		structures.ErrorDetectingMethod(0),
+		structures.HardwareSecurityStatus(0),
+		structures.ManagementDeviceAddressType(0),
+		structures.ManagementDeviceType(0),
+		structures.MemoryArrayLocation(0),
+		structures.MemoryArrayUse(0),
+		structures.MemoryDeviceType(0),
+		structures.MemoryErrorCorrection(0),
+		structures.MemoryErrorGranularity(0),
+		structures.MemoryErrorOperation(0),
+		structures.MemoryErrorType(0),
+		structures.MemoryFormFactor(0),
+		structures.MemoryInterleave(0),
+		structures.MemoryTechnology(0),
+		structures.OnBoardDeviceType(0),
+		structures.PortType(0),
+		structures.ProbeLocation(0),
+		structures.ProbeStatus(0),
+		structures.ResetBootOption(0),
+		structures.SlotHeight(0),
+		structures.SlotType(0),
+		structures.SlotUsage(0),
+		structures.SystemCacheType(0),
+		structures.WakeUpType(0),
+	}
+
+	for _, e := range enums {
+		typ := reflect.TypeOf(e)
+		t.Run(typ.Name(), func(t *testing.T) {
+			// Every value, named or not, must survive a round trip.
+			for v := 0; v <= 0xff; v++ {
+				in := reflect.New(typ).Elem()
+				in.SetUint(uint64(v))
+
+				b, err := in.Interface().(encoding.TextMarshaler).MarshalText()
+				if err != nil {
+					t.Fatalf("failed to marshal %d: %v", v, err)
+				}
+
+				out := reflect.New(typ)
+				if err := out.Interface().(encoding.TextUnmarshaler).UnmarshalText(b); err != nil {
+					t.Fatalf("failed to unmarshal %q: %v", b, err)
+				}
+
+				if got := out.Elem().Uint(); got != uint64(v) {
+					t.Fatalf("unexpected value for %q: want %d, got %d", b, v, got)
+				}
+			}
+
+			if err := reflect.New(typ).Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte("bogus")); err == nil {
+				t.Fatal("expected an error, but none occurred")
+			}
+		})
+	}
+}
+
+func TestEnumerationJSON(t *testing.T) {
+	b, err := json.Marshal(structures.ProbeStatusNonCritical)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+
+	if want := `"Non-critical"`; want != string(b) {
+		t.Fatalf("unexpected JSON: want %s, got %s", want, b)
+	}
+
+	var st structures.ProbeStatus
+	if err := json.Unmarshal(b, &st); err != nil {
+		t.Fatalf("failed to unmarshal: %v", err)
+	}
+
+	if st != structures.ProbeStatusNonCritical {
+		t.Fatalf("unexpected probe status: %v", st)
+	}
+}
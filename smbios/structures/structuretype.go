@@ -0,0 +1,127 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+// StructureType implements smbios.TypedStructure.
+func (*BIOSInformation) StructureType() uint8 { return TypeBIOSInformation }
+
+// StructureType implements smbios.TypedStructure.
+func (*SystemInformation) StructureType() uint8 { return TypeSystemInformation }
+
+// StructureType implements smbios.TypedStructure.
+func (*Baseboard) StructureType() uint8 { return TypeBaseboard }
+
+// StructureType implements smbios.TypedStructure.
+func (*Chassis) StructureType() uint8 { return TypeChassis }
+
+// StructureType implements smbios.TypedStructure.
+func (*Processor) StructureType() uint8 { return TypeProcessor }
+
+// StructureType implements smbios.TypedStructure.
+func (*MemoryController) StructureType() uint8 { return TypeMemoryController }
+
+// StructureType implements smbios.TypedStructure.
+func (*MemoryModule) StructureType() uint8 { return TypeMemoryModule }
+
+// StructureType implements smbios.TypedStructure.
+func (*CacheInformation) StructureType() uint8 { return TypeCacheInformation }
+
+// StructureType implements smbios.TypedStructure.
+func (*PortConnector) StructureType() uint8 { return TypePortConnector }
+
+// StructureType implements smbios.TypedStructure.
+func (*SystemSlot) StructureType() uint8 { return TypeSystemSlot }
+
+// StructureType implements smbios.TypedStructure.
+func (*OnBoardDevices) StructureType() uint8 { return TypeOnBoardDevices }
+
+// StructureType implements smbios.TypedStructure.
+func (*OEMStrings) StructureType() uint8 { return TypeOEMStrings }
+
+// StructureType implements smbios.TypedStructure.
+func (*SystemConfigurationOptions) StructureType() uint8 {
+	return TypeSystemConfigurationOptions
+}
+
+// StructureType implements smbios.TypedStructure.
+func (*BIOSLanguageInformation) StructureType() uint8 {
+	return TypeBIOSLanguageInformation
+}
+
+// StructureType implements smbios.TypedStructure.
+func (*GroupAssociations) StructureType() uint8 { return TypeGroupAssociations }
+
+// StructureType implements smbios.TypedStructure.
+func (*PhysicalMemoryArray) StructureType() uint8 { return TypePhysicalMemoryArray }
+
+// StructureType implements smbios.TypedStructure.
+func (*MemoryDevice) StructureType() uint8 { return TypeMemoryDevice }
+
+// StructureType implements smbios.TypedStructure.
+func (*MemoryErrorInformation32) StructureType() uint8 { return TypeMemoryErrorInformation32 }
+
+// StructureType implements smbios.TypedStructure.
+func (*MemoryArrayMappedAddress) StructureType() uint8 { return TypeMemoryArrayMappedAddress }
+
+// StructureType implements smbios.TypedStructure.
+func (*MemoryDeviceMappedAddress) StructureType() uint8 { return TypeMemoryDeviceMappedAddress }
+
+// StructureType implements smbios.TypedStructure.
+func (*SystemReset) StructureType() uint8 { return TypeSystemReset }
+
+// StructureType implements smbios.TypedStructure.
+func (*HardwareSecurity) StructureType() uint8 { return TypeHardwareSecurity }
+
+// StructureType implements smbios.TypedStructure.
+func (*SystemPowerControls) StructureType() uint8 { return TypeSystemPowerControls }
+
+// StructureType implements smbios.TypedStructure.
+func (*VoltageProbe) StructureType() uint8 { return TypeVoltageProbe }
+
+// StructureType implements smbios.TypedStructure.
+func (*CoolingDevice) StructureType() uint8 { return TypeCoolingDevice }
+
+// StructureType implements smbios.TypedStructure.
+func (*TemperatureProbe) StructureType() uint8 { return TypeTemperatureProbe }
+
+// StructureType implements smbios.TypedStructure.
+func (*ElectricalCurrentProbe) StructureType() uint8 { return TypeElectricalCurrentProbe }
+
+// StructureType implements smbios.TypedStructure.
+func (*OutOfBandRemoteAccess) StructureType() uint8 { return TypeOutOfBandRemoteAccess }
+
+// StructureType implements smbios.TypedStructure.
+func (*BootIntegrityServices) StructureType() uint8 { return TypeBootIntegrityServices }
+
+// StructureType implements smbios.TypedStructure.
+func (*SystemBootInformation) StructureType() uint8 { return TypeSystemBootInformation }
+
+// StructureType implements smbios.TypedStructure.
+func (*MemoryErrorInformation64) StructureType() uint8 { return TypeMemoryErrorInformation64 }
+
+// StructureType implements smbios.TypedStructure.
+func (*ManagementDevice) StructureType() uint8 { return TypeManagementDevice }
+
+// StructureType implements smbios.TypedStructure.
+func (*AdditionalInformation) StructureType() uint8 { return TypeAdditionalInformation }
+
+// StructureType implements smbios.TypedStructure.
+func (*SystemPowerSupply) StructureType() uint8 { return TypeSystemPowerSupply }
+
+// StructureType implements smbios.TypedStructure.
+func (*TPMDevice) StructureType() uint8 { return TypeTPMDevice }
+
+// StructureType implements smbios.TypedStructure.
+func (*FirmwareInventory) StructureType() uint8 { return TypeFirmwareInventory }
@@ -0,0 +1,190 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeSystemInformation is the structure type of System Information (type 1).
+const TypeSystemInformation = 1
+
+// A SystemInformation is a System Information (type 1) structure, which
+// identifies the system as a whole.
+type SystemInformation struct {
+	Header smbios.Header
+
+	// SMBIOS 2.0+.
+	Manufacturer string
+	ProductName  string
+	Version      string
+	SerialNumber string
+
+	// SMBIOS 2.1+.
+	UUID       UUID
+	WakeUpType WakeUpType
+
+	// SMBIOS 2.4+.
+	SKUNumber string
+	Family    string
+}
+
+// ParseSystemInformation parses a SystemInformation from a Structure.
+func ParseSystemInformation(s *smbios.Structure) (*SystemInformation, error) {
+	// The SMBIOS 2.0 structure ends after the serial number field.
+	if err := checkStructure(s, TypeSystemInformation, "system information", 0x08); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+
+	si := &SystemInformation{
+		Header: s.Header,
+
+		Manufacturer: f.str(0x04),
+		ProductName:  f.str(0x05),
+		Version:      f.str(0x06),
+		SerialNumber: f.str(0x07),
+
+		WakeUpType: WakeUpType(f.byte(0x18)),
+
+		SKUNumber: f.str(0x19),
+		Family:    f.str(0x1a),
+	}
+
+	if f.has(0x08, len(si.UUID)) {
+		copy(si.UUID[:], s.Formatted[0x08-headerLen:])
+	}
+
+	return si, nil
+}
+
+// A WakeUpType is the event which caused a system to power up.
+type WakeUpType uint8
+
+// Possible WakeUpType values.
+const (
+	WakeUpTypeReserved        WakeUpType = 0x00
+	WakeUpTypeOther           WakeUpType = 0x01
+	WakeUpTypeUnknown         WakeUpType = 0x02
+	WakeUpTypeAPMTimer        WakeUpType = 0x03
+	WakeUpTypeModemRing       WakeUpType = 0x04
+	WakeUpTypeLANRemote       WakeUpType = 0x05
+	WakeUpTypePowerSwitch     WakeUpType = 0x06
+	WakeUpTypePCIPME          WakeUpType = 0x07
+	WakeUpTypeACPowerRestored WakeUpType = 0x08
+)
+
+// wakeUpTypeNames are the names of each WakeUpType, as given by dmidecode.
+var wakeUpTypeNames = map[WakeUpType]string{
+	WakeUpTypeReserved:        "Reserved",
+	WakeUpTypeOther:           "Other",
+	WakeUpTypeUnknown:         "Unknown",
+	WakeUpTypeAPMTimer:        "APM Timer",
+	WakeUpTypeModemRing:       "Modem Ring",
+	WakeUpTypeLANRemote:       "LAN Remote",
+	WakeUpTypePowerSwitch:     "Power Switch",
+	WakeUpTypePCIPME:          "PCI PME#",
+	WakeUpTypeACPowerRestored: "AC Power Restored",
+}
+
+// String returns the name of a WakeUpType as given by dmidecode.
+func (t WakeUpType) String() string {
+	if s, ok := wakeUpTypeNames[t]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("WakeUpType(%d)", uint8(t))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding t as its name.
+func (t WakeUpType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (t *WakeUpType) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "WakeUpType", func(v uint8) string { return WakeUpType(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*t = WakeUpType(v)
+	return nil
+}
+
+// A UUID is a universally unique identifier as encoded in an SMBIOS
+// structure.
+type UUID [16]byte
+
+// String returns the textual form of a UUID.  As of SMBIOS 2.6, the first
+// three fields of a UUID are encoded in little endian byte order, and String
+// assumes this encoding.
+func (u UUID) String() string {
+	return fmt.Sprintf("%08x-%04x-%04x-%x-%x",
+		binary.LittleEndian.Uint32(u[0:4]),
+		binary.LittleEndian.Uint16(u[4:6]),
+		binary.LittleEndian.Uint16(u[6:8]),
+		u[8:10],
+		u[10:16],
+	)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (u UUID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding the textual form
+// produced by MarshalText.
+func (u *UUID) UnmarshalText(b []byte) error {
+	if len(b) != 36 || b[8] != '-' || b[13] != '-' || b[18] != '-' || b[23] != '-' {
+		return fmt.Errorf("invalid UUID: %q", b)
+	}
+
+	var v UUID
+	src := make([]byte, 0, 32)
+	src = append(src, b[0:8]...)
+	src = append(src, b[9:13]...)
+	src = append(src, b[14:18]...)
+	src = append(src, b[19:23]...)
+	src = append(src, b[24:36]...)
+	if _, err := hex.Decode(v[:], src); err != nil {
+		return fmt.Errorf("invalid UUID: %q: %v", b, err)
+	}
+
+	// Restore the little endian byte order of the first three fields.
+	binary.LittleEndian.PutUint32(v[0:4], binary.BigEndian.Uint32(v[0:4]))
+	binary.LittleEndian.PutUint16(v[4:6], binary.BigEndian.Uint16(v[4:6]))
+	binary.LittleEndian.PutUint16(v[6:8], binary.BigEndian.Uint16(v[6:8]))
+
+	*u = v
+	return nil
+}
+
+// Present reports whether u holds a UUID.  The SMBIOS specification reserves
+// all bits set to indicate that a UUID is not present but can be set, and all
+// bits clear to indicate that a UUID is not present.
+func (u UUID) Present() bool {
+	return u != UUID{} && u != UUID{
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	}
+}
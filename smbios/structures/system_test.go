@@ -0,0 +1,191 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseSystemInformation(t *testing.T) {
+	uuid := structures.UUID{
+		0x33, 0x22, 0x11, 0x00, 0x55, 0x44, 0x77, 0x66,
+		0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff,
+	}
+
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		si   *structures.SystemInformation
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s:    newBuilder(0, 0x08).structure(),
+		},
+		{
+			name: "too short",
+			s:    newBuilder(1, 0x06).structure(),
+		},
+		{
+			name: "OK, 2.0",
+			s: newBuilder(1, 0x08, "DigitalOcean", "Droplet").
+				byte(0x04, 1).
+				byte(0x05, 2).
+				structure(),
+			si: &structures.SystemInformation{
+				Header:       header(1, 0x08),
+				Manufacturer: "DigitalOcean",
+				ProductName:  "Droplet",
+			},
+			ok: true,
+		},
+		{
+			name: "OK, 2.4",
+			s: newBuilder(1, 0x1b, "DigitalOcean", "Droplet", "20171212", "1234", "SKU", "DigitalOcean_Droplet").
+				byte(0x04, 1).
+				byte(0x05, 2).
+				byte(0x06, 3).
+				byte(0x07, 4).
+				bytes(0x08, uuid[:]).
+				byte(0x18, 6).
+				byte(0x19, 5).
+				byte(0x1a, 6).
+				structure(),
+			si: &structures.SystemInformation{
+				Header:       header(1, 0x1b),
+				Manufacturer: "DigitalOcean",
+				ProductName:  "Droplet",
+				Version:      "20171212",
+				SerialNumber: "1234",
+				UUID:         uuid,
+				WakeUpType:   structures.WakeUpTypePowerSwitch,
+				SKUNumber:    "SKU",
+				Family:       "DigitalOcean_Droplet",
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			si, err := structures.ParseSystemInformation(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.si, si); diff != "" {
+				t.Fatalf("unexpected system information (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUUID(t *testing.T) {
+	tests := []struct {
+		name    string
+		u       structures.UUID
+		s       string
+		present bool
+	}{
+		{
+			name: "zero",
+			s:    "00000000-0000-0000-0000-000000000000",
+		},
+		{
+			name: "settable",
+			u: structures.UUID{
+				0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+				0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+			},
+			s: "ffffffff-ffff-ffff-ffff-ffffffffffff",
+		},
+		{
+			name: "OK",
+			u: structures.UUID{
+				0x33, 0x22, 0x11, 0x00, 0x55, 0x44, 0x77, 0x66,
+				0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff,
+			},
+			s:       "00112233-4455-6677-8899-aabbccddeeff",
+			present: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.s, tt.u.String()); diff != "" {
+				t.Fatalf("unexpected UUID string (-want +got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tt.present, tt.u.Present()); diff != "" {
+				t.Fatalf("unexpected UUID presence (-want +got):\n%s", diff)
+			}
+
+			b, err := json.Marshal(tt.u)
+			if err != nil {
+				t.Fatalf("failed to marshal UUID: %v", err)
+			}
+
+			var u structures.UUID
+			if err := json.Unmarshal(b, &u); err != nil {
+				t.Fatalf("failed to unmarshal UUID: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.u, u); diff != "" {
+				t.Fatalf("unexpected round-tripped UUID (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUUIDUnmarshalTextInvalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"00112233445566778899aabbccddeeff",
+		"00112233-4455-6677-8899-aabbccddeefg",
+		"00112233-4455-6677-8899_aabbccddeeff",
+	} {
+		var u structures.UUID
+		if err := u.UnmarshalText([]byte(s)); err == nil {
+			t.Fatalf("expected an error for %q, but none occurred", s)
+		}
+	}
+}
+
+func TestWakeUpType(t *testing.T) {
+	if diff := cmp.Diff("PCI PME#", structures.WakeUpTypePCIPME.String()); diff != "" {
+		t.Fatalf("unexpected wake-up type (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff("WakeUpType(255)", structures.WakeUpType(0xff).String()); diff != "" {
+		t.Fatalf("unexpected wake-up type (-want +got):\n%s", diff)
+	}
+
+	b, err := json.Marshal(structures.SystemInformation{WakeUpType: structures.WakeUpTypeACPowerRestored})
+	if err != nil {
+		t.Fatalf("failed to marshal system information: %v", err)
+	}
+	if !strings.Contains(string(b), `"WakeUpType":"AC Power Restored"`) {
+		t.Fatalf("unexpected system information JSON: %s", b)
+	}
+}
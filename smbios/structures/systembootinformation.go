@@ -0,0 +1,146 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"fmt"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeSystemBootInformation is the structure type of System Boot Information
+// (type 32).
+const TypeSystemBootInformation = 32
+
+// A SystemBootInformation is a System Boot Information (type 32) structure,
+// which reports the status of the most recent system boot.
+type SystemBootInformation struct {
+	Header smbios.Header
+
+	Status BootStatus
+
+	// AdditionalData is the vendor- or product-specific data which follows
+	// the status code, if any.
+	AdditionalData []byte
+}
+
+// ParseSystemBootInformation parses a SystemBootInformation from a
+// Structure.
+func ParseSystemBootInformation(s *smbios.Structure) (*SystemBootInformation, error) {
+	if err := checkStructure(s, TypeSystemBootInformation, "system boot information", 0x0b); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+
+	sbi := &SystemBootInformation{
+		Header: s.Header,
+
+		Status: BootStatus(f.byte(0x0a)),
+	}
+
+	if extra := s.Formatted[0x0b-headerLen:]; len(extra) > 0 {
+		sbi.AdditionalData = append([]byte(nil), extra...)
+	}
+
+	return sbi, nil
+}
+
+// A BootStatus is the status code of a system boot.
+type BootStatus uint8
+
+// Possible BootStatus values.  Values from 128 to 191 are vendor- or
+// OEM-specific, and values from 192 to 255 are product-specific.
+const (
+	BootStatusNoErrors                BootStatus = 0x00
+	BootStatusNoBootableMedia         BootStatus = 0x01
+	BootStatusOSFailedToLoad          BootStatus = 0x02
+	BootStatusFirmwareHardwareFailure BootStatus = 0x03
+	BootStatusOSHardwareFailure       BootStatus = 0x04
+	BootStatusUserRequested           BootStatus = 0x05
+	BootStatusSecurityViolation       BootStatus = 0x06
+	BootStatusPreviouslyRequested     BootStatus = 0x07
+	BootStatusWatchdogExpired         BootStatus = 0x08
+)
+
+// bootStatusNames are the names of each BootStatus, as given by dmidecode.
+var bootStatusNames = map[BootStatus]string{
+	BootStatusNoErrors:                "No errors detected",
+	BootStatusNoBootableMedia:         "No bootable media",
+	BootStatusOSFailedToLoad:          "Operating system failed to load",
+	BootStatusFirmwareHardwareFailure: "Firmware-detected hardware failure",
+	BootStatusOSHardwareFailure:       "Operating system-detected hardware failure",
+	BootStatusUserRequested:           "User-requested boot",
+	BootStatusSecurityViolation:       "System security violation",
+	BootStatusPreviouslyRequested:     "Previously-requested image",
+	BootStatusWatchdogExpired:         "System watchdog timer expired",
+}
+
+// String returns the name of a BootStatus as given by dmidecode.
+func (st BootStatus) String() string {
+	if s, ok := bootStatusNames[st]; ok {
+		return s
+	}
+
+	switch {
+	case st.OEMSpecific():
+		return fmt.Sprintf("OEM-specific (%d)", uint8(st))
+	case st.ProductSpecific():
+		return fmt.Sprintf("Product-specific (%d)", uint8(st))
+	default:
+		return fmt.Sprintf("BootStatus(%d)", uint8(st))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding st as its name.
+func (st BootStatus) MarshalText() ([]byte, error) {
+	return []byte(st.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (st *BootStatus) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "BootStatus", func(v uint8) string { return BootStatus(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*st = BootStatus(v)
+	return nil
+}
+
+// OEMSpecific reports whether st is a vendor- or OEM-specific status code.
+func (st BootStatus) OEMSpecific() bool {
+	return st >= 0x80 && st < 0xc0
+}
+
+// ProductSpecific reports whether st is a product-specific status code.
+func (st BootStatus) ProductSpecific() bool {
+	return st >= 0xc0
+}
+
+// Failed reports whether st indicates that the system did not boot
+// normally.  User-requested and previously-requested boots, and vendor- or
+// product-specific status codes, are not considered failures.
+func (st BootStatus) Failed() bool {
+	switch st {
+	case BootStatusNoBootableMedia, BootStatusOSFailedToLoad,
+		BootStatusFirmwareHardwareFailure, BootStatusOSHardwareFailure,
+		BootStatusSecurityViolation, BootStatusWatchdogExpired:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseSystemBootInformation(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		sbi  *structures.SystemBootInformation
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s:    newBuilder(31, 0x0b).structure(),
+		},
+		{
+			name: "too short",
+			s:    newBuilder(32, 0x0a).structure(),
+		},
+		{
+			name: "no bootable media",
+			s:    newBuilder(32, 0x0b).byte(0x0a, 0x01).structure(),
+			sbi: &structures.SystemBootInformation{
+				Header: header(32, 0x0b),
+				Status: structures.BootStatusNoBootableMedia,
+			},
+			ok: true,
+		},
+		{
+			name: "additional data",
+			s:    newBuilder(32, 0x0d).byte(0x0a, 0x80).bytes(0x0b, []byte{0xde, 0xad}).structure(),
+			sbi: &structures.SystemBootInformation{
+				Header:         header(32, 0x0d),
+				Status:         0x80,
+				AdditionalData: []byte{0xde, 0xad},
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sbi, err := structures.ParseSystemBootInformation(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.sbi, sbi); diff != "" {
+				t.Fatalf("unexpected system boot information (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBootStatus(t *testing.T) {
+	type status struct {
+		Name   string
+		Failed bool
+	}
+
+	var got []status
+	for _, st := range []structures.BootStatus{
+		structures.BootStatusNoErrors,
+		structures.BootStatusWatchdogExpired,
+		structures.BootStatusUserRequested,
+		0x10,
+		0x80,
+		0xc0,
+	} {
+		got = append(got, status{Name: st.String(), Failed: st.Failed()})
+	}
+
+	want := []status{
+		{Name: "No errors detected"},
+		{Name: "System watchdog timer expired", Failed: true},
+		{Name: "User-requested boot"},
+		{Name: "BootStatus(16)"},
+		{Name: "OEM-specific (128)"},
+		{Name: "Product-specific (192)"},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected boot statuses (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,347 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// SystemInfo aggregates the Structures which identify a system: the first
+// BIOS Information (type 0), System Information (type 1), Baseboard (type 2),
+// and Chassis (type 3) structures in a table.
+//
+// Each field is nil if its Structure is not present.  The accessor methods
+// are safe to call on a nil *SystemInfo and with nil fields, and each has a
+// Has method which distinguishes a value which is absent, because its
+// Structure is missing, too short, or references no string, from a value
+// which firmware reported as an empty or blank string.
+type SystemInfo struct {
+	BIOS      *BIOSInformation
+	System    *SystemInformation
+	Baseboard *Baseboard
+	Chassis   *Chassis
+
+	// The raw Structures, used to determine which strings are present.
+	bios, system, baseboard, chassis *smbios.Structure
+}
+
+// NewSystemInfo parses a SystemInfo from the first Structure of each type in
+// ss.
+func NewSystemInfo(ss []*smbios.Structure) (*SystemInfo, error) {
+	si := &SystemInfo{}
+
+	for _, s := range ss {
+		var err error
+		switch {
+		case s.Header.Type == TypeBIOSInformation && si.bios == nil:
+			si.bios = s
+			si.BIOS, err = ParseBIOSInformation(s)
+		case s.Header.Type == TypeSystemInformation && si.system == nil:
+			si.system = s
+			si.System, err = ParseSystemInformation(s)
+		case s.Header.Type == TypeBaseboard && si.baseboard == nil:
+			si.baseboard = s
+			si.Baseboard, err = ParseBaseboard(s)
+		case s.Header.Type == TypeChassis && si.chassis == nil:
+			si.chassis = s
+			si.Chassis, err = ParseChassis(s)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Apply post-processors once every Structure has been parsed, so that
+	// a nil field is never passed to one.
+	for _, v := range []smbios.TypedStructure{si.BIOS, si.System, si.Baseboard, si.Chassis} {
+		if err := processed(v, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return si, nil
+}
+
+// SystemInfo parses a SystemInfo from the Table's Structures.
+func (t *Table) SystemInfo() (*SystemInfo, error) {
+	return NewSystemInfo(t.Structures)
+}
+
+// str returns the string referenced at offset off of s, and whether it is
+// present.
+func str(s *smbios.Structure, off int) (string, bool) {
+	if s == nil {
+		return "", false
+	}
+
+	f := fields{s: s}
+	return f.str(off), f.hasStr(off)
+}
+
+// raw returns the raw Structure of type typ, or nil if it is not present.  raw
+// is safe to call on a nil *SystemInfo.
+func (si *SystemInfo) raw(typ uint8) *smbios.Structure {
+	if si == nil {
+		return nil
+	}
+
+	switch typ {
+	case TypeBIOSInformation:
+		return si.bios
+	case TypeSystemInformation:
+		return si.system
+	case TypeBaseboard:
+		return si.baseboard
+	case TypeChassis:
+		return si.chassis
+	default:
+		return nil
+	}
+}
+
+// field returns the string at offset off of the Structure of type typ, and
+// whether it is present.  Presence is determined from the raw Structure, but
+// the value is read from the parsed structure where it has one, so that
+// changes made by post-processors are visible.
+func (si *SystemInfo) field(typ uint8, off int) (string, bool) {
+	v, ok := str(si.raw(typ), off)
+	if !ok {
+		return "", false
+	}
+
+	switch typ {
+	case TypeBIOSInformation:
+		switch off {
+		case 0x04:
+			v = si.BIOS.Vendor
+		case 0x05:
+			v = si.BIOS.Version
+		case 0x08:
+			v = si.BIOS.ReleaseDate
+		}
+	case TypeSystemInformation:
+		switch off {
+		case 0x04:
+			v = si.System.Manufacturer
+		case 0x05:
+			v = si.System.ProductName
+		case 0x06:
+			v = si.System.Version
+		case 0x07:
+			v = si.System.SerialNumber
+		case 0x19:
+			v = si.System.SKUNumber
+		case 0x1a:
+			v = si.System.Family
+		}
+	case TypeBaseboard:
+		switch off {
+		case 0x04:
+			v = si.Baseboard.Manufacturer
+		case 0x05:
+			v = si.Baseboard.Product
+		case 0x06:
+			v = si.Baseboard.Version
+		case 0x07:
+			v = si.Baseboard.SerialNumber
+		case 0x08:
+			v = si.Baseboard.AssetTag
+		}
+	case TypeChassis:
+		switch off {
+		case 0x04:
+			v = si.Chassis.Manufacturer
+		case 0x06:
+			v = si.Chassis.Version
+		case 0x07:
+			v = si.Chassis.SerialNumber
+		case 0x08:
+			v = si.Chassis.AssetTag
+		}
+	}
+
+	return v, true
+}
+
+// BIOSVendor returns the BIOS vendor, or the empty string if it is absent.
+func (si *SystemInfo) BIOSVendor() string {
+	v, _ := si.field(TypeBIOSInformation, 0x04)
+	return v
+}
+
+// HasBIOSVendor reports whether the BIOS vendor is present.
+func (si *SystemInfo) HasBIOSVendor() bool {
+	_, ok := si.field(TypeBIOSInformation, 0x04)
+	return ok
+}
+
+// BIOSVersion returns the BIOS version, or the empty string if it is absent.
+func (si *SystemInfo) BIOSVersion() string {
+	v, _ := si.field(TypeBIOSInformation, 0x05)
+	return v
+}
+
+// HasBIOSVersion reports whether the BIOS version is present.
+func (si *SystemInfo) HasBIOSVersion() bool {
+	_, ok := si.field(TypeBIOSInformation, 0x05)
+	return ok
+}
+
+// SystemManufacturer returns the system manufacturer, or the empty string if
+// it is absent.
+func (si *SystemInfo) SystemManufacturer() string {
+	v, _ := si.field(TypeSystemInformation, 0x04)
+	return v
+}
+
+// HasSystemManufacturer reports whether the system manufacturer is present.
+func (si *SystemInfo) HasSystemManufacturer() bool {
+	_, ok := si.field(TypeSystemInformation, 0x04)
+	return ok
+}
+
+// SystemProductName returns the system product name, or the empty string if
+// it is absent.
+func (si *SystemInfo) SystemProductName() string {
+	v, _ := si.field(TypeSystemInformation, 0x05)
+	return v
+}
+
+// HasSystemProductName reports whether the system product name is present.
+func (si *SystemInfo) HasSystemProductName() bool {
+	_, ok := si.field(TypeSystemInformation, 0x05)
+	return ok
+}
+
+// SystemSerial returns the system serial number, or the empty string if it
+// is absent.
+func (si *SystemInfo) SystemSerial() string {
+	v, _ := si.field(TypeSystemInformation, 0x07)
+	return v
+}
+
+// HasSystemSerial reports whether the system serial number is present.
+func (si *SystemInfo) HasSystemSerial() bool {
+	_, ok := si.field(TypeSystemInformation, 0x07)
+	return ok
+}
+
+// SystemSKU returns the system SKU number, or the empty string if it is
+// absent.
+func (si *SystemInfo) SystemSKU() string {
+	v, _ := si.field(TypeSystemInformation, 0x19)
+	return v
+}
+
+// HasSystemSKU reports whether the system SKU number is present.
+func (si *SystemInfo) HasSystemSKU() bool {
+	_, ok := si.field(TypeSystemInformation, 0x19)
+	return ok
+}
+
+// UUID returns the system UUID, or the zero UUID if it is absent.
+func (si *SystemInfo) UUID() UUID {
+	if !si.HasUUID() {
+		return UUID{}
+	}
+
+	return si.System.UUID
+}
+
+// HasUUID reports whether the system UUID is present.  A UUID with all bits
+// set or clear indicates that no UUID is present, as described by
+// UUID.Present.
+func (si *SystemInfo) HasUUID() bool {
+	return si != nil && si.System != nil && si.System.UUID.Present()
+}
+
+// BaseboardManufacturer returns the baseboard manufacturer, or the empty
+// string if it is absent.
+func (si *SystemInfo) BaseboardManufacturer() string {
+	v, _ := si.field(TypeBaseboard, 0x04)
+	return v
+}
+
+// HasBaseboardManufacturer reports whether the baseboard manufacturer is
+// present.
+func (si *SystemInfo) HasBaseboardManufacturer() bool {
+	_, ok := si.field(TypeBaseboard, 0x04)
+	return ok
+}
+
+// BaseboardProduct returns the baseboard product, or the empty string if it
+// is absent.
+func (si *SystemInfo) BaseboardProduct() string {
+	v, _ := si.field(TypeBaseboard, 0x05)
+	return v
+}
+
+// HasBaseboardProduct reports whether the baseboard product is present.
+func (si *SystemInfo) HasBaseboardProduct() bool {
+	_, ok := si.field(TypeBaseboard, 0x05)
+	return ok
+}
+
+// BaseboardSerial returns the baseboard serial number, or the empty string if
+// it is absent.
+func (si *SystemInfo) BaseboardSerial() string {
+	v, _ := si.field(TypeBaseboard, 0x07)
+	return v
+}
+
+// HasBaseboardSerial reports whether the baseboard serial number is present.
+func (si *SystemInfo) HasBaseboardSerial() bool {
+	_, ok := si.field(TypeBaseboard, 0x07)
+	return ok
+}
+
+// BaseboardAssetTag returns the baseboard asset tag, or the empty string if
+// it is absent.
+func (si *SystemInfo) BaseboardAssetTag() string {
+	v, _ := si.field(TypeBaseboard, 0x08)
+	return v
+}
+
+// HasBaseboardAssetTag reports whether the baseboard asset tag is present.
+func (si *SystemInfo) HasBaseboardAssetTag() bool {
+	_, ok := si.field(TypeBaseboard, 0x08)
+	return ok
+}
+
+// ChassisSerial returns the chassis serial number, or the empty string if it
+// is absent.
+func (si *SystemInfo) ChassisSerial() string {
+	v, _ := si.field(TypeChassis, 0x07)
+	return v
+}
+
+// HasChassisSerial reports whether the chassis serial number is present.
+func (si *SystemInfo) HasChassisSerial() bool {
+	_, ok := si.field(TypeChassis, 0x07)
+	return ok
+}
+
+// ChassisAssetTag returns the chassis asset tag, or the empty string if it is
+// absent.
+func (si *SystemInfo) ChassisAssetTag() string {
+	v, _ := si.field(TypeChassis, 0x08)
+	return v
+}
+
+// HasChassisAssetTag reports whether the chassis asset tag is present.
+func (si *SystemInfo) HasChassisAssetTag() bool {
+	_, ok := si.field(TypeChassis, 0x08)
+	return ok
+}
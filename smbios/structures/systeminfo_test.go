@@ -0,0 +1,122 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewSystemInfo(t *testing.T) {
+	uuid := structures.UUID{0: 0x01, 15: 0x02}
+
+	ss := []*smbios.Structure{
+		newBuilder(0, 0x12, "DigitalOcean").
+			byte(0x04, 1).
+			structure(),
+		// The serial number references a blank string, and the SKU number
+		// references no string.
+		newBuilder(1, 0x1b, "DigitalOcean", "Droplet", " ").
+			byte(0x04, 1).
+			byte(0x05, 2).
+			byte(0x07, 3).
+			bytes(0x08, uuid[:]).
+			structure(),
+		// Only the first structure of each type is used.
+		newBuilder(1, 0x08, "Other").
+			byte(0x04, 1).
+			structure(),
+		newBuilder(2, 0x09, "Board", "1234").
+			byte(0x07, 1).
+			byte(0x08, 2).
+			structure(),
+	}
+
+	si, err := structures.NewSystemInfo(ss)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	type field struct {
+		Value string
+		OK    bool
+	}
+
+	got := map[string]field{
+		"BIOSVendor":            {si.BIOSVendor(), si.HasBIOSVendor()},
+		"BIOSVersion":           {si.BIOSVersion(), si.HasBIOSVersion()},
+		"SystemManufacturer":    {si.SystemManufacturer(), si.HasSystemManufacturer()},
+		"SystemProductName":     {si.SystemProductName(), si.HasSystemProductName()},
+		"SystemSerial":          {si.SystemSerial(), si.HasSystemSerial()},
+		"SystemSKU":             {si.SystemSKU(), si.HasSystemSKU()},
+		"UUID":                  {si.UUID().String(), si.HasUUID()},
+		"BaseboardManufacturer": {si.BaseboardManufacturer(), si.HasBaseboardManufacturer()},
+		"BaseboardProduct":      {si.BaseboardProduct(), si.HasBaseboardProduct()},
+		"BaseboardSerial":       {si.BaseboardSerial(), si.HasBaseboardSerial()},
+		"BaseboardAssetTag":     {si.BaseboardAssetTag(), si.HasBaseboardAssetTag()},
+		"ChassisSerial":         {si.ChassisSerial(), si.HasChassisSerial()},
+		"ChassisAssetTag":       {si.ChassisAssetTag(), si.HasChassisAssetTag()},
+	}
+
+	want := map[string]field{
+		"BIOSVendor":            {"DigitalOcean", true},
+		"BIOSVersion":           {"", false},
+		"SystemManufacturer":    {"DigitalOcean", true},
+		"SystemProductName":     {"Droplet", true},
+		"SystemSerial":          {" ", true},
+		"SystemSKU":             {"", false},
+		"UUID":                  {uuid.String(), true},
+		"BaseboardManufacturer": {"", false},
+		"BaseboardProduct":      {"", false},
+		"BaseboardSerial":       {"Board", true},
+		"BaseboardAssetTag":     {"1234", true},
+		"ChassisSerial":         {"", false},
+		"ChassisAssetTag":       {"", false},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected fields (-want +got):\n%s", diff)
+	}
+
+	if si.Chassis != nil {
+		t.Fatalf("expected no chassis, but got: %+v", si.Chassis)
+	}
+}
+
+func TestNewSystemInfoError(t *testing.T) {
+	ss := []*smbios.Structure{newBuilder(1, 0x06).structure()}
+	if _, err := structures.NewSystemInfo(ss); err == nil {
+		t.Fatal("expected an error, but none occurred")
+	}
+}
+
+func TestSystemInfoNil(t *testing.T) {
+	var si *structures.SystemInfo
+
+	if si.HasUUID() || si.HasSystemSerial() || si.HasBaseboardSerial() || si.HasChassisAssetTag() {
+		t.Fatal("expected no fields to be present")
+	}
+
+	if diff := cmp.Diff(structures.UUID{}, si.UUID()); diff != "" {
+		t.Fatalf("unexpected UUID (-want +got):\n%s", diff)
+	}
+
+	if s := si.SystemSerial(); s != "" {
+		t.Fatalf("unexpected system serial: %q", s)
+	}
+}
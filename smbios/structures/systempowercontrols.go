@@ -0,0 +1,135 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"time"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeSystemPowerControls is the structure type of System Power Controls
+// (type 25).
+const TypeSystemPowerControls = 25
+
+// PowerOnAny is the value of a SystemPowerControls next scheduled power-on
+// field which matches any value.  Firmware indicates this with the value
+// 0xff, and fields which are not valid BCD values in range are treated the
+// same way, as is done by dmidecode.
+const PowerOnAny = -1
+
+// A SystemPowerControls is a System Power Controls (type 25) structure, which
+// describes the next scheduled power-on of a system with a timed power-on
+// capability.
+type SystemPowerControls struct {
+	Header smbios.Header
+
+	// Decoded from the BCD next scheduled power-on fields, or PowerOnAny.
+	Month  int
+	Day    int
+	Hour   int
+	Minute int
+	Second int
+}
+
+// ParseSystemPowerControls parses a SystemPowerControls from a Structure.
+func ParseSystemPowerControls(s *smbios.Structure) (*SystemPowerControls, error) {
+	if err := checkStructure(s, TypeSystemPowerControls, "system power controls", 0x09); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+
+	return &SystemPowerControls{
+		Header: s.Header,
+
+		Month:  bcd(f.byte(0x04), 1, 12),
+		Day:    bcd(f.byte(0x05), 1, 31),
+		Hour:   bcd(f.byte(0x06), 0, 23),
+		Minute: bcd(f.byte(0x07), 0, 59),
+		Second: bcd(f.byte(0x08), 0, 59),
+	}, nil
+}
+
+// bcd decodes a BCD value in the range [lo, hi], returning PowerOnAny if b is
+// not a valid BCD value in range.
+func bcd(b uint8, lo, hi int) int {
+	tens, ones := int(b>>4), int(b&0x0f)
+	if tens > 9 || ones > 9 {
+		return PowerOnAny
+	}
+
+	v := tens*10 + ones
+	if v < lo || v > hi {
+		return PowerOnAny
+	}
+
+	return v
+}
+
+// NextPowerOn returns the earliest time at or after now which matches the
+// next scheduled power-on, in now's location.  Fields set to PowerOnAny
+// match any value.  If no such time exists within eight years, such as for
+// February 30, NextPowerOn returns false.
+func (pc *SystemPowerControls) NextPowerOn(now time.Time) (time.Time, bool) {
+	// Fractional seconds cannot be scheduled.
+	if now.Nanosecond() != 0 {
+		now = now.Truncate(time.Second).Add(time.Second)
+	}
+
+	y, m, d := now.Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, now.Location())
+
+	// Eight years always includes a February 29, even across a century year
+	// such as 2100 which is not a leap year.
+	for end := day.AddDate(8, 0, 1); day.Before(end); day = day.AddDate(0, 0, 1) {
+		if !matches(pc.Month, int(day.Month())) || !matches(pc.Day, day.Day()) {
+			continue
+		}
+
+		for _, h := range powerOnRange(pc.Hour, 23) {
+			for _, mi := range powerOnRange(pc.Minute, 59) {
+				for _, s := range powerOnRange(pc.Second, 59) {
+					t := time.Date(day.Year(), day.Month(), day.Day(), h, mi, s, 0, now.Location())
+					if !t.Before(now) {
+						return t, true
+					}
+				}
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// matches reports whether a next scheduled power-on field matches v.
+func matches(field, v int) bool {
+	return field == PowerOnAny || field == v
+}
+
+// powerOnRange returns the values matched by a next scheduled power-on time
+// field with maximum value hi, in increasing order.
+func powerOnRange(field, hi int) []int {
+	if field != PowerOnAny {
+		return []int{field}
+	}
+
+	vs := make([]int, hi+1)
+	for i := range vs {
+		vs[i] = i
+	}
+
+	return vs
+}
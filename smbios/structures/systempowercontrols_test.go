@@ -0,0 +1,168 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseSystemPowerControls(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		pc   *structures.SystemPowerControls
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s:    newBuilder(24, 0x09).structure(),
+		},
+		{
+			name: "too short",
+			s:    newBuilder(25, 0x08).structure(),
+		},
+		{
+			name: "OK",
+			s: newBuilder(25, 0x09).
+				byte(0x04, 0x12).
+				byte(0x05, 0x31).
+				byte(0x06, 0x23).
+				byte(0x07, 0x59).
+				byte(0x08, 0x05).
+				structure(),
+			pc: &structures.SystemPowerControls{
+				Header: header(25, 0x09),
+				Month:  12,
+				Day:    31,
+				Hour:   23,
+				Minute: 59,
+				Second: 5,
+			},
+			ok: true,
+		},
+		{
+			name: "any",
+			s: newBuilder(25, 0x09).
+				// Wildcard, invalid BCD, out of range.
+				byte(0x04, 0xff).
+				byte(0x05, 0x1a).
+				byte(0x06, 0x24).
+				byte(0x07, 0x30).
+				byte(0x08, 0x00).
+				structure(),
+			pc: &structures.SystemPowerControls{
+				Header: header(25, 0x09),
+				Month:  structures.PowerOnAny,
+				Day:    structures.PowerOnAny,
+				Hour:   structures.PowerOnAny,
+				Minute: 30,
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pc, err := structures.ParseSystemPowerControls(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.pc, pc); diff != "" {
+				t.Fatalf("unexpected system power controls (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSystemPowerControlsNextPowerOn(t *testing.T) {
+	const x = structures.PowerOnAny
+
+	now := time.Date(2024, time.March, 10, 12, 45, 0, 0, time.UTC)
+	date := func(y int, m time.Month, d, h, mi, s int) time.Time {
+		return time.Date(y, m, d, h, mi, s, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name string
+		pc   structures.SystemPowerControls
+		now  time.Time
+		t    time.Time
+		ok   bool
+	}{
+		{
+			name: "any",
+			pc:   structures.SystemPowerControls{Month: x, Day: x, Hour: x, Minute: x, Second: x},
+			t:    now,
+			ok:   true,
+		},
+		{
+			name: "every hour",
+			pc:   structures.SystemPowerControls{Month: x, Day: x, Hour: x, Minute: 30},
+			t:    date(2024, time.March, 10, 13, 30, 0),
+			ok:   true,
+		},
+		{
+			name: "next year",
+			pc:   structures.SystemPowerControls{Month: 1, Day: 1, Hour: 6},
+			t:    date(2025, time.January, 1, 6, 0, 0),
+			ok:   true,
+		},
+		{
+			name: "leap day",
+			pc:   structures.SystemPowerControls{Month: 2, Day: 29},
+			t:    date(2028, time.February, 29, 0, 0, 0),
+			ok:   true,
+		},
+		{
+			name: "leap day after century",
+			pc:   structures.SystemPowerControls{Month: 2, Day: 29},
+			now:  date(2096, time.March, 1, 0, 0, 0),
+			t:    date(2104, time.February, 29, 0, 0, 0),
+			ok:   true,
+		},
+		{
+			name: "impossible",
+			pc:   structures.SystemPowerControls{Month: 2, Day: 30},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := now
+			if !tt.now.IsZero() {
+				start = tt.now
+			}
+
+			next, ok := tt.pc.NextPowerOn(start)
+
+			if diff := cmp.Diff(tt.ok, ok); diff != "" {
+				t.Fatalf("unexpected power-on presence (-want +got):\n%s", diff)
+			}
+			if !tt.t.Equal(next) {
+				t.Fatalf("unexpected power-on time: want %v, got %v", tt.t, next)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeSystemReset is the structure type of System Reset (type 23).
+const TypeSystemReset = 23
+
+// SystemResetUnknown is the value of the count, limit, and interval fields of
+// a SystemReset which are unknown.
+const SystemResetUnknown = 0xffff
+
+// A SystemReset is a System Reset (type 23) structure, which describes
+// whether automatic system reset, such as by a watchdog timer, is enabled.
+type SystemReset struct {
+	Header smbios.Header
+
+	// Decoded from the capabilities field.
+	Enabled           bool
+	WatchdogTimer     bool
+	BootOption        ResetBootOption
+	BootOptionOnLimit ResetBootOption
+
+	// The number of automatic resets since the last intentional reset, and
+	// the number of consecutive resets before BootOptionOnLimit is taken,
+	// or SystemResetUnknown.
+	ResetCount uint16
+	ResetLimit uint16
+
+	// The number of minutes of the reset timer and timeout, or
+	// SystemResetUnknown.
+	TimerInterval uint16
+	Timeout       uint16
+}
+
+// ParseSystemReset parses a SystemReset from a Structure.
+func ParseSystemReset(s *smbios.Structure) (*SystemReset, error) {
+	if err := checkStructure(s, TypeSystemReset, "system reset", 0x0d); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+	caps := f.byte(0x04)
+
+	return &SystemReset{
+		Header: s.Header,
+
+		Enabled:           caps&(1<<0) != 0,
+		WatchdogTimer:     caps&(1<<5) != 0,
+		BootOption:        ResetBootOption((caps >> 1) & 0x03),
+		BootOptionOnLimit: ResetBootOption((caps >> 3) & 0x03),
+
+		ResetCount:    f.word(0x05),
+		ResetLimit:    f.word(0x07),
+		TimerInterval: f.word(0x09),
+		Timeout:       f.word(0x0b),
+	}, nil
+}
+
+// TimerIntervalDuration returns the interval of the reset timer.  If the
+// interval is unknown, TimerIntervalDuration returns false.
+func (sr *SystemReset) TimerIntervalDuration() (time.Duration, bool) {
+	return resetMinutes(sr.TimerInterval)
+}
+
+// TimeoutDuration returns the time after which the reset timer expires and a
+// reset is initiated.  If the timeout is unknown, TimeoutDuration returns
+// false.
+func (sr *SystemReset) TimeoutDuration() (time.Duration, bool) {
+	return resetMinutes(sr.Timeout)
+}
+
+// resetMinutes interprets a SystemReset field specified in minutes.
+func resetMinutes(v uint16) (time.Duration, bool) {
+	if v == SystemResetUnknown {
+		return 0, false
+	}
+
+	return time.Duration(v) * time.Minute, true
+}
+
+// A ResetBootOption is the action taken by a system after an automatic
+// reset.
+type ResetBootOption uint8
+
+// Possible ResetBootOption values.
+const (
+	ResetBootOptionReserved        ResetBootOption = 0x00
+	ResetBootOptionOperatingSystem ResetBootOption = 0x01
+	ResetBootOptionSystemUtilities ResetBootOption = 0x02
+	ResetBootOptionDoNotReboot     ResetBootOption = 0x03
+)
+
+// resetBootOptionNames are the names of each ResetBootOption, as given by
+// dmidecode.
+var resetBootOptionNames = map[ResetBootOption]string{
+	ResetBootOptionOperatingSystem: "Operating System",
+	ResetBootOptionSystemUtilities: "System Utilities",
+	ResetBootOptionDoNotReboot:     "Do Not Reboot",
+}
+
+// String returns the name of a ResetBootOption as given by dmidecode.
+func (o ResetBootOption) String() string {
+	if s, ok := resetBootOptionNames[o]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("ResetBootOption(%d)", uint8(o))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding o as its name.
+func (o ResetBootOption) MarshalText() ([]byte, error) {
+	return []byte(o.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (o *ResetBootOption) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "ResetBootOption", func(v uint8) string { return ResetBootOption(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*o = ResetBootOption(v)
+	return nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseSystemReset(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		sr   *structures.SystemReset
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s:    newBuilder(24, 0x0d).structure(),
+		},
+		{
+			name: "too short",
+			s:    newBuilder(23, 0x0c).structure(),
+		},
+		{
+			name: "OK",
+			s: newBuilder(23, 0x0d).
+				// Enabled, boot to OS, do not reboot on limit, watchdog.
+				byte(0x04, 0x01|0x01<<1|0x03<<3|1<<5).
+				word(0x05, 0xffff).
+				word(0x07, 3).
+				word(0x09, 5).
+				word(0x0b, 0xffff).
+				structure(),
+			sr: &structures.SystemReset{
+				Header:            header(23, 0x0d),
+				Enabled:           true,
+				WatchdogTimer:     true,
+				BootOption:        structures.ResetBootOptionOperatingSystem,
+				BootOptionOnLimit: structures.ResetBootOptionDoNotReboot,
+				ResetCount:        structures.SystemResetUnknown,
+				ResetLimit:        3,
+				TimerInterval:     5,
+				Timeout:           structures.SystemResetUnknown,
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sr, err := structures.ParseSystemReset(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.sr, sr); diff != "" {
+				t.Fatalf("unexpected system reset (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSystemResetDurations(t *testing.T) {
+	sr := &structures.SystemReset{
+		TimerInterval: 5,
+		Timeout:       structures.SystemResetUnknown,
+	}
+
+	if d, ok := sr.TimerIntervalDuration(); !ok || d != 5*time.Minute {
+		t.Fatalf("unexpected timer interval: %v, %v", d, ok)
+	}
+
+	if d, ok := sr.TimeoutDuration(); ok {
+		t.Fatalf("expected unknown timeout, but got: %v", d)
+	}
+
+	if want, got := "Do Not Reboot", structures.ResetBootOptionDoNotReboot.String(); want != got {
+		t.Fatalf("unexpected boot option: want %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,563 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"fmt"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeSystemSlot is the structure type of System Slots (type 9).
+const TypeSystemSlot = 9
+
+// A SystemSlot is a System Slots (type 9) structure, which describes a system
+// expansion slot.
+type SystemSlot struct {
+	Header smbios.Header
+
+	// SMBIOS 2.0+.
+	SlotDesignation  string
+	SlotType         SlotType
+	SlotDataBusWidth uint8
+	CurrentUsage     SlotUsage
+	SlotLength       uint8
+	SlotID           uint16
+	Characteristics1 SlotCharacteristics1
+
+	// SMBIOS 2.1+.
+	Characteristics2 SlotCharacteristics2
+
+	// SMBIOS 2.6+.
+	SegmentGroupNumber   uint16
+	BusNumber            uint8
+	DeviceFunctionNumber uint8
+
+	// SMBIOS 3.2+.
+	DataBusWidth uint8
+	PeerDevices  []SlotPeerDevice
+
+	// SMBIOS 3.4+.
+	SlotInformation   uint8
+	SlotPhysicalWidth uint8
+	SlotPitch         uint16
+
+	// SMBIOS 3.5+.
+	SlotHeight SlotHeight
+}
+
+// A SlotPeerDevice is a device which shares the lanes of a SystemSlot, such
+// as a function of a multi-function device or a device on a bifurcated slot.
+type SlotPeerDevice struct {
+	SegmentGroupNumber   uint16
+	BusNumber            uint8
+	DeviceFunctionNumber uint8
+	DataBusWidth         uint8
+}
+
+// ParseSystemSlot parses a SystemSlot from a Structure.
+func ParseSystemSlot(s *smbios.Structure) (*SystemSlot, error) {
+	// The SMBIOS 2.0 structure ends after the slot characteristics 1 field.
+	if err := checkStructure(s, TypeSystemSlot, "system slot", 0x0c); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+
+	slot := &SystemSlot{
+		Header: s.Header,
+
+		SlotDesignation:  f.str(0x04),
+		SlotType:         SlotType(f.byte(0x05)),
+		SlotDataBusWidth: f.byte(0x06),
+		CurrentUsage:     SlotUsage(f.byte(0x07)),
+		SlotLength:       f.byte(0x08),
+		SlotID:           f.word(0x09),
+		Characteristics1: SlotCharacteristics1(f.byte(0x0b)),
+
+		Characteristics2: SlotCharacteristics2(f.byte(0x0c)),
+
+		SegmentGroupNumber:   f.word(0x0d),
+		BusNumber:            f.byte(0x0f),
+		DeviceFunctionNumber: f.byte(0x10),
+
+		DataBusWidth: f.byte(0x11),
+	}
+
+	// A list of 5 byte peer device records follows the count.
+	n := int(f.byte(0x12))
+	if n > 0 && !f.has(0x13, n*5) {
+		return nil, errShortList("system slot", "peer devices", n)
+	}
+
+	for i := 0; i < n; i++ {
+		off := 0x13 + i*5
+		slot.PeerDevices = append(slot.PeerDevices, SlotPeerDevice{
+			SegmentGroupNumber:   f.word(off),
+			BusNumber:            f.byte(off + 2),
+			DeviceFunctionNumber: f.byte(off + 3),
+			DataBusWidth:         f.byte(off + 4),
+		})
+	}
+
+	// The remaining fields follow the peer device records.
+	off := 0x13 + n*5
+	slot.SlotInformation = f.byte(off)
+	slot.SlotPhysicalWidth = f.byte(off + 1)
+	slot.SlotPitch = f.word(off + 2)
+	slot.SlotHeight = SlotHeight(f.byte(off + 4))
+
+	return slot, nil
+}
+
+// SystemSlots parses all SystemSlots from a list of Structures, ignoring
+// Structures of other types.
+func SystemSlots(ss []*smbios.Structure) ([]*SystemSlot, error) {
+	var slots []*SystemSlot
+	for _, s := range ss {
+		if s.Header.Type != TypeSystemSlot {
+			continue
+		}
+
+		slot, err := ParseSystemSlot(s)
+		if err = processed(slot, err); err != nil {
+			return nil, err
+		}
+
+		slots = append(slots, slot)
+	}
+
+	return slots, nil
+}
+
+// PCIAddress returns the PCI address of the slot in the form used by Linux
+// sysfs, such as "0000:3b:01.0".  If the structure predates SMBIOS 2.6 or the
+// slot has no bus address, PCIAddress returns false.
+func (slot *SystemSlot) PCIAddress() (string, bool) {
+	// Slots without bus addresses set all fields to their maximum values.
+	if slot.Header.Length < 0x11 || slot.BusNumber == 0xff || slot.DeviceFunctionNumber == 0xff {
+		return "", false
+	}
+
+	return pciAddress(slot.SegmentGroupNumber, slot.BusNumber, slot.DeviceFunctionNumber), true
+}
+
+// PCIAddress returns the PCI address of the peer device in the form used by
+// Linux sysfs, such as "0000:3b:01.1".
+func (d SlotPeerDevice) PCIAddress() string {
+	return pciAddress(d.SegmentGroupNumber, d.BusNumber, d.DeviceFunctionNumber)
+}
+
+// pciAddress formats a PCI address in the form used by Linux sysfs.
+func pciAddress(segment uint16, bus, devfn uint8) string {
+	// The device number is stored in bits 7:3 and the function number in
+	// bits 2:0.
+	return fmt.Sprintf("%04x:%02x:%02x.%x", segment, bus, devfn>>3, devfn&0x7)
+}
+
+// SlotPitchMillimeters returns the distance between the centers of adjacent
+// slots in millimeters.  If the pitch is not given, SlotPitchMillimeters
+// returns false.
+func (slot *SystemSlot) SlotPitchMillimeters() (float64, bool) {
+	// The pitch is specified in units of 1/100 millimeter.
+	return float64(slot.SlotPitch) / 100, slot.SlotPitch != 0
+}
+
+// SlotCharacteristics1 is a bitfield which describes the characteristics of a
+// SystemSlot.
+type SlotCharacteristics1 uint8
+
+// Possible SlotCharacteristics1 bits.
+const (
+	SlotCharacteristicsUnknown         SlotCharacteristics1 = 1 << 0
+	SlotCharacteristicsProvides5V0     SlotCharacteristics1 = 1 << 1
+	SlotCharacteristicsProvides3V3     SlotCharacteristics1 = 1 << 2
+	SlotCharacteristicsSharedOpening   SlotCharacteristics1 = 1 << 3
+	SlotCharacteristicsPCCard16        SlotCharacteristics1 = 1 << 4
+	SlotCharacteristicsCardBus         SlotCharacteristics1 = 1 << 5
+	SlotCharacteristicsZoomVideo       SlotCharacteristics1 = 1 << 6
+	SlotCharacteristicsModemRingResume SlotCharacteristics1 = 1 << 7
+)
+
+// slotCharacteristics1Names are the names of each SlotCharacteristics1 bit,
+// indexed by bit number, as given by dmidecode.
+var slotCharacteristics1Names = []string{
+	"Unknown",
+	"5.0 V is provided",
+	"3.3 V is provided",
+	"Opening is shared",
+	"PC Card-16 is supported",
+	"Cardbus is supported",
+	"Zoom Video is supported",
+	"Modem ring resume is supported",
+}
+
+// Names returns the names of each bit set in c, in bit order.
+func (c SlotCharacteristics1) Names() []string {
+	return bitNames(uint64(c), slotCharacteristics1Names)
+}
+
+// String returns the names of each bit set in c separated by commas, or
+// "None" if no bits are set.
+func (c SlotCharacteristics1) String() string {
+	return joinNames(c.Names(), ", ")
+}
+
+// MarshalJSON implements json.Marshaler, encoding c as an array of the names
+// of each bit set in c.
+func (c SlotCharacteristics1) MarshalJSON() ([]byte, error) {
+	return marshalNames(c.Names())
+}
+
+// SlotCharacteristics2 is a bitfield which describes additional
+// characteristics of a SystemSlot.
+type SlotCharacteristics2 uint8
+
+// Possible SlotCharacteristics2 bits.
+const (
+	SlotCharacteristicsPME             SlotCharacteristics2 = 1 << 0
+	SlotCharacteristicsHotPlug         SlotCharacteristics2 = 1 << 1
+	SlotCharacteristicsSMBus           SlotCharacteristics2 = 1 << 2
+	SlotCharacteristicsBifurcation     SlotCharacteristics2 = 1 << 3
+	SlotCharacteristicsSurpriseRemoval SlotCharacteristics2 = 1 << 4
+	SlotCharacteristicsCXL1            SlotCharacteristics2 = 1 << 5
+	SlotCharacteristicsCXL2            SlotCharacteristics2 = 1 << 6
+	SlotCharacteristicsCXL3            SlotCharacteristics2 = 1 << 7
+)
+
+// slotCharacteristics2Names are the names of each SlotCharacteristics2 bit,
+// indexed by bit number, as given by dmidecode.
+var slotCharacteristics2Names = []string{
+	"PME signal is supported",
+	"Hot-plug devices are supported",
+	"SMBus signal is supported",
+	"PCIe slot bifurcation is supported",
+	"Async/surprise removal is supported",
+	"Flexbus slot, CXL 1.0 capable",
+	"Flexbus slot, CXL 2.0 capable",
+	"Flexbus slot, CXL 3.0 capable",
+}
+
+// Names returns the names of each bit set in c, in bit order.
+func (c SlotCharacteristics2) Names() []string {
+	return bitNames(uint64(c), slotCharacteristics2Names)
+}
+
+// String returns the names of each bit set in c separated by commas, or
+// "None" if no bits are set.
+func (c SlotCharacteristics2) String() string {
+	return joinNames(c.Names(), ", ")
+}
+
+// MarshalJSON implements json.Marshaler, encoding c as an array of the names
+// of each bit set in c.
+func (c SlotCharacteristics2) MarshalJSON() ([]byte, error) {
+	return marshalNames(c.Names())
+}
+
+// A SlotType is the type of a SystemSlot.
+type SlotType uint8
+
+// Possible SlotType values.
+const (
+	SlotTypeOther                     SlotType = 0x01
+	SlotTypeUnknown                   SlotType = 0x02
+	SlotTypeISA                       SlotType = 0x03
+	SlotTypeMCA                       SlotType = 0x04
+	SlotTypeEISA                      SlotType = 0x05
+	SlotTypePCI                       SlotType = 0x06
+	SlotTypePCMCIA                    SlotType = 0x07
+	SlotTypeVLB                       SlotType = 0x08
+	SlotTypeProprietary               SlotType = 0x09
+	SlotTypeProcessorCard             SlotType = 0x0a
+	SlotTypeProprietaryMemoryCard     SlotType = 0x0b
+	SlotTypeIORiserCard               SlotType = 0x0c
+	SlotTypeNuBus                     SlotType = 0x0d
+	SlotTypePCI66                     SlotType = 0x0e
+	SlotTypeAGP                       SlotType = 0x0f
+	SlotTypeAGP2x                     SlotType = 0x10
+	SlotTypeAGP4x                     SlotType = 0x11
+	SlotTypePCIX                      SlotType = 0x12
+	SlotTypeAGP8x                     SlotType = 0x13
+	SlotTypeM2Socket1DP               SlotType = 0x14
+	SlotTypeM2Socket1SD               SlotType = 0x15
+	SlotTypeM2Socket2                 SlotType = 0x16
+	SlotTypeM2Socket3                 SlotType = 0x17
+	SlotTypeMXMTypeI                  SlotType = 0x18
+	SlotTypeMXMTypeII                 SlotType = 0x19
+	SlotTypeMXMTypeIII                SlotType = 0x1a
+	SlotTypeMXMTypeIIIHE              SlotType = 0x1b
+	SlotTypeMXMTypeIV                 SlotType = 0x1c
+	SlotTypeMXM3TypeA                 SlotType = 0x1d
+	SlotTypeMXM3TypeB                 SlotType = 0x1e
+	SlotTypePCIe2SFF8639              SlotType = 0x1f
+	SlotTypePCIe3SFF8639              SlotType = 0x20
+	SlotTypePCIeMini52WithKeepouts    SlotType = 0x21
+	SlotTypePCIeMini52WithoutKeepouts SlotType = 0x22
+	SlotTypePCIeMini76                SlotType = 0x23
+	SlotTypePCIe4SFF8639              SlotType = 0x24
+	SlotTypePCIe5SFF8639              SlotType = 0x25
+	SlotTypeOCPNIC3SFF                SlotType = 0x26
+	SlotTypeOCPNIC3LFF                SlotType = 0x27
+	SlotTypeOCPNICPrior3              SlotType = 0x28
+	SlotTypeCXLFlexbus1               SlotType = 0x30
+	SlotTypePC98C20                   SlotType = 0xa0
+	SlotTypePC98C24                   SlotType = 0xa1
+	SlotTypePC98E                     SlotType = 0xa2
+	SlotTypePC98LocalBus              SlotType = 0xa3
+	SlotTypePC98Card                  SlotType = 0xa4
+	SlotTypePCIe                      SlotType = 0xa5
+	SlotTypePCIeX1                    SlotType = 0xa6
+	SlotTypePCIeX2                    SlotType = 0xa7
+	SlotTypePCIeX4                    SlotType = 0xa8
+	SlotTypePCIeX8                    SlotType = 0xa9
+	SlotTypePCIeX16                   SlotType = 0xaa
+	SlotTypePCIe2                     SlotType = 0xab
+	SlotTypePCIe2X1                   SlotType = 0xac
+	SlotTypePCIe2X2                   SlotType = 0xad
+	SlotTypePCIe2X4                   SlotType = 0xae
+	SlotTypePCIe2X8                   SlotType = 0xaf
+	SlotTypePCIe2X16                  SlotType = 0xb0
+	SlotTypePCIe3                     SlotType = 0xb1
+	SlotTypePCIe3X1                   SlotType = 0xb2
+	SlotTypePCIe3X2                   SlotType = 0xb3
+	SlotTypePCIe3X4                   SlotType = 0xb4
+	SlotTypePCIe3X8                   SlotType = 0xb5
+	SlotTypePCIe3X16                  SlotType = 0xb6
+	SlotTypePCIe4                     SlotType = 0xb8
+	SlotTypePCIe4X1                   SlotType = 0xb9
+	SlotTypePCIe4X2                   SlotType = 0xba
+	SlotTypePCIe4X4                   SlotType = 0xbb
+	SlotTypePCIe4X8                   SlotType = 0xbc
+	SlotTypePCIe4X16                  SlotType = 0xbd
+	SlotTypePCIe5                     SlotType = 0xbe
+	SlotTypePCIe5X1                   SlotType = 0xbf
+	SlotTypePCIe5X2                   SlotType = 0xc0
+	SlotTypePCIe5X4                   SlotType = 0xc1
+	SlotTypePCIe5X8                   SlotType = 0xc2
+	SlotTypePCIe5X16                  SlotType = 0xc3
+	SlotTypePCIe6                     SlotType = 0xc4
+	SlotTypeEDSFFE1                   SlotType = 0xc5
+	SlotTypeEDSFFE3                   SlotType = 0xc6
+)
+
+// slotTypeNames are the names of each SlotType, as given in the SMBIOS
+// specification.
+var slotTypeNames = map[SlotType]string{
+	SlotTypeOther:                     "Other",
+	SlotTypeUnknown:                   "Unknown",
+	SlotTypeISA:                       "ISA",
+	SlotTypeMCA:                       "MCA",
+	SlotTypeEISA:                      "EISA",
+	SlotTypePCI:                       "PCI",
+	SlotTypePCMCIA:                    "PC Card (PCMCIA)",
+	SlotTypeVLB:                       "VLB",
+	SlotTypeProprietary:               "Proprietary",
+	SlotTypeProcessorCard:             "Processor Card",
+	SlotTypeProprietaryMemoryCard:     "Proprietary Memory Card",
+	SlotTypeIORiserCard:               "I/O Riser Card",
+	SlotTypeNuBus:                     "NuBus",
+	SlotTypePCI66:                     "PCI-66",
+	SlotTypeAGP:                       "AGP",
+	SlotTypeAGP2x:                     "AGP 2x",
+	SlotTypeAGP4x:                     "AGP 4x",
+	SlotTypePCIX:                      "PCI-X",
+	SlotTypeAGP8x:                     "AGP 8x",
+	SlotTypeM2Socket1DP:               "M.2 Socket 1-DP",
+	SlotTypeM2Socket1SD:               "M.2 Socket 1-SD",
+	SlotTypeM2Socket2:                 "M.2 Socket 2",
+	SlotTypeM2Socket3:                 "M.2 Socket 3",
+	SlotTypeMXMTypeI:                  "MXM Type I",
+	SlotTypeMXMTypeII:                 "MXM Type II",
+	SlotTypeMXMTypeIII:                "MXM Type III",
+	SlotTypeMXMTypeIIIHE:              "MXM Type III-HE",
+	SlotTypeMXMTypeIV:                 "MXM Type IV",
+	SlotTypeMXM3TypeA:                 "MXM 3.0 Type A",
+	SlotTypeMXM3TypeB:                 "MXM 3.0 Type B",
+	SlotTypePCIe2SFF8639:              "PCI Express 2 SFF-8639 (U.2)",
+	SlotTypePCIe3SFF8639:              "PCI Express 3 SFF-8639 (U.2)",
+	SlotTypePCIeMini52WithKeepouts:    "PCI Express Mini 52-pin with bottom-side keep-outs",
+	SlotTypePCIeMini52WithoutKeepouts: "PCI Express Mini 52-pin without bottom-side keep-outs",
+	SlotTypePCIeMini76:                "PCI Express Mini 76-pin",
+	SlotTypePCIe4SFF8639:              "PCI Express 4 SFF-8639 (U.2)",
+	SlotTypePCIe5SFF8639:              "PCI Express 5 SFF-8639 (U.2)",
+	SlotTypeOCPNIC3SFF:                "OCP NIC 3.0 Small Form Factor (SFF)",
+	SlotTypeOCPNIC3LFF:                "OCP NIC 3.0 Large Form Factor (LFF)",
+	SlotTypeOCPNICPrior3:              "OCP NIC Prior to 3.0",
+	SlotTypeCXLFlexbus1:               "CXL Flexbus 1.0",
+	SlotTypePC98C20:                   "PC-98/C20",
+	SlotTypePC98C24:                   "PC-98/C24",
+	SlotTypePC98E:                     "PC-98/E",
+	SlotTypePC98LocalBus:              "PC-98/Local Bus",
+	SlotTypePC98Card:                  "PC-98/Card",
+	SlotTypePCIe:                      "PCI Express",
+	SlotTypePCIeX1:                    "PCI Express x1",
+	SlotTypePCIeX2:                    "PCI Express x2",
+	SlotTypePCIeX4:                    "PCI Express x4",
+	SlotTypePCIeX8:                    "PCI Express x8",
+	SlotTypePCIeX16:                   "PCI Express x16",
+	SlotTypePCIe2:                     "PCI Express 2",
+	SlotTypePCIe2X1:                   "PCI Express 2 x1",
+	SlotTypePCIe2X2:                   "PCI Express 2 x2",
+	SlotTypePCIe2X4:                   "PCI Express 2 x4",
+	SlotTypePCIe2X8:                   "PCI Express 2 x8",
+	SlotTypePCIe2X16:                  "PCI Express 2 x16",
+	SlotTypePCIe3:                     "PCI Express 3",
+	SlotTypePCIe3X1:                   "PCI Express 3 x1",
+	SlotTypePCIe3X2:                   "PCI Express 3 x2",
+	SlotTypePCIe3X4:                   "PCI Express 3 x4",
+	SlotTypePCIe3X8:                   "PCI Express 3 x8",
+	SlotTypePCIe3X16:                  "PCI Express 3 x16",
+	SlotTypePCIe4:                     "PCI Express 4",
+	SlotTypePCIe4X1:                   "PCI Express 4 x1",
+	SlotTypePCIe4X2:                   "PCI Express 4 x2",
+	SlotTypePCIe4X4:                   "PCI Express 4 x4",
+	SlotTypePCIe4X8:                   "PCI Express 4 x8",
+	SlotTypePCIe4X16:                  "PCI Express 4 x16",
+	SlotTypePCIe5:                     "PCI Express 5",
+	SlotTypePCIe5X1:                   "PCI Express 5 x1",
+	SlotTypePCIe5X2:                   "PCI Express 5 x2",
+	SlotTypePCIe5X4:                   "PCI Express 5 x4",
+	SlotTypePCIe5X8:                   "PCI Express 5 x8",
+	SlotTypePCIe5X16:                  "PCI Express 5 x16",
+	SlotTypePCIe6:                     "PCI Express 6+",
+	SlotTypeEDSFFE1:                   "EDSFF E1",
+	SlotTypeEDSFFE3:                   "EDSFF E3",
+}
+
+// String returns the name of a SlotType as given in the SMBIOS
+// specification.
+func (t SlotType) String() string {
+	if s, ok := slotTypeNames[t]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("SlotType(%d)", uint8(t))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding t as its name.
+func (t SlotType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (t *SlotType) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "SlotType", func(v uint8) string { return SlotType(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*t = SlotType(v)
+	return nil
+}
+
+// A SlotUsage is the current usage of a SystemSlot.
+type SlotUsage uint8
+
+// Possible SlotUsage values.
+const (
+	SlotUsageOther       SlotUsage = 0x01
+	SlotUsageUnknown     SlotUsage = 0x02
+	SlotUsageAvailable   SlotUsage = 0x03
+	SlotUsageInUse       SlotUsage = 0x04
+	SlotUsageUnavailable SlotUsage = 0x05
+)
+
+// slotUsageNames are the names of each SlotUsage, as given in the SMBIOS
+// specification.
+var slotUsageNames = map[SlotUsage]string{
+	SlotUsageOther:       "Other",
+	SlotUsageUnknown:     "Unknown",
+	SlotUsageAvailable:   "Available",
+	SlotUsageInUse:       "In use",
+	SlotUsageUnavailable: "Unavailable",
+}
+
+// String returns the name of a SlotUsage as given in the SMBIOS
+// specification.
+func (u SlotUsage) String() string {
+	if s, ok := slotUsageNames[u]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("SlotUsage(%d)", uint8(u))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding u as its name.
+func (u SlotUsage) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (u *SlotUsage) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "SlotUsage", func(v uint8) string { return SlotUsage(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*u = SlotUsage(v)
+	return nil
+}
+
+// A SlotHeight is the maximum height of a card which fits in a SystemSlot.
+type SlotHeight uint8
+
+// Possible SlotHeight values.
+const (
+	SlotHeightNotApplicable SlotHeight = 0x00
+	SlotHeightOther         SlotHeight = 0x01
+	SlotHeightUnknown       SlotHeight = 0x02
+	SlotHeightFullHeight    SlotHeight = 0x03
+	SlotHeightLowProfile    SlotHeight = 0x04
+)
+
+// slotHeightNames are the names of each SlotHeight, as given in the SMBIOS
+// specification.
+var slotHeightNames = map[SlotHeight]string{
+	SlotHeightNotApplicable: "Not Applicable",
+	SlotHeightOther:         "Other",
+	SlotHeightUnknown:       "Unknown",
+	SlotHeightFullHeight:    "Full Height",
+	SlotHeightLowProfile:    "Low-profile",
+}
+
+// String returns the name of a SlotHeight as given in the SMBIOS
+// specification.
+func (h SlotHeight) String() string {
+	if s, ok := slotHeightNames[h]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("SlotHeight(%d)", uint8(h))
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding h as its name.
+func (h SlotHeight) MarshalText() ([]byte, error) {
+	return []byte(h.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a name produced
+// by MarshalText.
+func (h *SlotHeight) UnmarshalText(b []byte) error {
+	v, err := parseName(b, "SlotHeight", func(v uint8) string { return SlotHeight(v).String() })
+	if err != nil {
+		return err
+	}
+
+	*h = SlotHeight(v)
+	return nil
+}
@@ -0,0 +1,237 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseSystemSlot(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		ss   *structures.SystemSlot
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s:    newBuilder(8, 0x0c).structure(),
+		},
+		{
+			name: "too short",
+			s:    newBuilder(9, 0x0b).structure(),
+		},
+		{
+			name: "OK, 2.0",
+			s: newBuilder(9, 0x0c, "PCIe Slot 1").
+				byte(0x04, 1).
+				byte(0x05, 0xb6).
+				byte(0x06, 0x0d).
+				byte(0x07, 0x03).
+				byte(0x08, 0x04).
+				word(0x09, 1).
+				structure(),
+			ss: &structures.SystemSlot{
+				Header:           header(9, 0x0c),
+				SlotDesignation:  "PCIe Slot 1",
+				SlotType:         structures.SlotTypePCIe3X16,
+				SlotDataBusWidth: 0x0d,
+				CurrentUsage:     structures.SlotUsageAvailable,
+				SlotLength:       0x04,
+				SlotID:           1,
+			},
+			ok: true,
+		},
+		{
+			name: "OK, 2.6",
+			s: newBuilder(9, 0x11, "PCIe Slot 1").
+				byte(0x04, 1).
+				byte(0x05, 0xb6).
+				word(0x0d, 1).
+				byte(0x0f, 0x3b).
+				byte(0x10, 0x08).
+				structure(),
+			ss: &structures.SystemSlot{
+				Header:               header(9, 0x11),
+				SlotDesignation:      "PCIe Slot 1",
+				SlotType:             structures.SlotTypePCIe3X16,
+				SegmentGroupNumber:   1,
+				BusNumber:            0x3b,
+				DeviceFunctionNumber: 0x08,
+			},
+			ok: true,
+		},
+		{
+			name: "short peer devices",
+			s: newBuilder(9, 0x17).
+				byte(0x12, 2).
+				structure(),
+		},
+		{
+			name: "OK, 3.5",
+			s: newBuilder(9, 0x1d, "PCIe Slot 2").
+				byte(0x04, 1).
+				byte(0x05, 0xb6).
+				byte(0x06, 0x0d).
+				byte(0x07, 0x04).
+				byte(0x08, 0x04).
+				word(0x09, 2).
+				byte(0x0b, 0x0c).
+				byte(0x0c, 0x0b).
+				word(0x0d, 0).
+				byte(0x0f, 0x3b).
+				byte(0x10, 0x00).
+				byte(0x11, 0x0d).
+				byte(0x12, 1).
+				bytes(0x13, []byte{0x00, 0x00, 0x3b, 0x01, 0x0b}).
+				byte(0x18, 0x05).
+				byte(0x19, 0x0d).
+				word(0x1a, 2032).
+				byte(0x1c, 0x04).
+				structure(),
+			ss: &structures.SystemSlot{
+				Header:           header(9, 0x1d),
+				SlotDesignation:  "PCIe Slot 2",
+				SlotType:         structures.SlotTypePCIe3X16,
+				SlotDataBusWidth: 0x0d,
+				CurrentUsage:     structures.SlotUsageInUse,
+				SlotLength:       0x04,
+				SlotID:           2,
+				Characteristics1: structures.SlotCharacteristicsProvides3V3 | structures.SlotCharacteristicsSharedOpening,
+				Characteristics2: structures.SlotCharacteristicsPME | structures.SlotCharacteristicsHotPlug | structures.SlotCharacteristicsBifurcation,
+				BusNumber:        0x3b,
+				DataBusWidth:     0x0d,
+				PeerDevices: []structures.SlotPeerDevice{{
+					BusNumber:            0x3b,
+					DeviceFunctionNumber: 0x01,
+					DataBusWidth:         0x0b,
+				}},
+				SlotInformation:   0x05,
+				SlotPhysicalWidth: 0x0d,
+				SlotPitch:         2032,
+				SlotHeight:        structures.SlotHeightLowProfile,
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ss, err := structures.ParseSystemSlot(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.ss, ss); diff != "" {
+				t.Fatalf("unexpected system slot (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSystemSlotPCIAddress(t *testing.T) {
+	tests := []struct {
+		name string
+		ss   *structures.SystemSlot
+		addr string
+		ok   bool
+	}{
+		{
+			name: "2.0",
+			ss:   &structures.SystemSlot{Header: header(9, 0x0c)},
+		},
+		{
+			name: "no bus address",
+			ss: &structures.SystemSlot{
+				Header:               header(9, 0x11),
+				SegmentGroupNumber:   0xffff,
+				BusNumber:            0xff,
+				DeviceFunctionNumber: 0xff,
+			},
+		},
+		{
+			name: "OK",
+			ss: &structures.SystemSlot{
+				Header:               header(9, 0x11),
+				SegmentGroupNumber:   1,
+				BusNumber:            0x3b,
+				DeviceFunctionNumber: 0x0a,
+			},
+			addr: "0001:3b:01.2",
+			ok:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr, ok := tt.ss.PCIAddress()
+			if tt.ok != ok {
+				t.Fatalf("unexpected ok: %v", ok)
+			}
+
+			if tt.addr != addr {
+				t.Fatalf("unexpected address: want %q, got %q", tt.addr, addr)
+			}
+		})
+	}
+}
+
+func TestSystemSlotFields(t *testing.T) {
+	slot := &structures.SystemSlot{
+		Characteristics1: structures.SlotCharacteristicsProvides3V3,
+		Characteristics2: structures.SlotCharacteristicsCXL2,
+		PeerDevices: []structures.SlotPeerDevice{{
+			SegmentGroupNumber:   1,
+			BusNumber:            0x3b,
+			DeviceFunctionNumber: 0x09,
+		}},
+		SlotPitch:  2032,
+		SlotHeight: structures.SlotHeight(0x10),
+	}
+
+	pitch, ok := slot.SlotPitchMillimeters()
+	if !ok {
+		t.Fatal("expected slot pitch to be present")
+	}
+
+	got := []string{
+		slot.Characteristics1.String(),
+		slot.Characteristics2.String(),
+		slot.PeerDevices[0].PCIAddress(),
+		fmt.Sprintf("%.2f", pitch),
+		slot.SlotHeight.String(),
+	}
+
+	want := []string{
+		"3.3 V is provided",
+		"Flexbus slot, CXL 2.0 capable",
+		"0001:3b:01.1",
+		"20.32",
+		"SlotHeight(16)",
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected fields (-want +got):\n%s", diff)
+	}
+}
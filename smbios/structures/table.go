@@ -0,0 +1,67 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// A Table is an SMBIOS structure table which provides typed access to its
+// Structures.
+type Table struct {
+	*smbios.Table
+}
+
+// NewTable creates a Table which provides typed access to the Structures of
+// t.
+func NewTable(t *smbios.Table) *Table {
+	return &Table{Table: t}
+}
+
+// MemoryDevices parses all MemoryDevices in the Table.
+func (t *Table) MemoryDevices() ([]*MemoryDevice, error) {
+	return MemoryDevices(t.Structures)
+}
+
+// TotalVolatileMemory returns the total volatile memory capacity of the
+// Table's memory devices in bytes, as computed by MemoryDevice.Capacity.
+func (t *Table) TotalVolatileMemory() (uint64, error) {
+	volatile, _, err := t.totalMemory()
+	return volatile, err
+}
+
+// TotalPersistentMemory returns the total persistent memory capacity of the
+// Table's memory devices in bytes, as computed by MemoryDevice.Capacity.
+func (t *Table) TotalPersistentMemory() (uint64, error) {
+	_, persistent, err := t.totalMemory()
+	return persistent, err
+}
+
+// totalMemory sums the volatile and persistent memory capacity of the Table's
+// memory devices.
+func (t *Table) totalMemory() (volatile, persistent uint64, err error) {
+	mds, err := t.MemoryDevices()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, md := range mds {
+		v, p := md.Capacity()
+		volatile += v
+		persistent += p
+	}
+
+	return volatile, persistent, nil
+}
@@ -0,0 +1,46 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.23
+// +build go1.23
+
+package structures
+
+import (
+	"iter"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// MemoryDevicesSeq returns an iterator over the MemoryDevices in the Table,
+// parsing each one as it is reached.  If a MemoryDevice cannot be parsed, the
+// iterator yields the error and stops.
+func (t *Table) MemoryDevicesSeq() iter.Seq2[*MemoryDevice, error] {
+	return parseSeq(t.OfType(TypeMemoryDevice), ParseMemoryDevice)
+}
+
+// parseSeq returns an iterator which parses and post-processes each Structure
+// from ss using parse.  If a Structure cannot be parsed, the iterator yields
+// the error and stops.
+func parseSeq[T smbios.TypedStructure](ss iter.Seq[*smbios.Structure], parse func(*smbios.Structure) (T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for s := range ss {
+			v, err := parse(s)
+			err = processed(v, err)
+			if !yield(v, err) || err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.23
+// +build go1.23
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTableMemoryDevicesSeq(t *testing.T) {
+	tbl := structures.NewTable(&smbios.Table{
+		Structures: []*smbios.Structure{
+			newBuilder(17, 0x15).word(0x0c, 8192).structure(),
+			newBuilder(1, 0x08).structure(),
+			newBuilder(17, 0x15).word(0x0c, 16384).structure(),
+			// Too short, terminates iteration.
+			newBuilder(17, 0x14).structure(),
+			newBuilder(17, 0x15).word(0x0c, 32768).structure(),
+		},
+	})
+
+	var (
+		sizes []uint16
+		errs  int
+	)
+
+	for md, err := range tbl.MemoryDevicesSeq() {
+		if err != nil {
+			errs++
+			continue
+		}
+
+		sizes = append(sizes, md.Size)
+	}
+
+	if diff := cmp.Diff([]uint16{8192, 16384}, sizes); diff != "" {
+		t.Fatalf("unexpected sizes (-want +got):\n%s", diff)
+	}
+	if errs != 1 {
+		t.Fatalf("unexpected number of errors: %d", errs)
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTableTotalMemory(t *testing.T) {
+	const gib = 1 << 30
+
+	// md builds an SMBIOS 3.2 memory device with the specified technology,
+	// size in megabytes, and volatile, non-volatile, and cache sizes.
+	md := func(tech uint8, mb uint16, volatile, nonVolatile, cache uint64) *smbios.Structure {
+		return newBuilder(17, 0x54).
+			word(0x0c, mb).
+			byte(0x28, tech).
+			qword(0x34, nonVolatile).
+			qword(0x3c, volatile).
+			qword(0x44, cache).
+			structure()
+	}
+
+	tests := []struct {
+		name                 string
+		ss                   []*smbios.Structure
+		volatile, persistent uint64
+		ok                   bool
+	}{
+		{
+			name: "bad memory device",
+			ss:   []*smbios.Structure{newBuilder(17, 0x14).structure()},
+		},
+		{
+			name: "no memory devices",
+			ss:   []*smbios.Structure{newBuilder(1, 0x08).structure()},
+			ok:   true,
+		},
+		{
+			name: "pre-3.2 DRAM and empty slot",
+			ss: []*smbios.Structure{
+				newBuilder(17, 0x15).word(0x0c, 16384).structure(),
+				newBuilder(17, 0x15).structure(),
+			},
+			volatile: 16 * gib,
+			ok:       true,
+		},
+		{
+			name: "DRAM and NVDIMM-N without sizes",
+			ss: []*smbios.Structure{
+				md(0x03, 16384, 0, 0, 0),
+				md(0x04, 16384, 0, 0, 0),
+			},
+			volatile:   16 * gib,
+			persistent: 16 * gib,
+			ok:         true,
+		},
+		{
+			name: "Optane memory mode with DRAM cache",
+			ss: []*smbios.Structure{
+				// DRAM is used entirely as cache.
+				md(0x03, 16384, 0, 0, 16*gib),
+				// Optane is split between memory and app direct modes.
+				md(0x07, 0x7fff, 64*gib, 64*gib, 0),
+			},
+			volatile:   64 * gib,
+			persistent: 64 * gib,
+			ok:         true,
+		},
+		{
+			name: "DRAM partially used as cache",
+			ss: []*smbios.Structure{
+				md(0x03, 16384, 0, 0, 4*gib),
+			},
+			volatile: 12 * gib,
+			ok:       true,
+		},
+		{
+			name: "unknown sizes",
+			ss: []*smbios.Structure{
+				md(0x03, 8192, 0xffffffffffffffff, 0xffffffffffffffff, 0),
+			},
+			volatile: 8 * gib,
+			ok:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := structures.NewTable(&smbios.Table{Structures: tt.ss})
+
+			volatile, err := tbl.TotalVolatileMemory()
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+			if !tt.ok {
+				return
+			}
+
+			persistent, err := tbl.TotalPersistentMemory()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.volatile, volatile); diff != "" {
+				t.Fatalf("unexpected volatile memory (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.persistent, persistent); diff != "" {
+				t.Fatalf("unexpected persistent memory (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeTemperatureProbe is the structure type of a Temperature Probe (type 28).
+const TypeTemperatureProbe = 28
+
+// A TemperatureProbe is a Temperature Probe (type 28) structure, which
+// describes a temperature probe within a system.
+//
+// The reading fields of the embedded Probe are raw values which are 0x8000 if
+// unknown.  Use the accessor methods to interpret them.
+type TemperatureProbe struct {
+	Probe
+}
+
+// ParseTemperatureProbe parses a TemperatureProbe from a Structure.
+func ParseTemperatureProbe(s *smbios.Structure) (*TemperatureProbe, error) {
+	p, err := parseProbe(s, TypeTemperatureProbe, "temperature probe")
+	if err != nil {
+		return nil, err
+	}
+
+	return &TemperatureProbe{Probe: p}, nil
+}
+
+// MaximumCelsius returns the maximum temperature readable by the probe in
+// degrees Celsius.  If the value is unknown, MaximumCelsius returns false.
+func (tp *TemperatureProbe) MaximumCelsius() (float64, bool) {
+	return tenths(probeSigned(tp.MaximumValue))
+}
+
+// MinimumCelsius returns the minimum temperature readable by the probe in
+// degrees Celsius.  If the value is unknown, MinimumCelsius returns false.
+func (tp *TemperatureProbe) MinimumCelsius() (float64, bool) {
+	return tenths(probeSigned(tp.MinimumValue))
+}
+
+// NominalCelsius returns the nominal temperature of the probe in degrees
+// Celsius.  If the value is unknown or not present, NominalCelsius returns
+// false.
+func (tp *TemperatureProbe) NominalCelsius() (float64, bool) {
+	return tenths(probeSigned(tp.NominalValue))
+}
+
+// ResolutionCelsius returns the resolution of the probe's reading in degrees
+// Celsius.  If the value is unknown, ResolutionCelsius returns false.
+func (tp *TemperatureProbe) ResolutionCelsius() (float64, bool) {
+	// The resolution field is specified in thousandths of degrees.
+	v, ok := probeUnsigned(tp.Resolution)
+	return float64(v) / 1000, ok
+}
+
+// ToleranceCelsius returns the tolerance of the probe's reading in plus or
+// minus degrees Celsius.  If the value is unknown, ToleranceCelsius returns
+// false.
+func (tp *TemperatureProbe) ToleranceCelsius() (float64, bool) {
+	return tenths(probeUnsigned(tp.Tolerance))
+}
+
+// tenths converts a probe reading specified in tenths of a unit.
+func tenths(v int, ok bool) (float64, bool) {
+	return float64(v) / 10, ok
+}
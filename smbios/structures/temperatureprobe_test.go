@@ -0,0 +1,66 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTemperatureProbeReadings(t *testing.T) {
+	tp := &structures.TemperatureProbe{
+		Probe: structures.Probe{
+			MaximumValue: 1000,
+			MinimumValue: 0xff9c,
+			Resolution:   500,
+			Tolerance:    0x8000,
+			Accuracy:     50,
+			NominalValue: 0x8000,
+		},
+	}
+
+	type reading struct {
+		V  float64
+		OK bool
+	}
+
+	var got []reading
+	for _, fn := range []func() (float64, bool){
+		tp.MaximumCelsius,
+		tp.MinimumCelsius,
+		tp.NominalCelsius,
+		tp.ResolutionCelsius,
+		tp.ToleranceCelsius,
+		tp.AccuracyPercent,
+	} {
+		v, ok := fn()
+		got = append(got, reading{V: v, OK: ok})
+	}
+
+	want := []reading{
+		{V: 100, OK: true},
+		{V: -10, OK: true},
+		{},
+		{V: 0.5, OK: true},
+		{},
+		{V: 0.5, OK: true},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected readings (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeTPMDevice is the structure type of a TPM Device (type 43).
+const TypeTPMDevice = 43
+
+// A TPMDevice is a TPM Device (type 43) structure, which describes a Trusted
+// Platform Module.
+type TPMDevice struct {
+	Header smbios.Header
+
+	VendorID         [4]byte
+	MajorSpecVersion uint8
+	MinorSpecVersion uint8
+	FirmwareVersion1 uint32
+	FirmwareVersion2 uint32
+	Description      string
+	Characteristics  uint64
+	OEMDefined       uint32
+}
+
+// ParseTPMDevice parses a TPMDevice from a Structure.
+func ParseTPMDevice(s *smbios.Structure) (*TPMDevice, error) {
+	if err := checkStructure(s, TypeTPMDevice, "TPM device", 0x1f); err != nil {
+		return nil, err
+	}
+
+	f := fields{s: s}
+
+	tpm := &TPMDevice{
+		Header: s.Header,
+
+		MajorSpecVersion: f.byte(0x08),
+		MinorSpecVersion: f.byte(0x09),
+		FirmwareVersion1: f.dword(0x0a),
+		FirmwareVersion2: f.dword(0x0e),
+		Description:      f.str(0x12),
+		Characteristics:  f.qword(0x13),
+		OEMDefined:       f.dword(0x1b),
+	}
+
+	copy(tpm.VendorID[:], s.Formatted[0x04-headerLen:])
+
+	return tpm, nil
+}
+
+// Vendor returns the TPM vendor ID as a string, such as "IFX" or "NTC".
+// Trailing NUL bytes and spaces are removed.
+func (tpm *TPMDevice) Vendor() string {
+	return strings.TrimRight(string(tpm.VendorID[:]), "\x00 ")
+}
+
+// SpecVersion returns the TPM specification version implemented by the
+// device, such as "2.0".
+func (tpm *TPMDevice) SpecVersion() string {
+	return fmt.Sprintf("%d.%d", tpm.MajorSpecVersion, tpm.MinorSpecVersion)
+}
+
+// FirmwareVersion returns the TPM firmware version.  TPM 1.2 devices report
+// their version in the format of the TPM_VERSION structure, and TPM 2.0
+// devices report the major and minor versions in the upper and lower 16 bits
+// of the first firmware version field.
+func (tpm *TPMDevice) FirmwareVersion() string {
+	switch tpm.MajorSpecVersion {
+	case 0x01:
+		v := tpm.FirmwareVersion1
+		return fmt.Sprintf("%d.%d", uint8(v>>16), uint8(v>>24))
+	case 0x02:
+		v := tpm.FirmwareVersion1
+		return fmt.Sprintf("%d.%d", v>>16, v&0xffff)
+	default:
+		return ""
+	}
+}
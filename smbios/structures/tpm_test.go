@@ -0,0 +1,128 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseTPMDevice(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *smbios.Structure
+		tpm  *structures.TPMDevice
+		ok   bool
+	}{
+		{
+			name: "wrong type",
+			s:    newBuilder(42, 0x1f).structure(),
+		},
+		{
+			name: "too short",
+			s:    newBuilder(43, 0x1e).structure(),
+		},
+		{
+			name: "OK",
+			s: newBuilder(43, 0x1f, "INFINEON").
+				bytes(0x04, []byte{'I', 'F', 'X', 0x00}).
+				byte(0x08, 2).
+				byte(0x09, 0).
+				dword(0x0a, 0x00070055).
+				byte(0x12, 1).
+				qword(0x13, 0x10).
+				dword(0x1b, 0xff).
+				structure(),
+			tpm: &structures.TPMDevice{
+				Header:           header(43, 0x1f),
+				VendorID:         [4]byte{'I', 'F', 'X', 0x00},
+				MajorSpecVersion: 2,
+				FirmwareVersion1: 0x00070055,
+				Description:      "INFINEON",
+				Characteristics:  0x10,
+				OEMDefined:       0xff,
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tpm, err := structures.ParseTPMDevice(tt.s)
+
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected an error, but none occurred: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.tpm, tpm); diff != "" {
+				t.Fatalf("unexpected TPM device (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTPMDeviceVersions(t *testing.T) {
+	tests := []struct {
+		name              string
+		tpm               *structures.TPMDevice
+		vendor, spec, fwv string
+	}{
+		{
+			name: "TPM 1.2",
+			tpm: &structures.TPMDevice{
+				VendorID:         [4]byte{'A', 'T', 'M', 'L'},
+				MajorSpecVersion: 1,
+				MinorSpecVersion: 2,
+				FirmwareVersion1: 0x0d0d0201,
+			},
+			vendor: "ATML",
+			spec:   "1.2",
+			fwv:    "13.13",
+		},
+		{
+			name: "TPM 2.0",
+			tpm: &structures.TPMDevice{
+				VendorID:         [4]byte{'N', 'T', 'C', 0x00},
+				MajorSpecVersion: 2,
+				FirmwareVersion1: 0x00070002,
+			},
+			vendor: "NTC",
+			spec:   "2.0",
+			fwv:    "7.2",
+		},
+		{
+			name: "unknown",
+			tpm: &structures.TPMDevice{
+				MajorSpecVersion: 3,
+			},
+			spec: "3.0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{tt.tpm.Vendor(), tt.tpm.SpecVersion(), tt.tpm.FirmwareVersion()}
+			if diff := cmp.Diff([]string{tt.vendor, tt.spec, tt.fwv}, got); diff != "" {
+				t.Fatalf("unexpected versions (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
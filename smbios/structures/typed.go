@@ -0,0 +1,62 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.18
+// +build go1.18
+
+package structures
+
+import (
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// Register each typed structure so it can be retrieved using smbios.Get.
+func init() {
+	smbios.Register(ParseBIOSInformation)
+	smbios.Register(ParseSystemInformation)
+	smbios.Register(ParseBaseboard)
+	smbios.Register(ParseChassis)
+	smbios.Register(ParseProcessor)
+	smbios.Register(ParseMemoryController)
+	smbios.Register(ParseMemoryModule)
+	smbios.Register(ParseCacheInformation)
+	smbios.Register(ParsePortConnector)
+	smbios.Register(ParseSystemSlot)
+	smbios.Register(ParseOnBoardDevices)
+	smbios.Register(ParseOEMStrings)
+	smbios.Register(ParseSystemConfigurationOptions)
+	smbios.Register(ParseBIOSLanguageInformation)
+	smbios.Register(ParseGroupAssociations)
+	smbios.Register(ParsePhysicalMemoryArray)
+	smbios.Register(ParseMemoryDevice)
+	smbios.Register(ParseMemoryErrorInformation32)
+	smbios.Register(ParseMemoryArrayMappedAddress)
+	smbios.Register(ParseMemoryDeviceMappedAddress)
+	smbios.Register(ParseSystemReset)
+	smbios.Register(ParseHardwareSecurity)
+	smbios.Register(ParseSystemPowerControls)
+	smbios.Register(ParseVoltageProbe)
+	smbios.Register(ParseCoolingDevice)
+	smbios.Register(ParseTemperatureProbe)
+	smbios.Register(ParseElectricalCurrentProbe)
+	smbios.Register(ParseOutOfBandRemoteAccess)
+	smbios.Register(ParseBootIntegrityServices)
+	smbios.Register(ParseSystemBootInformation)
+	smbios.Register(ParseMemoryErrorInformation64)
+	smbios.Register(ParseManagementDevice)
+	smbios.Register(ParseAdditionalInformation)
+	smbios.Register(ParseSystemPowerSupply)
+	smbios.Register(ParseTPMDevice)
+	smbios.Register(ParseFirmwareInventory)
+}
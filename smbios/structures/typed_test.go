@@ -0,0 +1,375 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.18
+// +build go1.18
+
+package structures_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGet(t *testing.T) {
+	tbl := &smbios.Table{
+		Structures: []*smbios.Structure{
+			newBuilder(17, 0x15).word(0x0c, 8192).structure(),
+			newBuilder(1, 0x08).structure(),
+			// Too short, skipped.
+			newBuilder(17, 0x14).structure(),
+			newBuilder(17, 0x15).word(0x0c, 16384).structure(),
+		},
+	}
+
+	var sizes []uint16
+	for _, md := range smbios.Get[*structures.MemoryDevice](tbl) {
+		sizes = append(sizes, md.Size)
+	}
+
+	if diff := cmp.Diff([]uint16{8192, 16384}, sizes); diff != "" {
+		t.Fatalf("unexpected sizes (-want +got):\n%s", diff)
+	}
+
+	if bs := smbios.Get[*structures.BIOSInformation](tbl); len(bs) != 0 {
+		t.Fatalf("unexpected BIOS information: %v", bs)
+	}
+}
+
+func TestGetPostProcessors(t *testing.T) {
+	defer smbios.RegisterPostProcessor(func(c *structures.Chassis) error {
+		if c.Manufacturer == "" {
+			return errors.New("no manufacturer")
+		}
+
+		c.Manufacturer = "acme"
+		return nil
+	})()
+	defer smbios.RegisterPostProcessor(func(c *structures.Chassis) error {
+		c.Manufacturer += " (enriched)"
+		return nil
+	})()
+
+	tbl := &smbios.Table{
+		Structures: []*smbios.Structure{
+			newBuilder(3, 0x09, "ACME Corp.").byte(0x04, 1).structure(),
+			// No manufacturer, skipped.
+			newBuilder(3, 0x09).structure(),
+		},
+	}
+
+	var names []string
+	for _, c := range smbios.Get[*structures.Chassis](tbl) {
+		names = append(names, c.Manufacturer)
+	}
+
+	if diff := cmp.Diff([]string{"acme (enriched)"}, names); diff != "" {
+		t.Fatalf("unexpected manufacturers (-want +got):\n%s", diff)
+	}
+}
+
+func TestPostProcessorsAggregates(t *testing.T) {
+	tbl := structures.NewTable(&smbios.Table{
+		Structures: []*smbios.Structure{
+			newBuilder(1, 0x08, "acme", "", "", "ABC123").byte(0x04, 1).byte(0x07, 4).structure(),
+			newBuilder(17, 0x15, "DIMM_A1").byte(0x10, 1).word(0x0c, 8192).structure(),
+		},
+	})
+
+	unregister := smbios.RegisterPostProcessor(func(si *structures.SystemInformation) error {
+		si.SerialNumber = "serial-" + si.SerialNumber
+		return nil
+	})
+	unregisterMD := smbios.RegisterPostProcessor(func(md *structures.MemoryDevice) error {
+		md.DeviceLocator = "slot " + md.DeviceLocator
+		return nil
+	})
+
+	info, err := tbl.SystemInfo()
+	if err != nil {
+		t.Fatalf("failed to get system info: %v", err)
+	}
+	if diff := cmp.Diff("serial-ABC123", info.SystemSerial()); diff != "" {
+		t.Fatalf("unexpected system serial (-want +got):\n%s", diff)
+	}
+
+	flat, err := tbl.Flatten()
+	if err != nil {
+		t.Fatalf("failed to flatten: %v", err)
+	}
+	if diff := cmp.Diff("serial-ABC123", flat["system.serial_number"]); diff != "" {
+		t.Fatalf("unexpected flattened serial (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff("slot DIMM_A1", flat["memory.device.0.locator"]); diff != "" {
+		t.Fatalf("unexpected flattened locator (-want +got):\n%s", diff)
+	}
+
+	mds, err := tbl.MemoryDevices()
+	if err != nil {
+		t.Fatalf("failed to get memory devices: %v", err)
+	}
+	if diff := cmp.Diff("slot DIMM_A1", mds[0].DeviceLocator); diff != "" {
+		t.Fatalf("unexpected locator (-want +got):\n%s", diff)
+	}
+
+	unregister()
+	unregisterMD()
+
+	info, err = tbl.SystemInfo()
+	if err != nil {
+		t.Fatalf("failed to get system info: %v", err)
+	}
+	if diff := cmp.Diff("ABC123", info.SystemSerial()); diff != "" {
+		t.Fatalf("unexpected system serial after unregister (-want +got):\n%s", diff)
+	}
+
+	mds, err = tbl.MemoryDevices()
+	if err != nil {
+		t.Fatalf("failed to get memory devices after unregister: %v", err)
+	}
+	if diff := cmp.Diff("DIMM_A1", mds[0].DeviceLocator); diff != "" {
+		t.Fatalf("unexpected locator after unregister (-want +got):\n%s", diff)
+	}
+}
+
+func TestPostProcessorsParse(t *testing.T) {
+	s := newBuilder(17, 0x15, "DIMM_A1").byte(0x10, 1).word(0x0c, 8192).structure()
+
+	defer smbios.RegisterPostProcessor(func(md *structures.MemoryDevice) error {
+		md.DeviceLocator = "slot " + md.DeviceLocator
+		return nil
+	})()
+
+	// Parsing a single Structure does not apply post-processors, so that
+	// callers can choose when to apply them.
+	md, err := structures.ParseMemoryDevice(s)
+	if err != nil {
+		t.Fatalf("failed to parse memory device: %v", err)
+	}
+	if diff := cmp.Diff("DIMM_A1", md.DeviceLocator); diff != "" {
+		t.Fatalf("unexpected locator (-want +got):\n%s", diff)
+	}
+
+	if err := smbios.PostProcess(md); err != nil {
+		t.Fatalf("failed to post-process memory device: %v", err)
+	}
+	if diff := cmp.Diff("slot DIMM_A1", md.DeviceLocator); diff != "" {
+		t.Fatalf("unexpected post-processed locator (-want +got):\n%s", diff)
+	}
+}
+
+// An allVisitor implements every typed Visitor interface and StructureVisitor,
+// recording the types of the Structures passed to each.
+type allVisitor struct {
+	typed, untyped []uint8
+}
+
+func (v *allVisitor) visit(x smbios.TypedStructure) error {
+	v.typed = append(v.typed, x.StructureType())
+	return nil
+}
+
+func (v *allVisitor) VisitStructure(s *smbios.Structure) error {
+	v.untyped = append(v.untyped, s.Header.Type)
+	return nil
+}
+
+func (v *allVisitor) VisitBIOS(x *structures.BIOSInformation) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitSystemInformation(x *structures.SystemInformation) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitBaseboard(x *structures.Baseboard) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitChassis(x *structures.Chassis) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitProcessor(x *structures.Processor) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitMemoryController(x *structures.MemoryController) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitMemoryModule(x *structures.MemoryModule) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitCacheInformation(x *structures.CacheInformation) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitPortConnector(x *structures.PortConnector) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitSystemSlot(x *structures.SystemSlot) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitOnBoardDevices(x *structures.OnBoardDevices) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitOEMStrings(x *structures.OEMStrings) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitSystemConfigurationOptions(x *structures.SystemConfigurationOptions) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitBIOSLanguageInformation(x *structures.BIOSLanguageInformation) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitGroupAssociations(x *structures.GroupAssociations) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitPhysicalMemoryArray(x *structures.PhysicalMemoryArray) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitMemoryDevice(x *structures.MemoryDevice) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitMemoryErrorInformation32(x *structures.MemoryErrorInformation32) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitMemoryArrayMappedAddress(x *structures.MemoryArrayMappedAddress) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitMemoryDeviceMappedAddress(x *structures.MemoryDeviceMappedAddress) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitSystemReset(x *structures.SystemReset) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitHardwareSecurity(x *structures.HardwareSecurity) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitSystemPowerControls(x *structures.SystemPowerControls) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitVoltageProbe(x *structures.VoltageProbe) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitCoolingDevice(x *structures.CoolingDevice) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitTemperatureProbe(x *structures.TemperatureProbe) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitElectricalCurrentProbe(x *structures.ElectricalCurrentProbe) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitOutOfBandRemoteAccess(x *structures.OutOfBandRemoteAccess) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitBootIntegrityServices(x *structures.BootIntegrityServices) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitSystemBootInformation(x *structures.SystemBootInformation) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitMemoryErrorInformation64(x *structures.MemoryErrorInformation64) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitManagementDevice(x *structures.ManagementDevice) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitSystemPowerSupply(x *structures.SystemPowerSupply) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitAdditionalInformation(x *structures.AdditionalInformation) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitTPMDevice(x *structures.TPMDevice) error {
+	return v.visit(x)
+}
+
+func (v *allVisitor) VisitFirmwareInventory(x *structures.FirmwareInventory) error {
+	return v.visit(x)
+}
+
+func TestVisitRegisteredTypes(t *testing.T) {
+	// Each type with a registered parser must be dispatched to a typed
+	// Visitor by Visit rather than falling through to VisitStructure.
+	for i := 0; i < 256; i++ {
+		typ := uint8(i)
+		if typ == 127 {
+			// End-of-table is appended by the Encoder.
+			continue
+		}
+
+		var buf bytes.Buffer
+		ss := []*smbios.Structure{newBuilder(typ, 0x04).structure()}
+		if err := smbios.NewEncoder(&buf).Encode(ss); err != nil {
+			t.Fatalf("failed to encode structure type %d: %v", typ, err)
+		}
+
+		// A Structure with only a header is typically too short to parse, in
+		// which case the typed Visitor method is not called, but an error
+		// is returned instead.
+		v := &allVisitor{}
+		err := structures.Visit(smbios.NewDecoder(&buf), v)
+
+		var visited bool
+		for _, u := range v.untyped {
+			if u == typ {
+				visited = true
+			}
+		}
+
+		switch registered := smbios.Registered(typ); {
+		case registered && visited:
+			t.Errorf("structure type %d has a registered parser, but was not dispatched to a Visitor", typ)
+		case !registered && (!visited || err != nil):
+			t.Errorf("structure type %d has no registered parser, but was not passed to VisitStructure: %v", typ, err)
+		case registered && err == nil && len(v.typed) == 0:
+			t.Errorf("structure type %d was neither visited nor reported as an error", typ)
+		}
+	}
+}
@@ -0,0 +1,619 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// Visitor interfaces are implemented by types passed to Visit to receive
+// parsed structures of a specific type.  A type may implement any number of
+// Visitor interfaces.
+type (
+	// A BIOSVisitor receives each parsed BIOSInformation.
+	BIOSVisitor interface {
+		VisitBIOS(v *BIOSInformation) error
+	}
+
+	// A SystemInformationVisitor receives each parsed SystemInformation.
+	SystemInformationVisitor interface {
+		VisitSystemInformation(v *SystemInformation) error
+	}
+
+	// A BaseboardVisitor receives each parsed Baseboard.
+	BaseboardVisitor interface {
+		VisitBaseboard(v *Baseboard) error
+	}
+
+	// A ChassisVisitor receives each parsed Chassis.
+	ChassisVisitor interface {
+		VisitChassis(v *Chassis) error
+	}
+
+	// A ProcessorVisitor receives each parsed Processor.
+	ProcessorVisitor interface {
+		VisitProcessor(v *Processor) error
+	}
+
+	// A MemoryControllerVisitor receives each parsed MemoryController.
+	MemoryControllerVisitor interface {
+		VisitMemoryController(v *MemoryController) error
+	}
+
+	// A MemoryModuleVisitor receives each parsed MemoryModule.
+	MemoryModuleVisitor interface {
+		VisitMemoryModule(v *MemoryModule) error
+	}
+
+	// A CacheInformationVisitor receives each parsed CacheInformation.
+	CacheInformationVisitor interface {
+		VisitCacheInformation(v *CacheInformation) error
+	}
+
+	// A PortConnectorVisitor receives each parsed PortConnector.
+	PortConnectorVisitor interface {
+		VisitPortConnector(v *PortConnector) error
+	}
+
+	// A SystemSlotVisitor receives each parsed SystemSlot.
+	SystemSlotVisitor interface {
+		VisitSystemSlot(v *SystemSlot) error
+	}
+
+	// An OnBoardDevicesVisitor receives each parsed OnBoardDevices.
+	OnBoardDevicesVisitor interface {
+		VisitOnBoardDevices(v *OnBoardDevices) error
+	}
+
+	// An OEMStringsVisitor receives each parsed OEMStrings.
+	OEMStringsVisitor interface {
+		VisitOEMStrings(v *OEMStrings) error
+	}
+
+	// A SystemConfigurationOptionsVisitor receives each parsed
+	// SystemConfigurationOptions.
+	SystemConfigurationOptionsVisitor interface {
+		VisitSystemConfigurationOptions(v *SystemConfigurationOptions) error
+	}
+
+	// A BIOSLanguageInformationVisitor receives each parsed
+	// BIOSLanguageInformation.
+	BIOSLanguageInformationVisitor interface {
+		VisitBIOSLanguageInformation(v *BIOSLanguageInformation) error
+	}
+
+	// A GroupAssociationsVisitor receives each parsed GroupAssociations.
+	GroupAssociationsVisitor interface {
+		VisitGroupAssociations(v *GroupAssociations) error
+	}
+
+	// A PhysicalMemoryArrayVisitor receives each parsed PhysicalMemoryArray.
+	PhysicalMemoryArrayVisitor interface {
+		VisitPhysicalMemoryArray(v *PhysicalMemoryArray) error
+	}
+
+	// A MemoryDeviceVisitor receives each parsed MemoryDevice.
+	MemoryDeviceVisitor interface {
+		VisitMemoryDevice(v *MemoryDevice) error
+	}
+
+	// A MemoryErrorInformation32Visitor receives each parsed
+	// MemoryErrorInformation32.
+	MemoryErrorInformation32Visitor interface {
+		VisitMemoryErrorInformation32(v *MemoryErrorInformation32) error
+	}
+
+	// A MemoryArrayMappedAddressVisitor receives each parsed
+	// MemoryArrayMappedAddress.
+	MemoryArrayMappedAddressVisitor interface {
+		VisitMemoryArrayMappedAddress(v *MemoryArrayMappedAddress) error
+	}
+
+	// A MemoryDeviceMappedAddressVisitor receives each parsed
+	// MemoryDeviceMappedAddress.
+	MemoryDeviceMappedAddressVisitor interface {
+		VisitMemoryDeviceMappedAddress(v *MemoryDeviceMappedAddress) error
+	}
+
+	// A SystemResetVisitor receives each parsed SystemReset.
+	SystemResetVisitor interface {
+		VisitSystemReset(v *SystemReset) error
+	}
+
+	// A HardwareSecurityVisitor receives each parsed HardwareSecurity.
+	HardwareSecurityVisitor interface {
+		VisitHardwareSecurity(v *HardwareSecurity) error
+	}
+
+	// A SystemPowerControlsVisitor receives each parsed SystemPowerControls.
+	SystemPowerControlsVisitor interface {
+		VisitSystemPowerControls(v *SystemPowerControls) error
+	}
+
+	// A VoltageProbeVisitor receives each parsed VoltageProbe.
+	VoltageProbeVisitor interface {
+		VisitVoltageProbe(v *VoltageProbe) error
+	}
+
+	// A CoolingDeviceVisitor receives each parsed CoolingDevice.
+	CoolingDeviceVisitor interface {
+		VisitCoolingDevice(v *CoolingDevice) error
+	}
+
+	// A TemperatureProbeVisitor receives each parsed TemperatureProbe.
+	TemperatureProbeVisitor interface {
+		VisitTemperatureProbe(v *TemperatureProbe) error
+	}
+
+	// An ElectricalCurrentProbeVisitor receives each parsed
+	// ElectricalCurrentProbe.
+	ElectricalCurrentProbeVisitor interface {
+		VisitElectricalCurrentProbe(v *ElectricalCurrentProbe) error
+	}
+
+	// An OutOfBandRemoteAccessVisitor receives each parsed
+	// OutOfBandRemoteAccess.
+	OutOfBandRemoteAccessVisitor interface {
+		VisitOutOfBandRemoteAccess(v *OutOfBandRemoteAccess) error
+	}
+
+	// A BootIntegrityServicesVisitor receives each parsed
+	// BootIntegrityServices.
+	BootIntegrityServicesVisitor interface {
+		VisitBootIntegrityServices(v *BootIntegrityServices) error
+	}
+
+	// A SystemBootInformationVisitor receives each parsed
+	// SystemBootInformation.
+	SystemBootInformationVisitor interface {
+		VisitSystemBootInformation(v *SystemBootInformation) error
+	}
+
+	// A MemoryErrorInformation64Visitor receives each parsed
+	// MemoryErrorInformation64.
+	MemoryErrorInformation64Visitor interface {
+		VisitMemoryErrorInformation64(v *MemoryErrorInformation64) error
+	}
+
+	// A ManagementDeviceVisitor receives each parsed ManagementDevice.
+	ManagementDeviceVisitor interface {
+		VisitManagementDevice(v *ManagementDevice) error
+	}
+
+	// A SystemPowerSupplyVisitor receives each parsed SystemPowerSupply.
+	SystemPowerSupplyVisitor interface {
+		VisitSystemPowerSupply(v *SystemPowerSupply) error
+	}
+
+	// An AdditionalInformationVisitor receives each parsed
+	// AdditionalInformation.
+	AdditionalInformationVisitor interface {
+		VisitAdditionalInformation(v *AdditionalInformation) error
+	}
+
+	// A TPMDeviceVisitor receives each parsed TPMDevice.
+	TPMDeviceVisitor interface {
+		VisitTPMDevice(v *TPMDevice) error
+	}
+
+	// A FirmwareInventoryVisitor receives each parsed FirmwareInventory.
+	FirmwareInventoryVisitor interface {
+		VisitFirmwareInventory(v *FirmwareInventory) error
+	}
+
+	// A StructureVisitor receives Structures for which no other Visitor
+	// interface is implemented, including the End-of-table structure.
+	StructureVisitor interface {
+		VisitStructure(s *smbios.Structure) error
+	}
+)
+
+// Visit decodes Structures from d, parsing each Structure and dispatching it
+// to the Visitor interface method which v implements for the Structure's
+// type.  Structures for which v implements no typed Visitor interface are
+// passed to v's VisitStructure method if v implements StructureVisitor, and
+// are otherwise ignored.
+//
+// Visit retains no Structures, so applications can build their own models of
+// the Structures in a single pass.  If parsing a Structure fails or a Visitor
+// method returns an error, decoding stops and Visit returns the error.
+func Visit(d *smbios.Decoder, v interface{}) error {
+	return d.DecodeFunc(func(s *smbios.Structure) error {
+		return visit(s, v)
+	})
+}
+
+// visit dispatches a single Structure to v.  Each parser registered in
+// typed.go must have a corresponding Visitor interface and case here, which
+// TestVisitRegisteredTypes checks.
+func visit(s *smbios.Structure, v interface{}) error {
+	var (
+		handled bool
+		err     error
+	)
+
+	switch s.Header.Type {
+	case TypeBIOSInformation:
+		if tv, ok := v.(BIOSVisitor); ok {
+			handled = true
+
+			var x *BIOSInformation
+			x, err = ParseBIOSInformation(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitBIOS(x)
+			}
+		}
+	case TypeSystemInformation:
+		if tv, ok := v.(SystemInformationVisitor); ok {
+			handled = true
+
+			var x *SystemInformation
+			x, err = ParseSystemInformation(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitSystemInformation(x)
+			}
+		}
+	case TypeBaseboard:
+		if tv, ok := v.(BaseboardVisitor); ok {
+			handled = true
+
+			var x *Baseboard
+			x, err = ParseBaseboard(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitBaseboard(x)
+			}
+		}
+	case TypeChassis:
+		if tv, ok := v.(ChassisVisitor); ok {
+			handled = true
+
+			var x *Chassis
+			x, err = ParseChassis(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitChassis(x)
+			}
+		}
+	case TypeProcessor:
+		if tv, ok := v.(ProcessorVisitor); ok {
+			handled = true
+
+			var x *Processor
+			x, err = ParseProcessor(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitProcessor(x)
+			}
+		}
+	case TypeMemoryController:
+		if tv, ok := v.(MemoryControllerVisitor); ok {
+			handled = true
+
+			var x *MemoryController
+			x, err = ParseMemoryController(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitMemoryController(x)
+			}
+		}
+	case TypeMemoryModule:
+		if tv, ok := v.(MemoryModuleVisitor); ok {
+			handled = true
+
+			var x *MemoryModule
+			x, err = ParseMemoryModule(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitMemoryModule(x)
+			}
+		}
+	case TypeCacheInformation:
+		if tv, ok := v.(CacheInformationVisitor); ok {
+			handled = true
+
+			var x *CacheInformation
+			x, err = ParseCacheInformation(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitCacheInformation(x)
+			}
+		}
+	case TypePortConnector:
+		if tv, ok := v.(PortConnectorVisitor); ok {
+			handled = true
+
+			var x *PortConnector
+			x, err = ParsePortConnector(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitPortConnector(x)
+			}
+		}
+	case TypeSystemSlot:
+		if tv, ok := v.(SystemSlotVisitor); ok {
+			handled = true
+
+			var x *SystemSlot
+			x, err = ParseSystemSlot(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitSystemSlot(x)
+			}
+		}
+	case TypeOnBoardDevices:
+		if tv, ok := v.(OnBoardDevicesVisitor); ok {
+			handled = true
+
+			var x *OnBoardDevices
+			x, err = ParseOnBoardDevices(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitOnBoardDevices(x)
+			}
+		}
+	case TypeOEMStrings:
+		if tv, ok := v.(OEMStringsVisitor); ok {
+			handled = true
+
+			var x *OEMStrings
+			x, err = ParseOEMStrings(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitOEMStrings(x)
+			}
+		}
+	case TypeSystemConfigurationOptions:
+		if tv, ok := v.(SystemConfigurationOptionsVisitor); ok {
+			handled = true
+
+			var x *SystemConfigurationOptions
+			x, err = ParseSystemConfigurationOptions(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitSystemConfigurationOptions(x)
+			}
+		}
+	case TypeBIOSLanguageInformation:
+		if tv, ok := v.(BIOSLanguageInformationVisitor); ok {
+			handled = true
+
+			var x *BIOSLanguageInformation
+			x, err = ParseBIOSLanguageInformation(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitBIOSLanguageInformation(x)
+			}
+		}
+	case TypeGroupAssociations:
+		if tv, ok := v.(GroupAssociationsVisitor); ok {
+			handled = true
+
+			var x *GroupAssociations
+			x, err = ParseGroupAssociations(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitGroupAssociations(x)
+			}
+		}
+	case TypePhysicalMemoryArray:
+		if tv, ok := v.(PhysicalMemoryArrayVisitor); ok {
+			handled = true
+
+			var x *PhysicalMemoryArray
+			x, err = ParsePhysicalMemoryArray(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitPhysicalMemoryArray(x)
+			}
+		}
+	case TypeMemoryDevice:
+		if tv, ok := v.(MemoryDeviceVisitor); ok {
+			handled = true
+
+			var x *MemoryDevice
+			x, err = ParseMemoryDevice(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitMemoryDevice(x)
+			}
+		}
+	case TypeMemoryErrorInformation32:
+		if tv, ok := v.(MemoryErrorInformation32Visitor); ok {
+			handled = true
+
+			var x *MemoryErrorInformation32
+			x, err = ParseMemoryErrorInformation32(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitMemoryErrorInformation32(x)
+			}
+		}
+	case TypeMemoryArrayMappedAddress:
+		if tv, ok := v.(MemoryArrayMappedAddressVisitor); ok {
+			handled = true
+
+			var x *MemoryArrayMappedAddress
+			x, err = ParseMemoryArrayMappedAddress(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitMemoryArrayMappedAddress(x)
+			}
+		}
+	case TypeMemoryDeviceMappedAddress:
+		if tv, ok := v.(MemoryDeviceMappedAddressVisitor); ok {
+			handled = true
+
+			var x *MemoryDeviceMappedAddress
+			x, err = ParseMemoryDeviceMappedAddress(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitMemoryDeviceMappedAddress(x)
+			}
+		}
+	case TypeSystemReset:
+		if tv, ok := v.(SystemResetVisitor); ok {
+			handled = true
+
+			var x *SystemReset
+			x, err = ParseSystemReset(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitSystemReset(x)
+			}
+		}
+	case TypeHardwareSecurity:
+		if tv, ok := v.(HardwareSecurityVisitor); ok {
+			handled = true
+
+			var x *HardwareSecurity
+			x, err = ParseHardwareSecurity(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitHardwareSecurity(x)
+			}
+		}
+	case TypeSystemPowerControls:
+		if tv, ok := v.(SystemPowerControlsVisitor); ok {
+			handled = true
+
+			var x *SystemPowerControls
+			x, err = ParseSystemPowerControls(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitSystemPowerControls(x)
+			}
+		}
+	case TypeVoltageProbe:
+		if tv, ok := v.(VoltageProbeVisitor); ok {
+			handled = true
+
+			var x *VoltageProbe
+			x, err = ParseVoltageProbe(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitVoltageProbe(x)
+			}
+		}
+	case TypeCoolingDevice:
+		if tv, ok := v.(CoolingDeviceVisitor); ok {
+			handled = true
+
+			var x *CoolingDevice
+			x, err = ParseCoolingDevice(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitCoolingDevice(x)
+			}
+		}
+	case TypeTemperatureProbe:
+		if tv, ok := v.(TemperatureProbeVisitor); ok {
+			handled = true
+
+			var x *TemperatureProbe
+			x, err = ParseTemperatureProbe(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitTemperatureProbe(x)
+			}
+		}
+	case TypeElectricalCurrentProbe:
+		if tv, ok := v.(ElectricalCurrentProbeVisitor); ok {
+			handled = true
+
+			var x *ElectricalCurrentProbe
+			x, err = ParseElectricalCurrentProbe(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitElectricalCurrentProbe(x)
+			}
+		}
+	case TypeOutOfBandRemoteAccess:
+		if tv, ok := v.(OutOfBandRemoteAccessVisitor); ok {
+			handled = true
+
+			var x *OutOfBandRemoteAccess
+			x, err = ParseOutOfBandRemoteAccess(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitOutOfBandRemoteAccess(x)
+			}
+		}
+	case TypeBootIntegrityServices:
+		if tv, ok := v.(BootIntegrityServicesVisitor); ok {
+			handled = true
+
+			var x *BootIntegrityServices
+			x, err = ParseBootIntegrityServices(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitBootIntegrityServices(x)
+			}
+		}
+	case TypeSystemBootInformation:
+		if tv, ok := v.(SystemBootInformationVisitor); ok {
+			handled = true
+
+			var x *SystemBootInformation
+			x, err = ParseSystemBootInformation(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitSystemBootInformation(x)
+			}
+		}
+	case TypeMemoryErrorInformation64:
+		if tv, ok := v.(MemoryErrorInformation64Visitor); ok {
+			handled = true
+
+			var x *MemoryErrorInformation64
+			x, err = ParseMemoryErrorInformation64(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitMemoryErrorInformation64(x)
+			}
+		}
+	case TypeManagementDevice:
+		if tv, ok := v.(ManagementDeviceVisitor); ok {
+			handled = true
+
+			var x *ManagementDevice
+			x, err = ParseManagementDevice(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitManagementDevice(x)
+			}
+		}
+	case TypeSystemPowerSupply:
+		if tv, ok := v.(SystemPowerSupplyVisitor); ok {
+			handled = true
+
+			var x *SystemPowerSupply
+			x, err = ParseSystemPowerSupply(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitSystemPowerSupply(x)
+			}
+		}
+	case TypeAdditionalInformation:
+		if tv, ok := v.(AdditionalInformationVisitor); ok {
+			handled = true
+
+			var x *AdditionalInformation
+			x, err = ParseAdditionalInformation(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitAdditionalInformation(x)
+			}
+		}
+	case TypeTPMDevice:
+		if tv, ok := v.(TPMDeviceVisitor); ok {
+			handled = true
+
+			var x *TPMDevice
+			x, err = ParseTPMDevice(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitTPMDevice(x)
+			}
+		}
+	case TypeFirmwareInventory:
+		if tv, ok := v.(FirmwareInventoryVisitor); ok {
+			handled = true
+
+			var x *FirmwareInventory
+			x, err = ParseFirmwareInventory(s)
+			if err = processed(x, err); err == nil {
+				err = tv.VisitFirmwareInventory(x)
+			}
+		}
+	}
+
+	if handled {
+		return err
+	}
+
+	if sv, ok := v.(StructureVisitor); ok {
+		return sv.VisitStructure(s)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+// A testVisitor records the structures it visits.
+type testVisitor struct {
+	systems []string
+	dimms   []string
+	arrays  []uint16
+	others  []uint8
+	dimmErr error
+}
+
+func (v *testVisitor) VisitSystemInformation(si *structures.SystemInformation) error {
+	v.systems = append(v.systems, si.Manufacturer)
+	return nil
+}
+
+func (v *testVisitor) VisitMemoryDevice(md *structures.MemoryDevice) error {
+	v.dimms = append(v.dimms, md.DeviceLocator)
+	return v.dimmErr
+}
+
+func (v *testVisitor) VisitMemoryArrayMappedAddress(ma *structures.MemoryArrayMappedAddress) error {
+	v.arrays = append(v.arrays, ma.MemoryArrayHandle)
+	return nil
+}
+
+func (v *testVisitor) VisitStructure(s *smbios.Structure) error {
+	v.others = append(v.others, s.Header.Type)
+	return nil
+}
+
+func TestVisit(t *testing.T) {
+	ss := []*smbios.Structure{
+		newBuilder(1, 0x08, "DigitalOcean").byte(0x04, 1).structure(),
+		memoryDevice37(),
+		newBuilder(19, 0x0f).dword(0x08, 0x003fffff).word(0x0c, 0x1000).byte(0x0e, 1).structure(),
+		newBuilder(200, 0x05).structure(),
+	}
+
+	var buf bytes.Buffer
+	if err := smbios.NewEncoder(&buf, smbios.WithOrder(smbios.OrderPreserve)).Encode(ss); err != nil {
+		t.Fatalf("failed to encode structures: %v", err)
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		v := &testVisitor{}
+		if err := structures.Visit(smbios.NewDecoder(bytes.NewReader(buf.Bytes())), v); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		want := &testVisitor{
+			systems: []string{"DigitalOcean"},
+			dimms:   []string{"CPU0_DIMM_A1"},
+			arrays:  []uint16{0x1000},
+			others:  []uint8{200, 127},
+		}
+
+		if diff := cmp.Diff(want, v, cmp.AllowUnexported(testVisitor{})); diff != "" {
+			t.Fatalf("unexpected visited structures (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("visitor error", func(t *testing.T) {
+		errDIMM := errors.New("bad DIMM")
+		v := &testVisitor{dimmErr: errDIMM}
+
+		err := structures.Visit(smbios.NewDecoder(bytes.NewReader(buf.Bytes())), v)
+		if err != errDIMM {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		// Decoding stops at the error.
+		if diff := cmp.Diff([]uint8(nil), v.others); diff != "" {
+			t.Fatalf("unexpected visited structures (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("parse error", func(t *testing.T) {
+		var buf bytes.Buffer
+		bad := []*smbios.Structure{newBuilder(17, 0x10).structure()}
+		if err := smbios.NewEncoder(&buf).Encode(bad); err != nil {
+			t.Fatalf("failed to encode structures: %v", err)
+		}
+
+		if err := structures.Visit(smbios.NewDecoder(&buf), &testVisitor{}); err == nil {
+			t.Fatal("expected an error, but none occurred")
+		}
+	})
+}
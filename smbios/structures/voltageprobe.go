@@ -0,0 +1,74 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures
+
+import (
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+// TypeVoltageProbe is the structure type of a Voltage Probe (type 26).
+const TypeVoltageProbe = 26
+
+// A VoltageProbe is a Voltage Probe (type 26) structure, which describes a
+// voltage probe within a system.
+//
+// The reading fields of the embedded Probe are raw values which are 0x8000 if
+// unknown.  Use the accessor methods to interpret them.
+type VoltageProbe struct {
+	Probe
+}
+
+// ParseVoltageProbe parses a VoltageProbe from a Structure.
+func ParseVoltageProbe(s *smbios.Structure) (*VoltageProbe, error) {
+	p, err := parseProbe(s, TypeVoltageProbe, "voltage probe")
+	if err != nil {
+		return nil, err
+	}
+
+	return &VoltageProbe{Probe: p}, nil
+}
+
+// MaximumMillivolts returns the maximum voltage readable by the probe in
+// millivolts.  If the value is unknown, MaximumMillivolts returns false.
+func (vp *VoltageProbe) MaximumMillivolts() (int, bool) {
+	return probeSigned(vp.MaximumValue)
+}
+
+// MinimumMillivolts returns the minimum voltage readable by the probe in
+// millivolts.  If the value is unknown, MinimumMillivolts returns false.
+func (vp *VoltageProbe) MinimumMillivolts() (int, bool) {
+	return probeSigned(vp.MinimumValue)
+}
+
+// NominalMillivolts returns the nominal voltage of the probe in millivolts.
+// If the value is unknown or not present, NominalMillivolts returns false.
+func (vp *VoltageProbe) NominalMillivolts() (int, bool) {
+	return probeSigned(vp.NominalValue)
+}
+
+// ResolutionMicrovolts returns the resolution of the probe's reading in
+// microvolts.  If the value is unknown, ResolutionMicrovolts returns false.
+func (vp *VoltageProbe) ResolutionMicrovolts() (int, bool) {
+	// The resolution field is specified in tenths of millivolts.
+	v, ok := probeUnsigned(vp.Resolution)
+	return v * 100, ok
+}
+
+// ToleranceMillivolts returns the tolerance of the probe's reading in plus or
+// minus millivolts.  If the value is unknown, ToleranceMillivolts returns
+// false.
+func (vp *VoltageProbe) ToleranceMillivolts() (int, bool) {
+	return probeUnsigned(vp.Tolerance)
+}
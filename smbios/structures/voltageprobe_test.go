@@ -0,0 +1,64 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structures_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios/structures"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestVoltageProbeReadings(t *testing.T) {
+	vp := &structures.VoltageProbe{
+		Probe: structures.Probe{
+			MaximumValue: 1500,
+			// -12V rail.
+			MinimumValue: 0xd120,
+			Resolution:   5,
+			Tolerance:    0x8000,
+			NominalValue: 0x8000,
+		},
+	}
+
+	type reading struct {
+		V  int
+		OK bool
+	}
+
+	var got []reading
+	for _, fn := range []func() (int, bool){
+		vp.MaximumMillivolts,
+		vp.MinimumMillivolts,
+		vp.NominalMillivolts,
+		vp.ResolutionMicrovolts,
+		vp.ToleranceMillivolts,
+	} {
+		v, ok := fn()
+		got = append(got, reading{V: v, OK: ok})
+	}
+
+	want := []reading{
+		{V: 1500, OK: true},
+		{V: -12000, OK: true},
+		{},
+		{V: 500, OK: true},
+		{},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected readings (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"crypto/sha256"
+)
+
+// A Table is a decoded SMBIOS structure table.
+type Table struct {
+	// Node is the index of the Table when a system exposes more than one
+	// structure table, such as one per node of a multi-node system.  Node
+	// is 0 for systems with a single table.
+	Node int
+
+	// EntryPoint is the entry point which describes the Table, if known.
+	EntryPoint EntryPoint
+
+	// Structures are the Structures decoded from the Table.
+	Structures []*Structure
+}
+
+// A NodeHandle identifies a Structure within a set of merged Tables.  Handles
+// are only unique within a single Table, so the Table's node is used to
+// disambiguate them.
+type NodeHandle struct {
+	Node   int
+	Handle uint16
+}
+
+// A NodeStructure is a Structure tagged with the node of the Table from which
+// it was decoded.
+type NodeStructure struct {
+	*Structure
+	Node int
+}
+
+// NodeHandle returns the NodeHandle which identifies s.
+func (s *NodeStructure) NodeHandle() NodeHandle {
+	return NodeHandle{
+		Node:   s.Node,
+		Handle: s.Header.Handle,
+	}
+}
+
+// MergeTables merges the Structures of one or more Tables into a single list
+// for aggregated inventory, tagging each Structure with the node of its Table.
+// The End-of-table Structure of each Table is omitted.
+func MergeTables(ts []*Table) []*NodeStructure {
+	var out []*NodeStructure
+	for _, t := range ts {
+		for _, s := range t.Structures {
+			if s.Header.Type == typeEndOfTable {
+				continue
+			}
+
+			out = append(out, &NodeStructure{
+				Structure: s,
+				Node:      t.Node,
+			})
+		}
+	}
+
+	return out
+}
+
+// A HashOption configures the behavior of Table.Hash.
+type HashOption func(*hashConfig)
+
+// hashConfig is the configuration built from HashOptions.
+type hashConfig struct {
+	exclude map[uint8]bool
+	oem     bool
+}
+
+// HashExcludeTypes excludes Structures of the specified types from a hash.
+func HashExcludeTypes(types ...uint8) HashOption {
+	return func(c *hashConfig) {
+		for _, t := range types {
+			c.exclude[t] = true
+		}
+	}
+}
+
+// HashExcludeOEM excludes OEM-specific Structures (types 128 through 255)
+// from a hash.  Some firmware stores volatile data such as counters or
+// timestamps in OEM-specific Structures.
+func HashExcludeOEM() HashOption {
+	return func(c *hashConfig) {
+		c.oem = true
+	}
+}
+
+// Hash computes a SHA-256 hash over the binary encoding of the Table's
+// Structures, in order.  The hash is stable for identical tables, so it can be
+// stored and compared to cheaply detect changes to firmware tables, such as
+// across reboots.
+//
+// HashOptions may be specified to exclude certain Structures from the hash.
+func (t *Table) Hash(options ...HashOption) [sha256.Size]byte {
+	c := &hashConfig{exclude: make(map[uint8]bool)}
+	for _, o := range options {
+		o(c)
+	}
+
+	h := sha256.New()
+
+	var b []byte
+	for _, s := range t.Structures {
+		if c.exclude[s.Header.Type] || (c.oem && s.Header.Type >= typeOEMStart) {
+			continue
+		}
+
+		b = appendStructure(b[:0], s)
+		_, _ = h.Write(b)
+	}
+
+	var sum [sha256.Size]byte
+	copy(sum[:], h.Sum(nil))
+	return sum
+}
@@ -0,0 +1,49 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.23
+// +build go1.23
+
+package smbios
+
+import (
+	"iter"
+)
+
+// All returns an iterator over the Table's Structures, in order.
+func (t *Table) All() iter.Seq[*Structure] {
+	return func(yield func(*Structure) bool) {
+		for _, s := range t.Structures {
+			if !yield(s) {
+				return
+			}
+		}
+	}
+}
+
+// OfType returns an iterator over the Table's Structures of the specified
+// type, in order.
+func (t *Table) OfType(typ uint8) iter.Seq[*Structure] {
+	return func(yield func(*Structure) bool) {
+		for s := range t.All() {
+			if s.Header.Type != typ {
+				continue
+			}
+
+			if !yield(s) {
+				return
+			}
+		}
+	}
+}
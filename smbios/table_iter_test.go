@@ -0,0 +1,56 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.23
+// +build go1.23
+
+package smbios_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTableAll(t *testing.T) {
+	tbl := &smbios.Table{
+		Structures: []*smbios.Structure{
+			{Header: smbios.Header{Type: 0, Handle: 0}},
+			{Header: smbios.Header{Type: 17, Handle: 1}},
+			{Header: smbios.Header{Type: 17, Handle: 2}},
+			{Header: smbios.Header{Type: 127, Handle: 3}},
+		},
+	}
+
+	var all []uint16
+	for s := range tbl.All() {
+		all = append(all, s.Header.Handle)
+	}
+
+	if diff := cmp.Diff([]uint16{0, 1, 2, 3}, all); diff != "" {
+		t.Fatalf("unexpected handles (-want +got):\n%s", diff)
+	}
+
+	// Stop after the first memory device to verify early termination.
+	var mds []uint16
+	for s := range tbl.OfType(17) {
+		mds = append(mds, s.Header.Handle)
+		break
+	}
+
+	if diff := cmp.Diff([]uint16{1}, mds); diff != "" {
+		t.Fatalf("unexpected memory device handles (-want +got):\n%s", diff)
+	}
+}
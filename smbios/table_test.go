@@ -0,0 +1,173 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMergeTables(t *testing.T) {
+	var (
+		bios0 = &smbios.Structure{Header: smbios.Header{Type: 0, Handle: 1}}
+		eot0  = &smbios.Structure{Header: smbios.Header{Type: 127, Handle: 2}}
+		bios1 = &smbios.Structure{Header: smbios.Header{Type: 0, Handle: 1}}
+		eot1  = &smbios.Structure{Header: smbios.Header{Type: 127, Handle: 2}}
+	)
+
+	ss := smbios.MergeTables([]*smbios.Table{
+		{Node: 0, Structures: []*smbios.Structure{bios0, eot0}},
+		{Node: 1, Structures: []*smbios.Structure{bios1, eot1}},
+	})
+
+	want := []smbios.NodeHandle{
+		{Node: 0, Handle: 1},
+		{Node: 1, Handle: 1},
+	}
+
+	got := make([]smbios.NodeHandle, 0, len(ss))
+	for _, s := range ss {
+		got = append(got, s.NodeHandle())
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected node handles (-want +got):\n%s", diff)
+	}
+
+	if ss[0].Structure != bios0 || ss[1].Structure != bios1 {
+		t.Fatal("merged structures do not reference their originals")
+	}
+}
+
+func TestTableHash(t *testing.T) {
+	var (
+		bios = []byte{
+			0x00, 0x05, 0x01, 0x00,
+			0xff,
+			0x00,
+			0x00,
+		}
+		oem = func(b byte) []byte {
+			return []byte{
+				0x80, 0x05, 0x02, 0x00,
+				b,
+				0x00,
+				0x00,
+			}
+		}
+		eot = []byte{
+			127, 0x04, 0x03, 0x00,
+			0x00,
+			0x00,
+		}
+	)
+
+	table := func(bs ...[]byte) []byte {
+		var out []byte
+		for _, b := range bs {
+			out = append(out, b...)
+		}
+		return out
+	}
+
+	decode := func(t *testing.T, b []byte) *smbios.Table {
+		t.Helper()
+
+		ss, err := smbios.NewDecoder(bytes.NewReader(b)).Decode()
+		if err != nil {
+			t.Fatalf("failed to decode structures: %v", err)
+		}
+
+		return &smbios.Table{Structures: ss}
+	}
+
+	tests := []struct {
+		name    string
+		a, b    []byte
+		options []smbios.HashOption
+		equal   bool
+	}{
+		{
+			name:  "identical",
+			a:     table(bios, oem(0x01), eot),
+			b:     table(bios, oem(0x01), eot),
+			equal: true,
+		},
+		{
+			name: "OEM differs",
+			a:    table(bios, oem(0x01), eot),
+			b:    table(bios, oem(0x02), eot),
+		},
+		{
+			name:    "OEM differs, excluded",
+			a:       table(bios, oem(0x01), eot),
+			b:       table(bios, oem(0x02), eot),
+			options: []smbios.HashOption{smbios.HashExcludeOEM()},
+			equal:   true,
+		},
+		{
+			name:    "OEM differs, type excluded",
+			a:       table(bios, oem(0x01), eot),
+			b:       table(bios, oem(0x02), eot),
+			options: []smbios.HashOption{smbios.HashExcludeTypes(0x80)},
+			equal:   true,
+		},
+		{
+			name:    "BIOS missing, OEM excluded",
+			a:       table(bios, oem(0x01), eot),
+			b:       table(oem(0x01), eot),
+			options: []smbios.HashOption{smbios.HashExcludeOEM()},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := decode(t, tt.a).Hash(tt.options...)
+			b := decode(t, tt.b).Hash(tt.options...)
+
+			if want, got := tt.equal, a == b; want != got {
+				t.Fatalf("unexpected hash equality: %v != %v\na: %x\nb: %x",
+					want, got, a, b)
+			}
+		})
+	}
+}
+
+func TestTableHashRaw(t *testing.T) {
+	b := []byte{
+		0x01, 0x0c, 0x01, 0x00,
+		0xde, 0xad, 0xbe, 0xef, 0xde, 0xad, 0xbe, 0xef,
+		'd', 'e', 'a', 'd', 'b', 'e', 'e', 'f', 0x00,
+		0x00,
+
+		127, 0x04, 0x02, 0x00,
+		0x00,
+		0x00,
+	}
+
+	ss, err := smbios.NewDecoder(bytes.NewReader(b)).Decode()
+	if err != nil {
+		t.Fatalf("failed to decode structures: %v", err)
+	}
+
+	// With no options, the hash covers exactly the raw table.
+	if want, got := sha256.Sum256(b), (&smbios.Table{Structures: ss}).Hash(); want != got {
+		t.Fatalf("unexpected hash:\nwant: %x\n got: %x", want, got)
+	}
+}
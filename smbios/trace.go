@@ -0,0 +1,71 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+// A Tracer starts Spans which annotate SMBIOS operations such as Stream and
+// Decode.
+//
+// Tracer is intentionally small so that it can be satisfied by a thin adapter
+// over an OpenTelemetry trace.Tracer, or any other tracing system, without
+// this package depending on one.
+type Tracer interface {
+	// Start begins a new Span with the specified name.
+	Start(name string) Span
+}
+
+// A Span is a single traced operation started by a Tracer.
+type Span interface {
+	// SetAttribute annotates the Span with a key/value pair.  Values are
+	// of type string or int.
+	SetAttribute(key string, value interface{})
+
+	// RecordError records an error which caused the operation to fail.
+	RecordError(err error)
+
+	// End completes the Span.
+	End()
+}
+
+// Attribute keys set on Spans by this package.
+const (
+	// AttributeSource is the location from which an SMBIOS stream was read.
+	AttributeSource = "smbios.source"
+
+	// AttributeVersion is the SMBIOS version reported by the entry point.
+	AttributeVersion = "smbios.version"
+
+	// AttributeTableSize is the SMBIOS structure table size reported by the
+	// entry point.
+	AttributeTableSize = "smbios.table.size"
+
+	// AttributeStructures is the number of structures decoded.
+	AttributeStructures = "smbios.structures.count"
+
+	// AttributeBytes is the number of bytes of structure data decoded.
+	AttributeBytes = "smbios.structures.bytes"
+)
+
+// nopTracer is a Tracer which does nothing.  It is used when no Tracer
+// is configured.
+type nopTracer struct{}
+
+func (nopTracer) Start(_ string) Span { return nopSpan{} }
+
+// nopSpan is a Span which does nothing.
+type nopSpan struct{}
+
+func (nopSpan) SetAttribute(_ string, _ interface{}) {}
+func (nopSpan) RecordError(_ error)                  {}
+func (nopSpan) End()                                 {}
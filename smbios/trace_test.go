@@ -0,0 +1,107 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDecoderTracer(t *testing.T) {
+	tests := []struct {
+		name  string
+		b     []byte
+		spans []*testSpan
+	}{
+		{
+			name: "error",
+			b:    []byte{0x00},
+			spans: []*testSpan{{
+				Name:  "smbios.Decode",
+				Attrs: map[string]interface{}{},
+				Err:   true,
+				Ended: true,
+			}},
+		},
+		{
+			name: "OK",
+			b: []byte{
+				0x01, 0x0c, 0x02, 0x00,
+				0xde, 0xad, 0xbe, 0xef, 0xde, 0xad, 0xbe, 0xef,
+				'd', 'e', 'a', 'd', 'b', 'e', 'e', 'f', 0x00,
+				0x00,
+
+				127, 0x04, 0x03, 0x00,
+				0x00,
+				0x00,
+			},
+			spans: []*testSpan{{
+				Name: "smbios.Decode",
+				Attrs: map[string]interface{}{
+					smbios.AttributeStructures: 2,
+					smbios.AttributeBytes:      28,
+				},
+				Ended: true,
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := &testTracer{}
+
+			d := smbios.NewDecoder(bytes.NewReader(tt.b), smbios.WithDecoderTracer(tr))
+			_, _ = d.Decode()
+
+			if diff := cmp.Diff(tt.spans, tr.spans); diff != "" {
+				t.Fatalf("unexpected spans (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+var _ smbios.Tracer = &testTracer{}
+
+// A testTracer is a smbios.Tracer which records its Spans.
+type testTracer struct {
+	spans []*testSpan
+}
+
+func (t *testTracer) Start(name string) smbios.Span {
+	s := &testSpan{
+		Name:  name,
+		Attrs: make(map[string]interface{}),
+	}
+
+	t.spans = append(t.spans, s)
+	return s
+}
+
+var _ smbios.Span = &testSpan{}
+
+// A testSpan is a smbios.Span which records its annotations.
+type testSpan struct {
+	Name  string
+	Attrs map[string]interface{}
+	Err   bool
+	Ended bool
+}
+
+func (s *testSpan) SetAttribute(key string, value interface{}) { s.Attrs[key] = value }
+func (s *testSpan) RecordError(err error)                      { s.Err = err != nil }
+func (s *testSpan) End()                                       { s.Ended = true }
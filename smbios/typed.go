@@ -0,0 +1,126 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.18
+// +build go1.18
+
+package smbios
+
+import (
+	"fmt"
+	"sync"
+)
+
+var (
+	// parsersMu guards parsers.
+	parsersMu sync.RWMutex
+
+	// parsers are the functions registered using Register to parse
+	// TypedStructures, keyed by structure type.
+	parsers = make(map[uint8]func(*Structure) (TypedStructure, error))
+)
+
+// Register registers a function which parses a TypedStructure of type T from a
+// Structure, so that TypedStructures of type T can be retrieved using Get.
+// Register is typically called from the init function of the package which
+// defines T, and panics if a parser is already registered for T's structure
+// type.
+func Register[T TypedStructure](parse func(*Structure) (T, error)) {
+	var zero T
+	typ := zero.StructureType()
+
+	parsersMu.Lock()
+	defer parsersMu.Unlock()
+
+	if _, ok := parsers[typ]; ok {
+		panic(fmt.Sprintf("smbios: parser already registered for structure type %d", typ))
+	}
+
+	parsers[typ] = func(s *Structure) (TypedStructure, error) {
+		return parse(s)
+	}
+}
+
+// Registered reports whether a parser is registered using Register for
+// Structures of type typ.
+func Registered(typ uint8) bool {
+	parsersMu.RLock()
+	defer parsersMu.RUnlock()
+
+	_, ok := parsers[typ]
+	return ok
+}
+
+// RegisterPostProcessor registers a function which is applied to each
+// TypedStructure of type T parsed by Get, such as to enrich it with
+// organization-specific data or to rewrite vendor names.  Package structures
+// also applies post-processors in the functions which parse many Structures,
+// such as MemoryDevices, the methods of Table, and Visit.  The functions which
+// parse a single Structure, such as structures.ParseMemoryDevice, do not;
+// call PostProcess on their results to apply post-processors.
+//
+// Post-processors for a type run in the order in which they were registered,
+// and may modify the TypedStructure in place.  If a post-processor returns an
+// error, the TypedStructure is treated as if it could not be parsed: Get
+// skips it, and package structures returns the error.
+//
+// RegisterPostProcessor is typically called from an init function.  The
+// returned function unregisters the post-processor.
+func RegisterPostProcessor[T TypedStructure](fn func(T) error) (unregister func()) {
+	var zero T
+	return addPostProcessor(zero.StructureType(), func(v TypedStructure) error {
+		return fn(v.(T))
+	})
+}
+
+// Get parses all Structures in t of the structure type represented by T using
+// the parser registered for T, and applies any post-processors registered
+// for T.  Structures which cannot be parsed or post-processed are skipped.
+//
+// Get panics if no parser is registered for T, which is a programming error.
+// Parsers for the types in package structures are registered when that
+// package is imported.
+func Get[T TypedStructure](t *Table) []T {
+	var zero T
+	typ := zero.StructureType()
+
+	parsersMu.RLock()
+	parse, ok := parsers[typ]
+	parsersMu.RUnlock()
+
+	if !ok {
+		panic(fmt.Sprintf("smbios: no parser registered for structure type %d", typ))
+	}
+
+	var out []T
+	for _, s := range t.Structures {
+		if s.Header.Type != typ {
+			continue
+		}
+
+		v, err := parse(s)
+		if err != nil {
+			continue
+		}
+
+		if err := PostProcess(v); err != nil {
+			continue
+		}
+
+		// The registered parser always returns a T.
+		out = append(out, v.(T))
+	}
+
+	return out
+}
@@ -0,0 +1,133 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// WithVerification enables a diagnostic mode in which Stream reads SMBIOS
+// data from a secondary source in addition to its primary source, and
+// returns a *DivergenceError if the two disagree.
+//
+// On Linux, the primary source is sysfs and the secondary source is /dev/mem.
+// Verification is skipped if the secondary source cannot be read, such as
+// when /dev/mem access is restricted by the kernel.  On other platforms,
+// no secondary source exists and this option has no effect.
+//
+// Verification is useful when diagnosing problems with an operating
+// system's handling of SMBIOS data, and requires reading the entire table
+// from each source.
+func WithVerification() StreamOption {
+	return func(c *streamConfig) {
+		c.verify = true
+	}
+}
+
+// A DivergenceError is returned by Stream when verification is enabled and
+// the SMBIOS data read from two sources is not identical.
+type DivergenceError struct {
+	// Primary and Secondary are the sources which were compared.
+	Primary, Secondary string
+
+	// Reason describes the first difference found.
+	Reason string
+
+	// Offset is the offset of the first differing byte in the structure
+	// tables, or -1 if the difference was not within the structure tables.
+	Offset int
+}
+
+// Error implements error.
+func (e *DivergenceError) Error() string {
+	return fmt.Sprintf("SMBIOS data from %s and %s diverges: %s", e.Primary, e.Secondary, e.Reason)
+}
+
+// verifyStream reads the primary stream and compares it against the stream
+// produced by openSecondary, returning a copy of the primary stream's data if
+// both agree or the secondary stream is not available.
+func verifyStream(
+	prc io.ReadCloser, pep EntryPoint, primary string,
+	openSecondary func() (io.ReadCloser, EntryPoint, error), secondary string,
+) (io.ReadCloser, error) {
+	defer prc.Close()
+
+	pb, err := ioutil.ReadAll(prc)
+	if err != nil {
+		return nil, err
+	}
+
+	src, sep, err := openSecondary()
+	if err != nil {
+		// Secondary source is not available; nothing to compare against.
+		return ioutil.NopCloser(bytes.NewReader(pb)), nil
+	}
+	defer src.Close()
+
+	sb, err := ioutil.ReadAll(src)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := compareStreams(pb, pep, sb, sep); err != nil {
+		err.Primary, err.Secondary = primary, secondary
+		return nil, err
+	}
+
+	return ioutil.NopCloser(bytes.NewReader(pb)), nil
+}
+
+// compareStreams compares the entry points and structure tables from two
+// sources, returning a *DivergenceError describing the first difference.
+func compareStreams(pb []byte, pep EntryPoint, sb []byte, sep EntryPoint) *DivergenceError {
+	pmaj, pmin, prev := pep.Version()
+	smaj, smin, srev := sep.Version()
+	if pmaj != smaj || pmin != smin || prev != srev {
+		return &DivergenceError{
+			Reason: fmt.Sprintf("version %d.%d.%d != %d.%d.%d",
+				pmaj, pmin, prev, smaj, smin, srev),
+			Offset: -1,
+		}
+	}
+
+	// Some entry points only specify a maximum table size, so a source may
+	// produce more data than the actual table.  Only compare the common
+	// portion of the tables.
+	n := len(pb)
+	if len(sb) < n {
+		n = len(sb)
+	}
+
+	for i := 0; i < n; i++ {
+		if pb[i] != sb[i] {
+			return &DivergenceError{
+				Reason: fmt.Sprintf("table byte at offset %#x: %#02x != %#02x", i, pb[i], sb[i]),
+				Offset: i,
+			}
+		}
+	}
+
+	if len(sb) < len(pb) {
+		return &DivergenceError{
+			Reason: fmt.Sprintf("table length %d != %d", len(pb), len(sb)),
+			Offset: len(sb),
+		}
+	}
+
+	return nil
+}
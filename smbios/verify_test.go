@@ -0,0 +1,129 @@
+// Copyright 2017-2018 DigitalOcean.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smbios
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_verifyStream(t *testing.T) {
+	var (
+		table = []byte{
+			127, 0x04, 0x01, 0x00,
+			0x00,
+			0x00,
+		}
+
+		ep30 = &EntryPoint64Bit{Major: 3}
+		ep31 = &EntryPoint64Bit{Major: 3, Minor: 1}
+	)
+
+	open := func(b []byte, ep EntryPoint, err error) func() (io.ReadCloser, EntryPoint, error) {
+		return func() (io.ReadCloser, EntryPoint, error) {
+			if err != nil {
+				return nil, nil, err
+			}
+
+			return ioutil.NopCloser(bytes.NewReader(b)), ep, nil
+		}
+	}
+
+	tests := []struct {
+		name      string
+		secondary func() (io.ReadCloser, EntryPoint, error)
+		err       *DivergenceError
+	}{
+		{
+			name:      "secondary unavailable",
+			secondary: open(nil, nil, errors.New("permission denied")),
+		},
+		{
+			name:      "identical",
+			secondary: open(table, ep30, nil),
+		},
+		{
+			name:      "secondary trailing data",
+			secondary: open(append(table, 0xff, 0xff), ep30, nil),
+		},
+		{
+			name:      "version",
+			secondary: open(table, ep31, nil),
+			err: &DivergenceError{
+				Primary:   "primary",
+				Secondary: "secondary",
+				Reason:    "version 3.0.0 != 3.1.0",
+				Offset:    -1,
+			},
+		},
+		{
+			name:      "table byte",
+			secondary: open([]byte{127, 0x04, 0x02, 0x00, 0x00, 0x00}, ep30, nil),
+			err: &DivergenceError{
+				Primary:   "primary",
+				Secondary: "secondary",
+				Reason:    "table byte at offset 0x2: 0x01 != 0x02",
+				Offset:    2,
+			},
+		},
+		{
+			name:      "table length",
+			secondary: open(table[:4], ep30, nil),
+			err: &DivergenceError{
+				Primary:   "primary",
+				Secondary: "secondary",
+				Reason:    "table length 6 != 4",
+				Offset:    4,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prc := ioutil.NopCloser(bytes.NewReader(table))
+
+			rc, err := verifyStream(prc, ep30, "primary", tt.secondary, "secondary")
+			if tt.err != nil {
+				var derr *DivergenceError
+				if !errors.As(err, &derr) {
+					t.Fatalf("expected *DivergenceError, but got: %v", err)
+				}
+
+				if diff := cmp.Diff(tt.err, derr); diff != "" {
+					t.Fatalf("unexpected error (-want +got):\n%s", diff)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			b, err := ioutil.ReadAll(rc)
+			if err != nil {
+				t.Fatalf("failed to read stream: %v", err)
+			}
+
+			if diff := cmp.Diff(table, b); diff != "" {
+				t.Fatalf("unexpected stream data (-want +got):\n%s", diff)
+			}
+		})
+	}
+}